@@ -2,37 +2,153 @@
 package endpoints
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	"github.com/SethCurry/stax/internal/api/requests"
 	"github.com/SethCurry/stax/internal/api/responses"
 	"github.com/SethCurry/stax/internal/api/squid"
+	"github.com/SethCurry/stax/internal/autocomplete"
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/ruling"
+	"github.com/SethCurry/stax/internal/bones/set"
 	"github.com/SethCurry/stax/internal/ql"
 )
 
-// CardByName searched for a single card via its name.  It should
-// only ever return a single result.
-func CardByName(ctx *squid.Context) error {
-	var params requests.CardByName
+// CardByName returns a handler that searches for a single card via its name.
+// It should only ever return a single result.
+//
+// Fuzzy searches are matched against the names in index, and return an
+// "ambiguous" error if more than one card matches equally well.
+func CardByName(index *autocomplete.Index) squid.HandlerFunc {
+	return func(ctx *squid.Context) error {
+		var params requests.CardByName
+
+		if err := ctx.Request.UnmarshalQuery(&params); err != nil {
+			return err
+		}
+
+		if err := params.Validate(); err != nil {
+			return err
+		}
+
+		pred := params.ToPredicate()
+
+		if params.Fuzzy != "" {
+			names := index.Fuzzy(params.Fuzzy)
+
+			switch len(names) {
+			case 0:
+				return ctx.Response.WriteJSON(404, responses.NewNotFoundError(
+					fmt.Sprintf("No cards found matching %q", params.Fuzzy)))
+			case 1:
+				pred = card.NameEQ(names[0])
+			default:
+				return ctx.Response.WriteJSON(404, responses.NewAmbiguousError(
+					fmt.Sprintf("Too many cards match ambiguous name %q. Add more words to refine your search.", params.Fuzzy)))
+			}
+		}
+
+		// tokens can share a name with each other, or with a card
+		result, err := ctx.DB.Card.Query().
+			Where(pred).
+			Order(tokensLast, card.ByID()).
+			WithFaces().
+			WithLegalities().
+			First(ctx.Request.Context())
+		if bones.IsNotFound(err) {
+			return ctx.Response.WriteJSON(404, responses.NewNotFoundError(
+				fmt.Sprintf("No cards found matching %q", params.Exact)))
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to query card: %w", err)
+		}
+
+		resp := responses.CardFromDB(result)
+
+		return ctx.Response.WriteJSON(200, resp)
+	}
+}
+
+// CardAutocomplete returns a handler that completes partial card names
+// from index, returning up to 20 names in a catalog like Scryfall's.
+//
+// The index is built once when the server starts, so that completions
+// don't need to query the database.
+func CardAutocomplete(index *autocomplete.Index) squid.HandlerFunc {
+	return func(ctx *squid.Context) error {
+		var params requests.CardAutocomplete
+
+		if err := ctx.Request.UnmarshalQuery(&params); err != nil {
+			return err
+		}
+
+		names := index.Search(params.Query, autocomplete.MaxResults)
+
+		return ctx.Response.WriteJSON(200, responses.NewCatalog(names))
+	}
+}
+
+// CardRandom returns a single random card, optionally from the cards
+// matching a ql query.
+//
+// Cards are picked by counting the matching cards and then skipping a
+// random number of them, so that every card is equally likely to be
+// picked without loading all of them.
+func CardRandom(ctx *squid.Context) error {
+	var params requests.CardRandom
 
 	if err := ctx.Request.UnmarshalQuery(&params); err != nil {
 		return err
 	}
 
-	if err := params.Validate(); err != nil {
-		return err
+	query := ctx.DB.Card.Query()
+
+	if params.Query != "" {
+		parsedQueryRoot, err := ql.ParseQuery(params.Query)
+		if err != nil {
+			if queryErr, ok := responses.QueryErrorFromErr(err); ok {
+				return ctx.Response.WriteJSON(400, queryErr)
+			}
+
+			return err
+		}
+
+		query = query.Where(parsedQueryRoot.Predicate())
 	}
 
-	result, err := ctx.DB.Card.Query().Where(params.ToPredicate()).WithFaces().Only(ctx.Request.Context())
+	total, err := query.Clone().Count(ctx.Request.Context())
 	if err != nil {
-		return fmt.Errorf("failed to query card: %w", err)
+		return fmt.Errorf("failed to count cards: %w", err)
+	}
+
+	if total == 0 {
+		return ctx.Response.WriteJSON(404, responses.NewNotFoundError("No cards found matching the query"))
 	}
 
-	resp := responses.CardFromDB(result)
+	result, err := query.
+		Order(card.ByID()).
+		Offset(params.Rand().IntN(total)).
+		WithFaces().
+		WithLegalities().
+		First(ctx.Request.Context())
+	if err != nil {
+		return fmt.Errorf("failed to query card: %w", err)
+	}
 
-	return ctx.Response.WriteJSON(200, resp)
+	return ctx.Response.WriteJSON(200, responses.CardFromDB(result))
 }
 
+// CardSearch searches for cards matching a ql query, returning a single page
+// of results in the same list format as Scryfall's search API.
 func CardSearch(ctx *squid.Context) error {
 	var params requests.CardQuery
 
@@ -40,21 +156,188 @@ func CardSearch(ctx *squid.Context) error {
 		return err
 	}
 
+	opts, err := params.SearchOptions()
+	if err != nil {
+		return ctx.Response.WriteJSON(400, squid.NewErrorResponse(err))
+	}
+
+	page, pageSize, err := params.Pagination()
+	if err != nil {
+		return ctx.Response.WriteJSON(400, squid.NewErrorResponse(err))
+	}
+
 	parsedQueryRoot, err := ql.ParseQuery(params.Query)
 	if err != nil {
+		if queryErr, ok := responses.QueryErrorFromErr(err); ok {
+			return ctx.Response.WriteJSON(400, queryErr)
+		}
+
 		return err
 	}
 
-	pred := parsedQueryRoot.Predicate()
+	opts = ql.ApplySearchOptions(parsedQueryRoot, opts)
+	offset := (page - 1) * pageSize
+
+	var total int
+	var cards []responses.Card
+
+	if opts.Unique == ql.UniqueCards {
+		query := ctx.DB.Card.Query().Where(parsedQueryRoot.Predicate())
+
+		total, err = query.Clone().Count(ctx.Request.Context())
+		if err != nil {
+			return fmt.Errorf("failed to count cards: %w", err)
+		}
+
+		gotCards, err := query.
+			Order(opts.OrderCards()).
+			Offset(offset).
+			Limit(pageSize).
+			WithFaces().
+			WithLegalities().
+			All(ctx.Request.Context())
+		if err != nil {
+			return fmt.Errorf("failed to query for cards: %w", err)
+		}
+
+		cards = responses.CardsFromDB(gotCards)
+	} else {
+		query := ctx.DB.Printing.Query().Where(opts.FilterPrintings(parsedQueryRoot.PrintingPredicate()))
 
-	gotCards, err := ctx.DB.Card.Query().Where(pred).WithFaces().All(ctx.Request.Context())
+		total, err = query.Clone().Count(ctx.Request.Context())
+		if err != nil {
+			return fmt.Errorf("failed to count printings: %w", err)
+		}
+
+		gotPrintings, err := query.
+			Order(opts.OrderPrintings()).
+			Offset(offset).
+			Limit(pageSize).
+			WithSet().
+			WithArtist().
+			WithCardFace(func(q *bones.CardFaceQuery) {
+				q.WithCard(func(q *bones.CardQuery) {
+					q.WithFaces().WithLegalities()
+				})
+			}).
+			All(ctx.Request.Context())
+		if err != nil {
+			return fmt.Errorf("failed to query for printings: %w", err)
+		}
+
+		cards = responses.CardsFromPrintings(gotPrintings)
+	}
+
+	nextPage := ""
+
+	if offset+len(cards) < total {
+		nextURL := ctx.Request.URL()
+		q := nextURL.Query()
+		q.Set("page", strconv.Itoa(page+1))
+		nextURL.RawQuery = q.Encode()
+
+		nextPage = nextURL.String()
+	}
+
+	return ctx.Response.WriteJSON(200, responses.NewList(cards, total, nextPage))
+}
+
+// CardByID looks up a single printing by its Scryfall ID.
+func CardByID(ctx *squid.Context) error {
+	return writePrinting(ctx, printing.ScryfallIDEQ(ctx.Request.URLParam("id")))
+}
+
+// CardRulings lists the rulings for the card with the given Scryfall ID,
+// oldest first.
+func CardRulings(ctx *squid.Context) error {
+	crd, err := ctx.DB.Printing.Query().
+		Where(printing.ScryfallIDEQ(ctx.Request.URLParam("id"))).
+		QueryCardFace().
+		QueryCard().
+		First(ctx.Request.Context())
+	if bones.IsNotFound(err) {
+		return ctx.Response.WriteJSON(404, responses.NewNotFoundError("No card found with the given ID"))
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to query card: %w", err)
+	}
+
+	rulings, err := crd.QueryRulings().
+		Order(ruling.ByDate(), ruling.ByID()).
+		All(ctx.Request.Context())
 	if err != nil {
-		return fmt.Errorf("failed to query for cards: %w", err)
+		return fmt.Errorf("failed to query rulings: %w", err)
+	}
+
+	data := responses.RulingsFromDB(crd, rulings)
+
+	return ctx.Response.WriteJSON(200, responses.NewList(data, len(data), ""))
+}
+
+// CardByCollectorNumber looks up a single printing by its set code
+// and its collector number within that set, and optionally by its
+// language, such as "ja".  Without a language, the printing in English
+// is preferred if more than one language has been loaded.
+func CardByCollectorNumber(ctx *squid.Context) error {
+	preds := []predicate.Printing{
+		printing.HasSetWith(set.CodeEqualFold(ctx.Request.URLParam("code"))),
+		printing.CollectorNumberEQ(ctx.Request.URLParam("number")),
+	}
+
+	if lang := ctx.Request.URLParam("lang"); lang != "" {
+		preds = append(preds, printing.LanguageEqualFold(lang))
 	}
 
-	cards := responses.CardsFromDB(gotCards)
+	return writePrinting(ctx, preds...)
+}
 
-	return ctx.Response.WriteJSON(200, responses.CardSearch{
-		Cards: cards,
+// CardByMultiverseID looks up a single printing by one of its
+// multiverse IDs on Gatherer.
+func CardByMultiverseID(ctx *squid.Context) error {
+	multiverseID, err := strconv.Atoi(ctx.Request.URLParam("id"))
+	if err != nil {
+		return ctx.Response.WriteJSON(400, squid.NewErrorResponse(errors.New("multiverse ID must be an integer")))
+	}
+
+	return writePrinting(ctx, func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(printing.FieldMultiverseIds, multiverseID))
 	})
 }
+
+// writePrinting writes the first printing matching all of preds as a card,
+// preferring printings in English.  Multi-faced cards have a printing for
+// each face, which all share the same IDs, so the printing of the front
+// face is the one written.
+func writePrinting(ctx *squid.Context, preds ...predicate.Printing) error {
+	found, err := ctx.DB.Printing.Query().
+		Where(preds...).
+		Order(englishFirst, printing.ByID()).
+		WithSet().
+		WithArtist().
+		WithCardFace(func(q *bones.CardFaceQuery) {
+			q.WithCard(func(q *bones.CardQuery) {
+				q.WithFaces().WithLegalities()
+			})
+		}).
+		First(ctx.Request.Context())
+	if bones.IsNotFound(err) {
+		return ctx.Response.WriteJSON(404, responses.NewNotFoundError("No card found with the given ID or set code and collector number"))
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to query printing: %w", err)
+	}
+
+	return ctx.Response.WriteJSON(200, responses.CardFromPrinting(found))
+}
+
+// englishFirst orders printings in English before printings in any other language.
+func englishFirst(s *sql.Selector) {
+	s.OrderExpr(sql.ExprP(s.C(printing.FieldLanguage)+" <> ?", "en"))
+}
+
+// tokensLast orders cards that are tokens or emblems after any other cards.
+func tokensLast(s *sql.Selector) {
+	s.OrderExpr(sql.ExprP(s.C(card.FieldLayout)+" IN (?, ?, ?)", "token", "double_faced_token", "emblem"))
+}
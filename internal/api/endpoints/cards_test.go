@@ -0,0 +1,326 @@
+package endpoints_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/api/endpoints"
+	"github.com/SethCurry/stax/internal/api/responses"
+	"github.com/SethCurry/stax/internal/api/squid"
+	"github.com/SethCurry/stax/internal/autocomplete"
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/etl"
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestServer loads the cards in the bulk data fixture into a database,
+// and serves the API from it.
+func newTestServer(t *testing.T) (*bones.Client, *httptest.Server) {
+	t.Helper()
+
+	db := testutils.NewDB(t)
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+
+	fd, err := os.Open("../../../pkg/scryfall/test/cards.json")
+	require.NoError(t, err)
+
+	defer fd.Close()
+
+	reader, err := scryfall.NewBulkReader[scryfall.Card](fd)
+	require.NoError(t, err)
+
+	err = etl.ScryfallCards(ctx, zap.NewNop(), db, reader, etl.DefaultLoadOptions)
+	require.NoError(t, err)
+
+	index, err := autocomplete.LoadIndex(ctx, db)
+	require.NoError(t, err)
+
+	srv := squid.NewServer(db, zap.NewNop())
+	endpoints.Register(srv, index)
+
+	server := httptest.NewServer(srv)
+	t.Cleanup(server.Close)
+
+	return db, server
+}
+
+// getJSON sends a GET request to the server and decodes the response into into,
+// returning the status code.
+func getJSON(t *testing.T, server *httptest.Server, path string, into any) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(into))
+
+	return resp.StatusCode
+}
+
+func TestCardByName(t *testing.T) {
+	db, server := newTestServer(t)
+
+	ctx := context.Background()
+
+	// a token that shares its name with the token in the fixture,
+	// and a card that shares its name with both
+	db.Card.Create().SetName("Spirit").SetOracleID("spirit-token").SetLayout("token").SetColorIdentity(0).SaveX(ctx)
+	db.Card.Create().SetName("Spirit").SetOracleID("spirit-card").SetColorIdentity(0).SaveX(ctx)
+
+	var found struct {
+		Name     string `json:"name"`
+		OracleID string `json:"oracle_id"`
+	}
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/named?exact=Fury+Sliver", &found))
+	assert.Equal(t, "Fury Sliver", found.Name)
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/named?fuzzy=fury+sliv", &found))
+	assert.Equal(t, "Fury Sliver", found.Name)
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/named?exact=Spirit", &found))
+	assert.Equal(t, "spirit-card", found.OracleID, "cards are preferred over tokens with the same name")
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/named?fuzzy=spirit", &found))
+	assert.Equal(t, "spirit-card", found.OracleID)
+
+	var notFound responses.Error
+
+	require.Equal(t, http.StatusNotFound, getJSON(t, server, "/cards/named?exact=Fury", &notFound))
+	assert.Equal(t, "not_found", notFound.Code)
+	assert.Empty(t, notFound.Type)
+
+	require.Equal(t, http.StatusNotFound, getJSON(t, server, "/cards/named?fuzzy=zzzzzz", &notFound))
+	assert.Equal(t, "not_found", notFound.Code)
+
+	var ambiguous responses.Error
+
+	require.Equal(t, http.StatusNotFound, getJSON(t, server, "/cards/named?fuzzy=w", &ambiguous))
+	assert.Equal(t, "not_found", ambiguous.Code)
+	assert.Equal(t, "ambiguous", ambiguous.Type)
+}
+
+func TestCardRandom(t *testing.T) {
+	_, server := newTestServer(t)
+
+	names := make(map[string]bool)
+
+	for _, seed := range []string{"1", "2", "3", "4", "5"} {
+		var first, second struct {
+			Name string `json:"name"`
+		}
+
+		require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/random?seed="+seed, &first), "seed %s", seed)
+		require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/random?seed="+seed, &second), "seed %s", seed)
+
+		assert.NotEmpty(t, first.Name)
+		assert.Equal(t, first.Name, second.Name, "the same seed picks the same card")
+
+		names[first.Name] = true
+	}
+
+	assert.Greater(t, len(names), 1, "different seeds pick different cards")
+
+	var sliver struct {
+		Name string `json:"name"`
+	}
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/random?q=t:sliver", &sliver))
+	assert.Equal(t, "Fury Sliver", sliver.Name)
+
+	var notFound map[string]any
+
+	assert.Equal(t, http.StatusNotFound, getJSON(t, server, "/cards/random?q=t:nonexistenttype", &notFound))
+}
+
+func TestCardSearch_Printings(t *testing.T) {
+	_, server := newTestServer(t)
+
+	search := func(query url.Values) (int, []string) {
+		var list struct {
+			Data []struct {
+				Name string `json:"name"`
+			} `json:"data"`
+			TotalCards int `json:"total_cards"`
+		}
+
+		require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/search?"+query.Encode(), &list))
+
+		names := []string{}
+
+		for _, c := range list.Data {
+			names = append(names, c.Name)
+		}
+
+		return list.TotalCards, names
+	}
+
+	// the adventure card has a printing for each face, but is only returned once
+	total, names := search(url.Values{"q": {"cmc>=0"}, "unique": {"prints"}})
+	assert.Equal(t, 10, total)
+	assert.Len(t, names, 10)
+	assert.Contains(t, names, "Obyra's Attendants // Desperate Parry")
+
+	total, names = search(url.Values{"q": {"s:woe OR s:eld"}, "unique": {"art"}, "order": {"name"}})
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []string{"Obyra's Attendants // Desperate Parry", "Venerable Knight"}, names)
+}
+
+func TestCardSearch_Pager(t *testing.T) {
+	_, server := newTestServer(t)
+
+	client := scryfall.NewClient(server.Client(), scryfall.WithBaseURL(server.URL))
+
+	pager, err := client.Card.Search(context.Background(), "cmc>=0", scryfall.CardSearchOptions{
+		Order:    "name",
+		PageSize: 3,
+	})
+	require.NoError(t, err)
+
+	names := []string{}
+	pages := 0
+
+	for pager.HasMore() {
+		cards, err := pager.Next(context.Background())
+		require.NoError(t, err)
+
+		for _, c := range cards {
+			names = append(names, c.Name)
+		}
+
+		pages++
+	}
+
+	assert.Equal(t, 4, pages)
+	assert.Equal(t, []string{
+		"Fury Sliver",
+		"Kor Outfitter",
+		"Mystic Skyfish",
+		"Obyra's Attendants // Desperate Parry",
+		"Siren Lookout",
+		"Spirit",
+		"Surge of Brilliance",
+		"Venerable Knight",
+		"Web",
+		"Wildcall",
+	}, names)
+
+	_, err = pager.Next(context.Background())
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestCardSearch_CardsAlias(t *testing.T) {
+	_, server := newTestServer(t)
+
+	var list struct {
+		TotalCards int `json:"total_cards"`
+	}
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards?q=t:sliver", &list))
+	assert.Equal(t, 1, list.TotalCards)
+}
+
+func TestCardByCollectorNumber(t *testing.T) {
+	_, server := newTestServer(t)
+
+	var sliver scryfall.Card
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/tsp/157", &sliver))
+
+	assert.Equal(t, scryfall.ObjectCard, sliver.Object)
+	assert.Equal(t, "0000579f-7b35-4ed3-b44c-db2a538066fe", sliver.ID)
+	assert.Equal(t, "Fury Sliver", sliver.Name)
+	assert.Equal(t, "tsp", sliver.SetCode)
+	assert.Equal(t, "157", sliver.CollectorNumber)
+	assert.Equal(t, "uncommon", sliver.Rarity)
+	assert.Equal(t, "Paolo Parente", sliver.Artist)
+	assert.Equal(t, "2006-10-06", sliver.ReleasedAt.String())
+	assert.Equal(t, "{5}{R}", sliver.ManaCost)
+	assert.Equal(t, float32(6), sliver.CMC)
+	assert.Equal(t, []string{"R"}, sliver.Colors)
+	assert.Equal(t, []string{"R"}, sliver.ColorIdentity)
+	assert.Equal(t, scryfall.LegalityLegal, sliver.Legality.Legacy)
+	assert.Empty(t, sliver.CardFaces)
+
+	var attendants scryfall.Card
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/woe/63", &attendants))
+
+	assert.Equal(t, "0001e77a-7fff-49d2-a55c-42f6fdf6db08", attendants.ID)
+	assert.Equal(t, scryfall.LayoutAdventure, attendants.Layout)
+	assert.Equal(t, []int{629564}, attendants.MultiverseIDs)
+	assert.Equal(t, 116428, attendants.MTGOID)
+	assert.Equal(t, "Creature — Faerie Wizard // Instant — Adventure", attendants.TypeLine)
+	require.Len(t, attendants.CardFaces, 2)
+	assert.Equal(t, "Obyra's Attendants", attendants.CardFaces[0].Name)
+	assert.Equal(t, "{4}{U}", attendants.CardFaces[0].ManaCost)
+	assert.Equal(t, "Desperate Parry", attendants.CardFaces[1].Name)
+	assert.Equal(t, []string{"U"}, attendants.CardFaces[1].Colors)
+}
+
+func TestCardLookups_NotFound(t *testing.T) {
+	_, server := newTestServer(t)
+
+	for _, path := range []string{
+		"/cards/00000000-0000-0000-0000-000000000000",
+		"/cards/tsp/9999",
+		"/cards/tsp/157/ja",
+		"/cards/multiverse/1",
+	} {
+		var notFound scryfall.APIError
+
+		require.Equal(t, http.StatusNotFound, getJSON(t, server, path, &notFound), path)
+		assert.Equal(t, 404, notFound.Status, path)
+		assert.Equal(t, "not_found", notFound.Code, path)
+	}
+}
+
+func TestCardRulings(t *testing.T) {
+	db, server := newTestServer(t)
+
+	// newest first, to check that they're returned oldest first
+	const rulingsJSON = `[
+{"object":"ruling","oracle_id":"44623693-51d6-49ad-8cd7-140505caf02f","source":"scryfall","published_at":"2021-03-19","comment":"The second ruling."},
+{"object":"ruling","oracle_id":"44623693-51d6-49ad-8cd7-140505caf02f","source":"wotc","published_at":"2006-09-25","comment":"The first ruling."}
+]`
+
+	reader, err := scryfall.NewBulkReader[scryfall.Ruling](strings.NewReader(rulingsJSON))
+	require.NoError(t, err)
+
+	require.NoError(t, etl.ScryfallRulings(context.Background(), zap.NewNop(), db, reader))
+
+	var rulings scryfall.List[scryfall.Ruling]
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/0000579f-7b35-4ed3-b44c-db2a538066fe/rulings", &rulings))
+	require.Len(t, rulings.Data, 2)
+	assert.Equal(t, "The first ruling.", rulings.Data[0].Comment)
+	assert.Equal(t, "44623693-51d6-49ad-8cd7-140505caf02f", rulings.Data[0].OracleID)
+	assert.Equal(t, "The second ruling.", rulings.Data[1].Comment)
+
+	// Kor Outfitter has no rulings
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/cards/00006596-1166-4a79-8443-ca9f82e6db4e/rulings", &rulings))
+	assert.Empty(t, rulings.Data)
+
+	var notFound scryfall.APIError
+
+	require.Equal(t, http.StatusNotFound, getJSON(t, server, "/cards/00000000-0000-0000-0000-000000000000/rulings", &notFound))
+	assert.Equal(t, "not_found", notFound.Code)
+}
@@ -0,0 +1,24 @@
+package endpoints
+
+import (
+	"github.com/SethCurry/stax/internal/api/squid"
+	"github.com/SethCurry/stax/internal/autocomplete"
+)
+
+// Register registers every endpoint of the API on srv, at the same
+// paths as Scryfall's API.  Names are completed and fuzzy matched
+// against index.
+func Register(srv *squid.Server, index *autocomplete.Index) {
+	srv.Get("/cards/named", CardByName(index))
+	srv.Get("/cards/search", CardSearch)
+	srv.Get("/cards", CardSearch) // kept for clients of earlier versions
+	srv.Get("/cards/autocomplete", CardAutocomplete(index))
+	srv.Get("/cards/random", CardRandom)
+	srv.Get("/cards/multiverse/{id}", CardByMultiverseID)
+	srv.Get("/cards/{code}/{number}", CardByCollectorNumber)
+	srv.Get("/cards/{code}/{number}/{lang}", CardByCollectorNumber)
+	srv.Get("/cards/{id}", CardByID)
+	srv.Get("/cards/{id}/rulings", CardRulings)
+	srv.Get("/sets", SetList)
+	srv.Get("/sets/{code}", SetByCode)
+}
@@ -0,0 +1,42 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/SethCurry/stax/internal/api/responses"
+	"github.com/SethCurry/stax/internal/api/squid"
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/set"
+)
+
+// SetList lists every set, newest first, the same as Scryfall.
+func SetList(ctx *squid.Context) error {
+	sets, err := ctx.DB.Set.Query().
+		Order(set.ByReleasedAt(sql.OrderDesc(), sql.OrderNullsLast()), set.ByCode()).
+		All(ctx.Request.Context())
+	if err != nil {
+		return fmt.Errorf("failed to query sets: %w", err)
+	}
+
+	return ctx.Response.WriteJSON(200, responses.NewList(responses.SetsFromDB(sets), len(sets), ""))
+}
+
+// SetByCode looks up a single set by its code, or by its Scryfall ID.
+func SetByCode(ctx *squid.Context) error {
+	code := ctx.Request.URLParam("code")
+
+	found, err := ctx.DB.Set.Query().
+		Where(set.Or(set.CodeEqualFold(code), set.ScryfallIDEQ(code))).
+		First(ctx.Request.Context())
+	if bones.IsNotFound(err) {
+		return ctx.Response.WriteJSON(404, responses.NewNotFoundError(fmt.Sprintf("No set found with the code %q", code)))
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to query set: %w", err)
+	}
+
+	return ctx.Response.WriteJSON(200, responses.SetFromDB(found))
+}
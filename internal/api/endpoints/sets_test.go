@@ -0,0 +1,51 @@
+package endpoints_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/api/responses"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetList(t *testing.T) {
+	_, server := newTestServer(t)
+
+	var list scryfall.List[scryfall.Set]
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/sets", &list))
+
+	assert.Equal(t, 10, list.TotalCards)
+	require.Len(t, list.Data, 10)
+	assert.False(t, list.HasMore)
+
+	// newest first
+	assert.Equal(t, "who", list.Data[0].Code)
+	assert.Equal(t, "woe", list.Data[1].Code)
+	assert.Equal(t, "3ed", list.Data[9].Code)
+}
+
+func TestSetByCode(t *testing.T) {
+	_, server := newTestServer(t)
+
+	var found scryfall.Set
+
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/sets/WOE", &found))
+	assert.Equal(t, "woe", found.Code)
+	assert.Equal(t, "Wilds of Eldraine", found.Name)
+
+	byID := found.ID
+
+	require.NotEmpty(t, byID)
+	require.Equal(t, http.StatusOK, getJSON(t, server, "/sets/"+byID, &found))
+	assert.Equal(t, "woe", found.Code)
+
+	var notFound responses.Error
+
+	require.Equal(t, http.StatusNotFound, getJSON(t, server, "/sets/zzz", &notFound))
+	assert.Equal(t, "error", notFound.Object)
+	assert.Equal(t, 404, notFound.Status)
+	assert.Equal(t, "not_found", notFound.Code)
+}
@@ -2,9 +2,12 @@ package requests
 
 import (
 	"errors"
+	"fmt"
+	"math/rand/v2"
 
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/SethCurry/stax/internal/ql"
 )
 
 type CardByName struct {
@@ -24,16 +27,35 @@ func (c CardByName) Validate() error {
 	return nil
 }
 
+// ToPredicate returns a predicate matching cards with the exact name.
+// Fuzzy names are matched against the autocomplete index instead, so
+// they don't filter cards here.
 func (c CardByName) ToPredicate() predicate.Card {
 	if c.Exact != "" {
 		return card.NameEQ(c.Exact)
 	}
 
-	if c.Fuzzy != "" {
-		return card.NameContainsFold(c.Fuzzy)
+	return card.And()
+}
+
+type CardAutocomplete struct {
+	Query string `schema:"q"`
+}
+
+type CardRandom struct {
+	Query string `schema:"q"`
+	Seed  *int64 `schema:"seed"`
+}
+
+// Rand returns the source of randomness for picking a card.  If a
+// seed was provided, the same seed will always pick the same card
+// from the same database.
+func (c CardRandom) Rand() *rand.Rand {
+	if c.Seed != nil {
+		return rand.New(rand.NewPCG(uint64(*c.Seed), 0))
 	}
 
-	return card.And()
+	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
 }
 
 type CardSearch struct {
@@ -41,5 +63,71 @@ type CardSearch struct {
 }
 
 type CardQuery struct {
-	Query string `schema:"q"`
+	Query     string `schema:"q"`
+	Order     string `schema:"order"`
+	Direction string `schema:"dir"`
+	Unique    string `schema:"unique"`
+	Page      int    `schema:"page"`
+	PageSize  int    `schema:"page_size"`
+}
+
+const (
+	// DefaultPageSize is the number of cards on each page of search
+	// results, the same as Scryfall's.
+	DefaultPageSize = 175
+
+	// MaxPageSize is the largest page_size that can be requested.
+	MaxPageSize = 1000
+)
+
+// Pagination returns the 1-based page number and the page size of the
+// request, applying the defaults for any that weren't provided.
+func (c CardQuery) Pagination() (page int, pageSize int, err error) {
+	page, pageSize = c.Page, c.PageSize
+
+	if page == 0 {
+		page = 1
+	}
+
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	if page < 1 {
+		return 0, 0, errors.New("page must be at least 1")
+	}
+
+	if pageSize < 1 || pageSize > MaxPageSize {
+		return 0, 0, fmt.Errorf("page_size must be between 1 and %d", MaxPageSize)
+	}
+
+	return page, pageSize, nil
+}
+
+// SearchOptions parses the order, dir and unique parameters, using
+// ql.DefaultSearchOptions for any that weren't provided.
+func (c CardQuery) SearchOptions() (ql.SearchOptions, error) {
+	opts := ql.DefaultSearchOptions
+
+	var err error
+
+	if c.Order != "" {
+		if opts.Order, err = ql.ParseSortOrder(c.Order); err != nil {
+			return opts, err
+		}
+	}
+
+	if c.Direction != "" {
+		if opts.Direction, err = ql.ParseSortDirection(c.Direction); err != nil {
+			return opts, err
+		}
+	}
+
+	if c.Unique != "" {
+		if opts.Unique, err = ql.ParseUniqueMode(c.Unique); err != nil {
+			return opts, err
+		}
+	}
+
+	return opts, nil
 }
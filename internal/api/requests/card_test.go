@@ -35,7 +35,7 @@ func TestAddToSQL(t *testing.T) {
 		expected     string
 		expectedArgs []any
 	}{
-		{"fuzzy search", CardByName{"", "Fuzzy"}, "SELECT * FROM `cards` WHERE LOWER(`cards`.`name`) LIKE ?", []interface{}{"Fuzzy"}}, // You need to provide the expected SQL based on your actual implementation of card.NameEQ and card.NameContainsFold
+		{"fuzzy search", CardByName{"", "Fuzzy"}, "SELECT * FROM `cards`", []interface{}{}},
 		{"exact search", CardByName{"Exact", ""}, "SELECT * FROM `cards` WHERE `cards`.`name` = ?", []interface{}{"Exact"}},
 		{"invalid search", CardByName{"", ""}, "SELECT * FROM `cards`", []interface{}{}},
 	}
@@ -52,3 +52,38 @@ func TestAddToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestCardQuery_Pagination(t *testing.T) {
+	tables := []struct {
+		name     string
+		c        CardQuery
+		page     int
+		pageSize int
+		wantErr  bool
+	}{
+		{"defaults", CardQuery{}, 1, DefaultPageSize, false},
+		{"explicit", CardQuery{Page: 3, PageSize: 20}, 3, 20, false},
+		{"negative page", CardQuery{Page: -1}, 0, 0, true},
+		{"page size too large", CardQuery{PageSize: MaxPageSize + 1}, 0, 0, true},
+	}
+	for _, table := range tables {
+		t.Run(table.name, func(t *testing.T) {
+			page, pageSize, err := table.c.Pagination()
+
+			assert.Equal(t, table.wantErr, err != nil)
+			assert.Equal(t, table.page, page)
+			assert.Equal(t, table.pageSize, pageSize)
+		})
+	}
+}
+
+func TestCardRandom_Rand(t *testing.T) {
+	seed := int64(42)
+
+	first := CardRandom{Seed: &seed}.Rand()
+	second := CardRandom{Seed: &seed}.Rand()
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, first.IntN(1000), second.IntN(1000))
+	}
+}
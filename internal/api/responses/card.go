@@ -1,32 +1,78 @@
 package responses
 
 import (
+	"strings"
+	"time"
+
 	"github.com/SethCurry/scurry-go/fp"
 	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/pkg/stax"
 )
 
+// Card is a card in the same shape as Scryfall's card objects, so that
+// it can be decoded into a scryfall.Card.
+//
+// Cards that aren't returned as a particular printing, such as the
+// results of searches for unique cards, leave the printing's fields empty.
 type Card struct {
-	Name     string     `json:"name"`
-	OracleID string     `json:"oracle_id"`
-	Faces    []CardFace `json:"faces"`
+	Object   string `json:"object"`
+	ID       string `json:"id,omitempty"`
+	OracleID string `json:"oracle_id"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	Layout   string `json:"layout"`
+
+	SetCode         string `json:"set,omitempty"`
+	SetName         string `json:"set_name,omitempty"`
+	CollectorNumber string `json:"collector_number,omitempty"`
+	Rarity          string `json:"rarity,omitempty"`
+	Artist          string `json:"artist,omitempty"`
+	ReleasedAt      string `json:"released_at,omitempty"`
+	IllustrationID  string `json:"illustration_id,omitempty"`
+	MultiverseIDs   []int  `json:"multiverse_ids,omitempty"`
+	MTGOID          *int   `json:"mtgo_id,omitempty"`
+	ArenaID         *int   `json:"arena_id,omitempty"`
+	TCGPlayerID     *int   `json:"tcgplayer_id,omitempty"`
+
+	CMC           float32  `json:"cmc"`
+	ManaCost      string   `json:"mana_cost,omitempty"`
+	TypeLine      string   `json:"type_line"`
+	OracleText    string   `json:"oracle_text,omitempty"`
+	FlavorText    string   `json:"flavor_text,omitempty"`
+	Power         string   `json:"power,omitempty"`
+	Toughness     string   `json:"toughness,omitempty"`
+	Loyalty       string   `json:"loyalty,omitempty"`
+	Colors        []string `json:"colors,omitempty"`
+	ColorIdentity []string `json:"color_identity"`
+
+	// the card's text as it is printed, which is only set if it differs
+	// from its Oracle text, such as for printings in other languages
+	PrintedName     string `json:"printed_name,omitempty"`
+	PrintedText     string `json:"printed_text,omitempty"`
+	PrintedTypeLine string `json:"printed_type_line,omitempty"`
+
+	// CardFaces is only set for cards with more than one face.  Single-faced
+	// cards have the fields of their face on the card itself.
+	CardFaces  []CardFace        `json:"card_faces,omitempty"`
+	Legalities map[string]string `json:"legalities"`
 }
 
+// CardFace is a single face of a card with more than one face, in the
+// same shape as the faces of Scryfall's card objects.
 type CardFace struct {
-	Name       string  `json:"name"`
-	OracleText string  `json:"oracle_text"`
-	FlavorText string  `json:"flavor_text"`
-	Language   string  `json:"language"`
-	CMC        float32 `json:"cmc"`
-	Power      string  `json:"power"`
-	Toughness  string  `json:"toughness"`
-	Loyalty    string  `json:"loyalty"`
-	ManaCost   string  `json:"mana_cost"`
-	TypeLine   string  `json:"type_line"`
-	Colors     string  `json:"colors"`
-}
-
-type CardSearch struct {
-	Cards []Card `json:"cards"`
+	Object          string   `json:"object"`
+	Name            string   `json:"name"`
+	ManaCost        string   `json:"mana_cost"`
+	TypeLine        string   `json:"type_line"`
+	OracleText      string   `json:"oracle_text"`
+	FlavorText      string   `json:"flavor_text,omitempty"`
+	Power           string   `json:"power,omitempty"`
+	Toughness       string   `json:"toughness,omitempty"`
+	Loyalty         string   `json:"loyalty,omitempty"`
+	Colors          []string `json:"colors"`
+	PrintedName     string   `json:"printed_name,omitempty"`
+	PrintedText     string   `json:"printed_text,omitempty"`
+	PrintedTypeLine string   `json:"printed_type_line,omitempty"`
 }
 
 type CardQuery struct {
@@ -34,29 +80,138 @@ type CardQuery struct {
 }
 
 // CardFromDB converts a single Card to a Card response object.
+// The card must have been queried with its faces and legalities.
 func CardFromDB(crd *bones.Card) Card {
-	return Card{
-		Name:     crd.Name,
-		OracleID: crd.OracleID,
-		Faces: fp.Map(func(f *bones.CardFace) CardFace {
-			return CardFace{
-				Name:       f.Name,
-				OracleText: f.OracleText,
-				FlavorText: f.FlavorText,
-				Language:   f.Language,
-				CMC:        f.Cmc,
-				Power:      f.Power,
-				Toughness:  f.Toughness,
-				Loyalty:    f.Loyalty,
-				ManaCost:   f.ManaCost,
-				TypeLine:   f.TypeLine,
-				Colors:     f.Colors,
-			}
-		}, crd.Edges.Faces),
+	return cardFromDB(crd, nil)
+}
+
+// cardFromDB converts a card to a Card response object.  If prt is
+// set, the text of its face is taken from the printing, since that
+// is the text printed on it.
+func cardFromDB(crd *bones.Card, prt *bones.Printing) Card {
+	ret := Card{
+		Object:        "card",
+		OracleID:      crd.OracleID,
+		Name:          crd.Name,
+		Layout:        crd.Layout,
+		ColorIdentity: stax.ColorField(crd.ColorIdentity).Chars(),
+		Legalities:    legalitiesFromDB(crd.Edges.Legalities),
+	}
+
+	faces := fp.Map(func(f *bones.CardFace) CardFace {
+		face := CardFace{
+			Object:     "card_face",
+			Name:       f.Name,
+			ManaCost:   f.ManaCost,
+			TypeLine:   f.TypeLine,
+			OracleText: f.OracleText,
+			FlavorText: f.FlavorText,
+			Power:      f.Power,
+			Toughness:  f.Toughness,
+			Loyalty:    f.Loyalty,
+			Colors:     stax.ColorField(f.ColorField).Chars(),
+		}
+
+		if prt != nil && prt.Edges.CardFace != nil && prt.Edges.CardFace.ID == f.ID {
+			face.FlavorText = prt.FlavorText
+			face.PrintedName = prt.PrintedName
+			face.PrintedText = prt.PrintedText
+			face.PrintedTypeLine = prt.PrintedTypeLine
+		}
+
+		return face
+	}, crd.Edges.Faces)
+
+	if len(crd.Edges.Faces) > 0 {
+		ret.Lang = crd.Edges.Faces[0].Language
+		ret.CMC = crd.Edges.Faces[0].Cmc
+	}
+
+	if len(faces) == 1 {
+		ret.ManaCost = faces[0].ManaCost
+		ret.TypeLine = faces[0].TypeLine
+		ret.OracleText = faces[0].OracleText
+		ret.FlavorText = faces[0].FlavorText
+		ret.Power = faces[0].Power
+		ret.Toughness = faces[0].Toughness
+		ret.Loyalty = faces[0].Loyalty
+		ret.Colors = faces[0].Colors
+		ret.PrintedName = faces[0].PrintedName
+		ret.PrintedText = faces[0].PrintedText
+		ret.PrintedTypeLine = faces[0].PrintedTypeLine
+
+		return ret
+	}
+
+	// like Scryfall, cards with more than one face have the type lines of
+	// all of their faces, and the colors of any of their faces
+	var colors stax.ColorField
+
+	for _, f := range crd.Edges.Faces {
+		colors |= stax.ColorField(f.ColorField)
+	}
+
+	ret.TypeLine = strings.Join(fp.Map(func(f CardFace) string { return f.TypeLine }, faces), " // ")
+	ret.Colors = colors.Chars()
+	ret.CardFaces = faces
+
+	return ret
+}
+
+// legalitiesFromDB converts a card's legalities into a map of format
+// to legality, the same shape as Scryfall's "legalities" field.
+func legalitiesFromDB(legalities []*bones.Legality) map[string]string {
+	ret := make(map[string]string, len(legalities))
+
+	for _, l := range legalities {
+		ret[l.Format] = string(l.Legality)
 	}
+
+	return ret
 }
 
 // CardsFromDB converts a slice of database cards to Card response objects.
 func CardsFromDB(crds []*bones.Card) []Card {
 	return fp.Map(CardFromDB, crds)
 }
+
+// CardFromPrinting converts a printing to a Card response object
+// describing that printing.  The printing must have been queried with
+// its set, artist, card face and card.
+func CardFromPrinting(prt *bones.Printing) Card {
+	ret := Card{Object: "card"}
+
+	if prt.Edges.CardFace != nil && prt.Edges.CardFace.Edges.Card != nil {
+		ret = cardFromDB(prt.Edges.CardFace.Edges.Card, prt)
+	}
+
+	ret.ID = prt.ScryfallID
+	ret.Lang = prt.Language
+	ret.CollectorNumber = prt.CollectorNumber
+	ret.Rarity = string(prt.Rarity)
+	ret.IllustrationID = prt.IllustrationID
+	ret.MultiverseIDs = prt.MultiverseIds
+	ret.MTGOID = prt.MtgoID
+	ret.ArenaID = prt.ArenaID
+	ret.TCGPlayerID = prt.TcgplayerID
+
+	if prt.Edges.Set != nil {
+		ret.SetCode = prt.Edges.Set.Code
+		ret.SetName = prt.Edges.Set.Name
+	}
+
+	if prt.Edges.Artist != nil {
+		ret.Artist = prt.Edges.Artist.Name
+	}
+
+	if prt.ReleasedAt != nil {
+		ret.ReleasedAt = prt.ReleasedAt.Format(time.DateOnly)
+	}
+
+	return ret
+}
+
+// CardsFromPrintings converts a slice of database printings to Card response objects.
+func CardsFromPrintings(prts []*bones.Printing) []Card {
+	return fp.Map(CardFromPrinting, prts)
+}
@@ -0,0 +1,18 @@
+package responses
+
+// Catalog is a list of strings, in the same shape as Scryfall's
+// catalog objects.
+type Catalog struct {
+	Object      string   `json:"object"`
+	TotalValues int      `json:"total_values"`
+	Data        []string `json:"data"`
+}
+
+// NewCatalog creates a new Catalog holding data.
+func NewCatalog(data []string) Catalog {
+	return Catalog{
+		Object:      "catalog",
+		TotalValues: len(data),
+		Data:        data,
+	}
+}
@@ -0,0 +1,74 @@
+package responses
+
+import (
+	"errors"
+
+	"github.com/SethCurry/stax/internal/ql"
+)
+
+// Error is an error in the same shape as Scryfall's error objects.
+type Error struct {
+	Object  string `json:"object"`
+	Code    string `json:"code"`
+	Status  int    `json:"status"`
+	Type    string `json:"type,omitempty"`
+	Details string `json:"details"`
+}
+
+// NewNotFoundError creates an Error for a request that found nothing.
+func NewNotFoundError(details string) Error {
+	return Error{
+		Object:  "error",
+		Code:    "not_found",
+		Status:  404,
+		Details: details,
+	}
+}
+
+// NewAmbiguousError creates an Error for a request that found more than
+// one result when only one was expected.
+func NewAmbiguousError(details string) Error {
+	ret := NewNotFoundError(details)
+	ret.Type = "ambiguous"
+
+	return ret
+}
+
+// QueryError is returned when a search query fails to parse.  It
+// describes where in the query the problem is so that clients can
+// highlight it.
+type QueryError struct {
+	Error       string   `json:"error"`
+	Query       string   `json:"query"`
+	Offset      int      `json:"offset"`
+	Length      int      `json:"length"`
+	Expected    []string `json:"expected"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// QueryErrorFromErr converts the *ql.QueryError wrapped in err to a
+// QueryError response.  It returns false if err doesn't wrap one.
+func QueryErrorFromErr(err error) (QueryError, bool) {
+	var queryErr *ql.QueryError
+	if !errors.As(err, &queryErr) {
+		return QueryError{}, false
+	}
+
+	return QueryError{
+		Error:       queryErr.Error(),
+		Query:       queryErr.Query,
+		Offset:      queryErr.Offset,
+		Length:      queryErr.Length,
+		Expected:    nonNilStrings(queryErr.Expected),
+		Suggestions: nonNilStrings(queryErr.Suggestions),
+	}, true
+}
+
+// nonNilStrings makes sure empty lists are marshalled as [] instead of null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,24 @@
+package responses
+
+// List is a single page of results, in the same shape as Scryfall's
+// list objects so that Scryfall clients can page through it.
+type List[T any] struct {
+	Object     string   `json:"object"`
+	Data       []T      `json:"data"`
+	HasMore    bool     `json:"has_more"`
+	NextPage   string   `json:"next_page,omitempty"`
+	TotalCards int      `json:"total_cards"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+// NewList creates a new List holding one page of data.
+// nextPage should be empty when there are no more pages.
+func NewList[T any](data []T, total int, nextPage string) List[T] {
+	return List[T]{
+		Object:     "list",
+		Data:       data,
+		HasMore:    nextPage != "",
+		NextPage:   nextPage,
+		TotalCards: total,
+	}
+}
@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/SethCurry/scurry-go/fp"
+	"github.com/SethCurry/stax/internal/bones"
+)
+
+// Ruling is a single ruling on a card, in the same shape as Scryfall's
+// ruling objects.
+type Ruling struct {
+	Object      string `json:"object"`
+	OracleID    string `json:"oracle_id"`
+	Source      string `json:"source"`
+	PublishedAt string `json:"published_at"`
+	Comment     string `json:"comment"`
+}
+
+// RulingsFromDB converts the rulings of a card to Ruling response objects.
+func RulingsFromDB(crd *bones.Card, rulings []*bones.Ruling) []Ruling {
+	return fp.Map(func(r *bones.Ruling) Ruling {
+		return Ruling{
+			Object:      "ruling",
+			OracleID:    crd.OracleID,
+			Source:      string(r.Source),
+			PublishedAt: r.Date.Format(time.DateOnly),
+			Comment:     r.Text,
+		}
+	}, rulings)
+}
@@ -0,0 +1,52 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/SethCurry/scurry-go/fp"
+	"github.com/SethCurry/stax/internal/bones"
+)
+
+// Set is a set of cards, in the same shape as Scryfall's set objects.
+type Set struct {
+	Object        string `json:"object"`
+	ID            string `json:"id"`
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	SetType       string `json:"set_type"`
+	ReleasedAt    string `json:"released_at,omitempty"`
+	BlockCode     string `json:"block_code,omitempty"`
+	Block         string `json:"block,omitempty"`
+	ParentSetCode string `json:"parent_set_code,omitempty"`
+	CardCount     int    `json:"card_count"`
+	Digital       bool   `json:"digital"`
+	IconSVGURI    string `json:"icon_svg_uri"`
+}
+
+// SetFromDB converts a single Set to a Set response object.
+func SetFromDB(s *bones.Set) Set {
+	ret := Set{
+		Object:        "set",
+		ID:            s.ScryfallID,
+		Code:          s.Code,
+		Name:          s.Name,
+		SetType:       s.SetType,
+		BlockCode:     s.BlockCode,
+		Block:         s.Block,
+		ParentSetCode: s.ParentSetCode,
+		CardCount:     s.CardCount,
+		Digital:       s.Digital,
+		IconSVGURI:    s.IconSvgURI,
+	}
+
+	if s.ReleasedAt != nil {
+		ret.ReleasedAt = s.ReleasedAt.Format(time.DateOnly)
+	}
+
+	return ret
+}
+
+// SetsFromDB converts a slice of database sets to Set response objects.
+func SetsFromDB(sets []*bones.Set) []Set {
+	return fp.Map(SetFromDB, sets)
+}
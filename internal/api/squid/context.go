@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/schema"
 
 	"github.com/SethCurry/stax/internal/bones"
@@ -26,6 +28,27 @@ func (r *RequestContext) Context() context.Context {
 	return r.req.Context()
 }
 
+// URL returns an absolute copy of the request's URL, which can be
+// modified to build links to other pages of the same endpoint.
+func (r *RequestContext) URL() *url.URL {
+	ret := *r.req.URL
+
+	ret.Host = r.req.Host
+	ret.Scheme = "http"
+
+	if r.req.TLS != nil {
+		ret.Scheme = "https"
+	}
+
+	return &ret
+}
+
+// URLParam returns the value of a parameter in the request's path,
+// e.g. "id" for a handler registered on "/cards/{id}".
+func (r *RequestContext) URLParam(name string) string {
+	return chi.URLParam(r.req, name)
+}
+
 // UnmarshalJSON unmarshals the contents of the request body into the
 // provided struct.
 func (r *RequestContext) UnmarshalJSON(into interface{}) error {
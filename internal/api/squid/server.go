@@ -76,6 +76,12 @@ func (s *Server) Get(pattern string, handler HandlerFunc) {
 	s.router.Get(pattern, s.wrapHandler(handler))
 }
 
+// ServeHTTP serves a single request with the registered handlers, so
+// that the server can be used as an http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.router.ServeHTTP(w, req)
+}
+
 func (s *Server) Serve(listen string) error {
 	return http.ListenAndServe(listen, s.router)
 }
@@ -85,7 +91,7 @@ func (s *Server) errorResponse(w http.ResponseWriter, gotErr error) {
 	errResponse := NewErrorResponse(gotErr)
 
 	if bones.IsNotFound(gotErr) {
-		statusCode = 400
+		statusCode = 404
 		errResponse = &ErrorResponse{
 			Err: "no results found",
 		}
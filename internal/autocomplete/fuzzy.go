@@ -0,0 +1,143 @@
+package autocomplete
+
+import (
+	"sort"
+	"strings"
+)
+
+// fuzzyScore is how closely a name matches a fuzzy query.
+// Lower scores are closer matches.
+type fuzzyScore struct {
+	// typos is the total edit distance of words with typos.
+	typos int
+
+	// partial is the number of words that only matched the start of a word.
+	partial int
+
+	// incomplete is whether there were words in the name that weren't in the query.
+	incomplete bool
+}
+
+func (f fuzzyScore) less(other fuzzyScore) bool {
+	if f.typos != other.typos {
+		return f.typos < other.typos
+	}
+
+	if f.partial != other.partial {
+		return f.partial < other.partial
+	}
+
+	return !f.incomplete && other.incomplete
+}
+
+// Fuzzy returns the names that most closely match query, in the same way as
+// Scryfall's fuzzy name search.  More than one name is returned, sorted, if
+// several match equally well, and none are returned if nothing is close.
+//
+// Names match if they contain the words of the query in the same order, where
+// each word of the query can be the start of a word in the name, and can have
+// a few typos.  Words can be left out of the query, so "jace mind sculptor"
+// matches "Jace, the Mind Sculptor".  Multi-faced cards also match the name
+// of each of their faces.
+//
+// Names with the fewest typos are the best matches, followed by names with
+// the fewest partial words, and then names with no words left out.  This
+// means an exact match is always the best.
+func (i *Index) Fuzzy(query string) []string {
+	words := strings.Fields(normalize(query))
+	if len(words) == 0 {
+		return []string{}
+	}
+
+	var best *fuzzyScore
+
+	names := []string{}
+
+	for idx := range i.entries {
+		e := &i.entries[idx]
+
+		score, ok := fuzzyMatch(words, e.variants)
+		if !ok {
+			continue
+		}
+
+		if best == nil || score.less(*best) {
+			best = &score
+			names = names[:0]
+		}
+
+		if !best.less(score) {
+			names = append(names, e.name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// fuzzyMatch returns the best score of the query's words against any
+// variant of a name, and false if none of them match.
+func fuzzyMatch(words []string, variants [][]string) (fuzzyScore, bool) {
+	var best fuzzyScore
+
+	found := false
+
+	for _, variant := range variants {
+		score, ok := fuzzyMatchWords(words, variant)
+		if ok && (!found || score.less(best)) {
+			best = score
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// fuzzyMatchWords matches each word of the query, in order, against the
+// first word of the name it is close enough to.
+func fuzzyMatchWords(words []string, nameWords []string) (fuzzyScore, bool) {
+	if len(words) > len(nameWords) {
+		return fuzzyScore{}, false
+	}
+
+	score := fuzzyScore{}
+	next := 0
+
+	for _, word := range words {
+		maxDistance := maxTypos(word)
+		scratch := make([]int, 2*(len(word)+maxDistance+1))
+		found := false
+
+		for ; next < len(nameWords); next++ {
+			nameWord := nameWords[next]
+
+			if nameWord == word {
+				found = true
+			} else if strings.HasPrefix(nameWord, word) {
+				score.partial++
+				found = true
+			} else if distance := prefixDistance(word, nameWord, maxDistance, scratch); distance <= maxDistance {
+				score.typos += distance
+				found = true
+			} else {
+				score.incomplete = true
+			}
+
+			if found {
+				next++
+				break
+			}
+		}
+
+		if !found {
+			return fuzzyScore{}, false
+		}
+	}
+
+	if next < len(nameWords) {
+		score.incomplete = true
+	}
+
+	return score, true
+}
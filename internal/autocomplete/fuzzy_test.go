@@ -0,0 +1,40 @@
+package autocomplete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex_Fuzzy(t *testing.T) {
+	index := NewIndex(append([]string{
+		"Jace, the Mind Sculptor",
+		"Jace Beleren",
+		"Delver of Secrets // Insectile Aberration",
+		"Boltwing Marauder",
+	}, testNames...))
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{"exact", "Lightning Bolt", []string{"Lightning Bolt"}},
+		{"exact beats longer names", "lightning", []string{"Lightning"}},
+		{"partial words", "lightning bol", []string{"Lightning Bolt"}},
+		{"typos", "lightnig bolt", []string{"Lightning Bolt"}},
+		{"left out words", "jace mind sculptor", []string{"Jace, the Mind Sculptor"}},
+		{"punctuation and accents", "LIM DULS VAULT", []string{"Lim-Dûl's Vault"}},
+		{"whole words beat partial words", "bolt", []string{"Lightning Bolt"}},
+		{"front face", "delver of secrets", []string{"Delver of Secrets // Insectile Aberration"}},
+		{"back face", "insectile aberation", []string{"Delver of Secrets // Insectile Aberration"}},
+		{"ambiguous", "jace", []string{"Jace Beleren", "Jace, the Mind Sculptor"}},
+		{"no matches", "zzzzzz", []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, index.Fuzzy(tc.query))
+		})
+	}
+}
@@ -0,0 +1,359 @@
+// Package autocomplete provides an in-memory index of card names for
+// completing partially typed names, and for finding misspelled ones.
+package autocomplete
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/card"
+)
+
+const (
+	// MaxResults is the most names a search will return, the same as
+	// Scryfall's autocomplete API.
+	MaxResults = 20
+
+	// MinQueryLength is the shortest query that will return results.
+	// Shorter queries match far too many names to be useful.
+	MinQueryLength = 2
+)
+
+// rank is how well a name matches a query.  Lower ranks are better matches.
+type rank int
+
+const (
+	rankPrefix rank = iota
+	rankWordStart
+	rankFuzzy
+)
+
+// entry is a single name in the index.
+type entry struct {
+	name       string
+	normalized string
+
+	// variants holds the words of the whole name, followed by the words of
+	// each face's name if the card has more than one face.
+	variants [][]string
+}
+
+// key is the start of a word in a name.  Keys are kept sorted by text, so
+// that every word starting with a query can be found with a binary search.
+type key struct {
+	text     string
+	entry    int
+	position int
+}
+
+// match is an entry that matched a query, and how well it matched.
+type match struct {
+	entry    *entry
+	rank     rank
+	position int
+	distance int
+}
+
+// Index is an in-memory index of names.  It is safe for concurrent use,
+// as it is never modified after it is created.
+type Index struct {
+	entries []entry
+	keys    []key
+}
+
+// NewIndex creates a new *Index of the provided names.  Names that are
+// provided more than once, such as tokens with the same name as each
+// other, are only indexed once.
+func NewIndex(names []string) *Index {
+	entries := make([]entry, 0, len(names))
+	keys := make([]key, 0, len(names)*2)
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		normalized := normalize(name)
+		entryIdx := len(entries)
+
+		variants := [][]string{strings.Fields(normalized)}
+
+		if faces := strings.Split(name, "//"); len(faces) > 1 {
+			for _, face := range faces {
+				variants = append(variants, strings.Fields(normalize(face)))
+			}
+		}
+
+		entries = append(entries, entry{
+			name:       name,
+			normalized: normalized,
+			variants:   variants,
+		})
+
+		for i := 0; i < len(normalized); i++ {
+			if i == 0 || normalized[i-1] == ' ' {
+				keys = append(keys, key{text: normalized[i:], entry: entryIdx, position: i})
+			}
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].text < keys[j].text
+	})
+
+	return &Index{entries: entries, keys: keys}
+}
+
+// LoadIndex creates a new *Index of the names of every card in the database.
+func LoadIndex(ctx context.Context, db *bones.Client) (*Index, error) {
+	names, err := db.Card.Query().Select(card.FieldName).Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query card names: %w", err)
+	}
+
+	return NewIndex(names), nil
+}
+
+// Len returns the number of names in the index.
+func (i *Index) Len() int {
+	return len(i.entries)
+}
+
+// Search returns up to limit names matching query, best matches first.
+//
+// Names that start with the query are the best matches, followed by names
+// with a word that starts with the query, and then names that start with
+// something within a few typos of the query.  Case, punctuation and
+// accents are ignored.
+func (i *Index) Search(query string, limit int) []string {
+	query = normalize(query)
+
+	if len(query) < MinQueryLength || limit <= 0 {
+		return []string{}
+	}
+
+	matches := []match{}
+
+	// one more than the index in matches of every entry that has matched,
+	// as a name can have more than one word starting with the query
+	matched := make([]int, len(i.entries))
+
+	first := sort.Search(len(i.keys), func(idx int) bool {
+		return i.keys[idx].text >= query
+	})
+
+	for idx := first; idx < len(i.keys) && strings.HasPrefix(i.keys[idx].text, query); idx++ {
+		k := i.keys[idx]
+
+		m := match{entry: &i.entries[k.entry], rank: rankWordStart, position: k.position}
+		if k.position == 0 {
+			m.rank = rankPrefix
+		}
+
+		if existing := matched[k.entry]; existing != 0 {
+			if m.position < matches[existing-1].position {
+				matches[existing-1] = m
+			}
+
+			continue
+		}
+
+		matches = append(matches, m)
+		matched[k.entry] = len(matches)
+	}
+
+	// typos are only worth looking for if there aren't enough real matches
+	if len(matches) < limit {
+		maxDistance := maxTypos(query)
+		scratch := make([]int, 2*(len(query)+maxDistance+1))
+
+		for idx := range i.entries {
+			if matched[idx] != 0 {
+				continue
+			}
+
+			distance := prefixDistance(query, i.entries[idx].normalized, maxDistance, scratch)
+			if distance <= maxDistance {
+				matches = append(matches, match{entry: &i.entries[idx], rank: rankFuzzy, distance: distance})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		left, right := matches[a], matches[b]
+
+		if left.rank != right.rank {
+			return left.rank < right.rank
+		}
+
+		switch left.rank {
+		case rankPrefix:
+			if len(left.entry.name) != len(right.entry.name) {
+				return len(left.entry.name) < len(right.entry.name)
+			}
+		case rankWordStart:
+			if left.position != right.position {
+				return left.position < right.position
+			}
+		case rankFuzzy:
+			if left.distance != right.distance {
+				return left.distance < right.distance
+			}
+		}
+
+		return left.entry.name < right.entry.name
+	})
+
+	names := []string{}
+
+	for idx := 0; idx < len(matches) && idx < limit; idx++ {
+		names = append(names, matches[idx].entry.name)
+	}
+
+	return names
+}
+
+// maxTypos returns the largest edit distance that is still considered
+// a match for a query, which grows with the length of the query.
+func maxTypos(query string) int {
+	switch {
+	case len(query) < 4:
+		return 0
+	case len(query) < 8:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// prefixDistance returns the smallest edit distance between query and any
+// start of name, which will be more than maxDistance if there is no start
+// of name within maxDistance edits of query.
+//
+// Only the cells of the edit distance matrix within maxDistance of its
+// diagonal are computed, and it gives up as soon as a whole row is over
+// maxDistance.  scratch must hold at least 2*(len(query)+maxDistance+1) ints.
+func prefixDistance(query string, name string, maxDistance int, scratch []int) int {
+	over := maxDistance + 1
+
+	width := len(query) + maxDistance
+	if width > len(name) {
+		width = len(name)
+	}
+
+	if width < len(query)-maxDistance {
+		return over
+	}
+
+	prev := scratch[:width+1]
+	curr := scratch[width+1 : 2*(width+1)]
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(query); i++ {
+		low := max(1, i-maxDistance)
+		high := min(width, i+maxDistance)
+
+		rowMin := over
+
+		curr[0] = i
+		if low > 1 {
+			curr[low-1] = over
+		}
+
+		for j := low; j <= high; j++ {
+			cost := 1
+			if query[i-1] == name[j-1] {
+				cost = 0
+			}
+
+			best := prev[j-1] + cost
+
+			if j-1 >= i-maxDistance && curr[j-1]+1 < best {
+				best = curr[j-1] + 1
+			}
+
+			if j <= i-1+maxDistance && prev[j]+1 < best {
+				best = prev[j] + 1
+			}
+
+			curr[j] = min(best, over)
+			rowMin = min(rowMin, curr[j])
+		}
+
+		if high < width {
+			curr[high+1] = over
+		}
+
+		if curr[0] < rowMin {
+			rowMin = curr[0]
+		}
+
+		if rowMin > maxDistance {
+			return over
+		}
+
+		prev, curr = curr, prev
+	}
+
+	best := over
+
+	for j := max(0, len(query)-maxDistance); j <= width; j++ {
+		best = min(best, prev[j])
+	}
+
+	return best
+}
+
+// normalize lowercases a name, removes punctuation and accents, and
+// collapses any whitespace into single spaces.
+func normalize(name string) string {
+	var builder strings.Builder
+
+	space := false
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsSpace(r) || r == '-' || r == '/':
+			space = builder.Len() > 0
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if space {
+				builder.WriteByte(' ')
+				space = false
+			}
+
+			builder.WriteRune(r)
+		case unicode.IsLetter(r):
+			if folded, ok := accents[r]; ok {
+				if space {
+					builder.WriteByte(' ')
+					space = false
+				}
+
+				builder.WriteString(folded)
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// accents maps the accented letters used in card names to their plain
+// ASCII equivalents, so "Lim-Dul" finds "Lim-Dûl".
+var accents = map[rune]string{
+	'á': "a", 'à': "a", 'â': "a", 'ä': "a", 'ã': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e",
+	'í': "i", 'ì': "i", 'î': "i", 'ï': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'ö': "o", 'õ': "o",
+	'ú': "u", 'ù': "u", 'û': "u", 'ü': "u",
+	'ñ': "n", 'ç': "c", 'æ': "ae",
+}
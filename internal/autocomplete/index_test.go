@@ -0,0 +1,135 @@
+package autocomplete
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNames = []string{
+	"Lightning Bolt",
+	"Lightning Helix",
+	"Lightning",
+	"Chain Lightning",
+	"Ball Lightning",
+	"Black Lotus",
+	"Lotus Petal",
+	"Lim-Dûl's Vault",
+	"Jötun Grunt",
+	"Urza's Saga",
+	"Fire // Ice",
+}
+
+func TestIndex_Search(t *testing.T) {
+	index := NewIndex(testNames)
+
+	testCases := []struct {
+		name     string
+		query    string
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "prefix matches shortest first",
+			query:    "light",
+			limit:    MaxResults,
+			expected: []string{"Lightning", "Lightning Bolt", "Lightning Helix", "Ball Lightning", "Chain Lightning"},
+		},
+		{
+			name:     "word start after prefix",
+			query:    "lotus",
+			limit:    MaxResults,
+			expected: []string{"Lotus Petal", "Black Lotus"},
+		},
+		{
+			name:     "typos",
+			query:    "lightnign b",
+			limit:    MaxResults,
+			expected: []string{"Lightning Bolt"},
+		},
+		{
+			name:     "case and punctuation",
+			query:    "URZAS",
+			limit:    MaxResults,
+			expected: []string{"Urza's Saga"},
+		},
+		{
+			name:     "accents",
+			query:    "lim-dul",
+			limit:    MaxResults,
+			expected: []string{"Lim-Dûl's Vault"},
+		},
+		{
+			name:     "split cards",
+			query:    "ice",
+			limit:    MaxResults,
+			expected: []string{"Fire // Ice"},
+		},
+		{
+			name:     "limit",
+			query:    "lightning",
+			limit:    2,
+			expected: []string{"Lightning", "Lightning Bolt"},
+		},
+		{
+			name:     "too short",
+			query:    "l",
+			limit:    MaxResults,
+			expected: []string{},
+		},
+		{
+			name:     "no matches",
+			query:    "zzzzzz",
+			limit:    MaxResults,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, index.Search(tc.query, tc.limit))
+		})
+	}
+}
+
+func BenchmarkIndex_Search(b *testing.B) {
+	// roughly the number of unique cards in Scryfall's oracle cards export
+	names := make([]string, 0, 30000)
+
+	for i := 0; i < 30000; i++ {
+		names = append(names, fmt.Sprintf("%s %s %d", testNames[i%len(testNames)], testNames[(i/len(testNames))%len(testNames)], i))
+	}
+
+	index := NewIndex(names)
+
+	for _, query := range []string{"lightning b", "lotsu", "zzzzzzzz"} {
+		b.Run(query, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				index.Search(query, MaxResults)
+			}
+		})
+	}
+}
+
+func TestLoadIndex_SharedNames(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// tokens from different sets can share a name but not an Oracle ID
+	db.Card.Create().SetName("Soldier").SetOracleID("soldier-1").SetColorIdentity(0).SaveX(ctx)
+	db.Card.Create().SetName("Soldier").SetOracleID("soldier-2").SetColorIdentity(0).SaveX(ctx)
+	db.Card.Create().SetName("Soldier of Fortune").SetOracleID("fortune").SetColorIdentity(0).SaveX(ctx)
+
+	index, err := LoadIndex(ctx, db)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, index.Len())
+	assert.Equal(t, []string{"Soldier", "Soldier of Fortune"}, index.Search("soldier", MaxResults))
+	assert.Equal(t, []string{"Soldier"}, index.Fuzzy("soldier"))
+}
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
+)
+
+// BulkImport is the model entity for the BulkImport schema.
+type BulkImport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ImportedAt holds the value of the "imported_at" field.
+	ImportedAt   time.Time `json:"imported_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BulkImport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bulkimport.FieldID:
+			values[i] = new(sql.NullInt64)
+		case bulkimport.FieldType:
+			values[i] = new(sql.NullString)
+		case bulkimport.FieldUpdatedAt, bulkimport.FieldImportedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BulkImport fields.
+func (bi *BulkImport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bulkimport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bi.ID = int(value.Int64)
+		case bulkimport.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				bi.Type = value.String
+			}
+		case bulkimport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bi.UpdatedAt = value.Time
+			}
+		case bulkimport.FieldImportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_at", values[i])
+			} else if value.Valid {
+				bi.ImportedAt = value.Time
+			}
+		default:
+			bi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BulkImport.
+// This includes values selected through modifiers, order, etc.
+func (bi *BulkImport) Value(name string) (ent.Value, error) {
+	return bi.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BulkImport.
+// Note that you need to call BulkImport.Unwrap() before calling this method if this BulkImport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bi *BulkImport) Update() *BulkImportUpdateOne {
+	return NewBulkImportClient(bi.config).UpdateOne(bi)
+}
+
+// Unwrap unwraps the BulkImport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bi *BulkImport) Unwrap() *BulkImport {
+	_tx, ok := bi.config.driver.(*txDriver)
+	if !ok {
+		panic("bones: BulkImport is not a transactional entity")
+	}
+	bi.config.driver = _tx.drv
+	return bi
+}
+
+// String implements the fmt.Stringer.
+func (bi *BulkImport) String() string {
+	var builder strings.Builder
+	builder.WriteString("BulkImport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bi.ID))
+	builder.WriteString("type=")
+	builder.WriteString(bi.Type)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bi.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("imported_at=")
+	builder.WriteString(bi.ImportedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BulkImports is a parsable slice of BulkImport.
+type BulkImports []*BulkImport
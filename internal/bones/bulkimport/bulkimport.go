@@ -0,0 +1,68 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bulkimport
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the bulkimport type in the database.
+	Label = "bulk_import"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldImportedAt holds the string denoting the imported_at field in the database.
+	FieldImportedAt = "imported_at"
+	// Table holds the table name of the bulkimport in the database.
+	Table = "bulk_imports"
+)
+
+// Columns holds all SQL columns for bulkimport fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldUpdatedAt,
+	FieldImportedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BulkImport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByImportedAt orders the results by the imported_at field.
+func ByImportedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedAt, opts...).ToFunc()
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bulkimport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLTE(FieldID, id))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldType, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ImportedAt applies equality check predicate on the "imported_at" field. It's identical to ImportedAtEQ.
+func ImportedAt(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldImportedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldContainsFold(FieldType, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ImportedAtEQ applies the EQ predicate on the "imported_at" field.
+func ImportedAtEQ(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldEQ(FieldImportedAt, v))
+}
+
+// ImportedAtNEQ applies the NEQ predicate on the "imported_at" field.
+func ImportedAtNEQ(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNEQ(FieldImportedAt, v))
+}
+
+// ImportedAtIn applies the In predicate on the "imported_at" field.
+func ImportedAtIn(vs ...time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldIn(FieldImportedAt, vs...))
+}
+
+// ImportedAtNotIn applies the NotIn predicate on the "imported_at" field.
+func ImportedAtNotIn(vs ...time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldNotIn(FieldImportedAt, vs...))
+}
+
+// ImportedAtGT applies the GT predicate on the "imported_at" field.
+func ImportedAtGT(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGT(FieldImportedAt, v))
+}
+
+// ImportedAtGTE applies the GTE predicate on the "imported_at" field.
+func ImportedAtGTE(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldGTE(FieldImportedAt, v))
+}
+
+// ImportedAtLT applies the LT predicate on the "imported_at" field.
+func ImportedAtLT(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLT(FieldImportedAt, v))
+}
+
+// ImportedAtLTE applies the LTE predicate on the "imported_at" field.
+func ImportedAtLTE(v time.Time) predicate.BulkImport {
+	return predicate.BulkImport(sql.FieldLTE(FieldImportedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BulkImport) predicate.BulkImport {
+	return predicate.BulkImport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BulkImport) predicate.BulkImport {
+	return predicate.BulkImport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BulkImport) predicate.BulkImport {
+	return predicate.BulkImport(sql.NotPredicates(p))
+}
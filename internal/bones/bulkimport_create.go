@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
+)
+
+// BulkImportCreate is the builder for creating a BulkImport entity.
+type BulkImportCreate struct {
+	config
+	mutation *BulkImportMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (bic *BulkImportCreate) SetType(s string) *BulkImportCreate {
+	bic.mutation.SetType(s)
+	return bic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bic *BulkImportCreate) SetUpdatedAt(t time.Time) *BulkImportCreate {
+	bic.mutation.SetUpdatedAt(t)
+	return bic
+}
+
+// SetImportedAt sets the "imported_at" field.
+func (bic *BulkImportCreate) SetImportedAt(t time.Time) *BulkImportCreate {
+	bic.mutation.SetImportedAt(t)
+	return bic
+}
+
+// Mutation returns the BulkImportMutation object of the builder.
+func (bic *BulkImportCreate) Mutation() *BulkImportMutation {
+	return bic.mutation
+}
+
+// Save creates the BulkImport in the database.
+func (bic *BulkImportCreate) Save(ctx context.Context) (*BulkImport, error) {
+	return withHooks(ctx, bic.sqlSave, bic.mutation, bic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bic *BulkImportCreate) SaveX(ctx context.Context) *BulkImport {
+	v, err := bic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bic *BulkImportCreate) Exec(ctx context.Context) error {
+	_, err := bic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bic *BulkImportCreate) ExecX(ctx context.Context) {
+	if err := bic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bic *BulkImportCreate) check() error {
+	if _, ok := bic.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`bones: missing required field "BulkImport.type"`)}
+	}
+	if v, ok := bic.mutation.GetType(); ok {
+		if err := bulkimport.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`bones: validator failed for field "BulkImport.type": %w`, err)}
+		}
+	}
+	if _, ok := bic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`bones: missing required field "BulkImport.updated_at"`)}
+	}
+	if _, ok := bic.mutation.ImportedAt(); !ok {
+		return &ValidationError{Name: "imported_at", err: errors.New(`bones: missing required field "BulkImport.imported_at"`)}
+	}
+	return nil
+}
+
+func (bic *BulkImportCreate) sqlSave(ctx context.Context) (*BulkImport, error) {
+	if err := bic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bic.mutation.id = &_node.ID
+	bic.mutation.done = true
+	return _node, nil
+}
+
+func (bic *BulkImportCreate) createSpec() (*BulkImport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BulkImport{config: bic.config}
+		_spec = sqlgraph.NewCreateSpec(bulkimport.Table, sqlgraph.NewFieldSpec(bulkimport.FieldID, field.TypeInt))
+	)
+	if value, ok := bic.mutation.GetType(); ok {
+		_spec.SetField(bulkimport.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := bic.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkimport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bic.mutation.ImportedAt(); ok {
+		_spec.SetField(bulkimport.FieldImportedAt, field.TypeTime, value)
+		_node.ImportedAt = value
+	}
+	return _node, _spec
+}
+
+// BulkImportCreateBulk is the builder for creating many BulkImport entities in bulk.
+type BulkImportCreateBulk struct {
+	config
+	err      error
+	builders []*BulkImportCreate
+}
+
+// Save creates the BulkImport entities in the database.
+func (bicb *BulkImportCreateBulk) Save(ctx context.Context) ([]*BulkImport, error) {
+	if bicb.err != nil {
+		return nil, bicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bicb.builders))
+	nodes := make([]*BulkImport, len(bicb.builders))
+	mutators := make([]Mutator, len(bicb.builders))
+	for i := range bicb.builders {
+		func(i int, root context.Context) {
+			builder := bicb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BulkImportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bicb *BulkImportCreateBulk) SaveX(ctx context.Context) []*BulkImport {
+	v, err := bicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bicb *BulkImportCreateBulk) Exec(ctx context.Context) error {
+	_, err := bicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bicb *BulkImportCreateBulk) ExecX(ctx context.Context) {
+	if err := bicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+)
+
+// BulkImportDelete is the builder for deleting a BulkImport entity.
+type BulkImportDelete struct {
+	config
+	hooks    []Hook
+	mutation *BulkImportMutation
+}
+
+// Where appends a list predicates to the BulkImportDelete builder.
+func (bid *BulkImportDelete) Where(ps ...predicate.BulkImport) *BulkImportDelete {
+	bid.mutation.Where(ps...)
+	return bid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bid *BulkImportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bid.sqlExec, bid.mutation, bid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bid *BulkImportDelete) ExecX(ctx context.Context) int {
+	n, err := bid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bid *BulkImportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bulkimport.Table, sqlgraph.NewFieldSpec(bulkimport.FieldID, field.TypeInt))
+	if ps := bid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bid.mutation.done = true
+	return affected, err
+}
+
+// BulkImportDeleteOne is the builder for deleting a single BulkImport entity.
+type BulkImportDeleteOne struct {
+	bid *BulkImportDelete
+}
+
+// Where appends a list predicates to the BulkImportDelete builder.
+func (bido *BulkImportDeleteOne) Where(ps ...predicate.BulkImport) *BulkImportDeleteOne {
+	bido.bid.mutation.Where(ps...)
+	return bido
+}
+
+// Exec executes the deletion query.
+func (bido *BulkImportDeleteOne) Exec(ctx context.Context) error {
+	n, err := bido.bid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bulkimport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bido *BulkImportDeleteOne) ExecX(ctx context.Context) {
+	if err := bido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
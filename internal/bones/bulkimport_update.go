@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+)
+
+// BulkImportUpdate is the builder for updating BulkImport entities.
+type BulkImportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BulkImportMutation
+}
+
+// Where appends a list predicates to the BulkImportUpdate builder.
+func (biu *BulkImportUpdate) Where(ps ...predicate.BulkImport) *BulkImportUpdate {
+	biu.mutation.Where(ps...)
+	return biu
+}
+
+// SetType sets the "type" field.
+func (biu *BulkImportUpdate) SetType(s string) *BulkImportUpdate {
+	biu.mutation.SetType(s)
+	return biu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (biu *BulkImportUpdate) SetNillableType(s *string) *BulkImportUpdate {
+	if s != nil {
+		biu.SetType(*s)
+	}
+	return biu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (biu *BulkImportUpdate) SetUpdatedAt(t time.Time) *BulkImportUpdate {
+	biu.mutation.SetUpdatedAt(t)
+	return biu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (biu *BulkImportUpdate) SetNillableUpdatedAt(t *time.Time) *BulkImportUpdate {
+	if t != nil {
+		biu.SetUpdatedAt(*t)
+	}
+	return biu
+}
+
+// SetImportedAt sets the "imported_at" field.
+func (biu *BulkImportUpdate) SetImportedAt(t time.Time) *BulkImportUpdate {
+	biu.mutation.SetImportedAt(t)
+	return biu
+}
+
+// SetNillableImportedAt sets the "imported_at" field if the given value is not nil.
+func (biu *BulkImportUpdate) SetNillableImportedAt(t *time.Time) *BulkImportUpdate {
+	if t != nil {
+		biu.SetImportedAt(*t)
+	}
+	return biu
+}
+
+// Mutation returns the BulkImportMutation object of the builder.
+func (biu *BulkImportUpdate) Mutation() *BulkImportMutation {
+	return biu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (biu *BulkImportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, biu.sqlSave, biu.mutation, biu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (biu *BulkImportUpdate) SaveX(ctx context.Context) int {
+	affected, err := biu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (biu *BulkImportUpdate) Exec(ctx context.Context) error {
+	_, err := biu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (biu *BulkImportUpdate) ExecX(ctx context.Context) {
+	if err := biu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (biu *BulkImportUpdate) check() error {
+	if v, ok := biu.mutation.GetType(); ok {
+		if err := bulkimport.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`bones: validator failed for field "BulkImport.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (biu *BulkImportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := biu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bulkimport.Table, bulkimport.Columns, sqlgraph.NewFieldSpec(bulkimport.FieldID, field.TypeInt))
+	if ps := biu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := biu.mutation.GetType(); ok {
+		_spec.SetField(bulkimport.FieldType, field.TypeString, value)
+	}
+	if value, ok := biu.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkimport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := biu.mutation.ImportedAt(); ok {
+		_spec.SetField(bulkimport.FieldImportedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, biu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	biu.mutation.done = true
+	return n, nil
+}
+
+// BulkImportUpdateOne is the builder for updating a single BulkImport entity.
+type BulkImportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BulkImportMutation
+}
+
+// SetType sets the "type" field.
+func (biuo *BulkImportUpdateOne) SetType(s string) *BulkImportUpdateOne {
+	biuo.mutation.SetType(s)
+	return biuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (biuo *BulkImportUpdateOne) SetNillableType(s *string) *BulkImportUpdateOne {
+	if s != nil {
+		biuo.SetType(*s)
+	}
+	return biuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (biuo *BulkImportUpdateOne) SetUpdatedAt(t time.Time) *BulkImportUpdateOne {
+	biuo.mutation.SetUpdatedAt(t)
+	return biuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (biuo *BulkImportUpdateOne) SetNillableUpdatedAt(t *time.Time) *BulkImportUpdateOne {
+	if t != nil {
+		biuo.SetUpdatedAt(*t)
+	}
+	return biuo
+}
+
+// SetImportedAt sets the "imported_at" field.
+func (biuo *BulkImportUpdateOne) SetImportedAt(t time.Time) *BulkImportUpdateOne {
+	biuo.mutation.SetImportedAt(t)
+	return biuo
+}
+
+// SetNillableImportedAt sets the "imported_at" field if the given value is not nil.
+func (biuo *BulkImportUpdateOne) SetNillableImportedAt(t *time.Time) *BulkImportUpdateOne {
+	if t != nil {
+		biuo.SetImportedAt(*t)
+	}
+	return biuo
+}
+
+// Mutation returns the BulkImportMutation object of the builder.
+func (biuo *BulkImportUpdateOne) Mutation() *BulkImportMutation {
+	return biuo.mutation
+}
+
+// Where appends a list predicates to the BulkImportUpdate builder.
+func (biuo *BulkImportUpdateOne) Where(ps ...predicate.BulkImport) *BulkImportUpdateOne {
+	biuo.mutation.Where(ps...)
+	return biuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (biuo *BulkImportUpdateOne) Select(field string, fields ...string) *BulkImportUpdateOne {
+	biuo.fields = append([]string{field}, fields...)
+	return biuo
+}
+
+// Save executes the query and returns the updated BulkImport entity.
+func (biuo *BulkImportUpdateOne) Save(ctx context.Context) (*BulkImport, error) {
+	return withHooks(ctx, biuo.sqlSave, biuo.mutation, biuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (biuo *BulkImportUpdateOne) SaveX(ctx context.Context) *BulkImport {
+	node, err := biuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (biuo *BulkImportUpdateOne) Exec(ctx context.Context) error {
+	_, err := biuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (biuo *BulkImportUpdateOne) ExecX(ctx context.Context) {
+	if err := biuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (biuo *BulkImportUpdateOne) check() error {
+	if v, ok := biuo.mutation.GetType(); ok {
+		if err := bulkimport.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`bones: validator failed for field "BulkImport.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (biuo *BulkImportUpdateOne) sqlSave(ctx context.Context) (_node *BulkImport, err error) {
+	if err := biuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bulkimport.Table, bulkimport.Columns, sqlgraph.NewFieldSpec(bulkimport.FieldID, field.TypeInt))
+	id, ok := biuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`bones: missing "BulkImport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := biuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bulkimport.FieldID)
+		for _, f := range fields {
+			if !bulkimport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("bones: invalid field %q for query", f)}
+			}
+			if f != bulkimport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := biuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := biuo.mutation.GetType(); ok {
+		_spec.SetField(bulkimport.FieldType, field.TypeString, value)
+	}
+	if value, ok := biuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(bulkimport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := biuo.mutation.ImportedAt(); ok {
+		_spec.SetField(bulkimport.FieldImportedAt, field.TypeTime, value)
+	}
+	_node = &BulkImport{config: biuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, biuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bulkimport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	biuo.mutation.done = true
+	return _node, nil
+}
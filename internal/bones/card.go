@@ -22,6 +22,10 @@ type Card struct {
 	OracleID string `json:"oracle_id,omitempty"`
 	// ColorIdentity holds the value of the "color_identity" field.
 	ColorIdentity uint8 `json:"color_identity,omitempty"`
+	// Layout holds the value of the "layout" field.
+	Layout string `json:"layout,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CardQuery when eager-loading is set.
 	Edges        CardEdges `json:"edges"`
@@ -34,9 +38,11 @@ type CardEdges struct {
 	Faces []*CardFace `json:"faces,omitempty"`
 	// Rulings holds the value of the rulings edge.
 	Rulings []*Ruling `json:"rulings,omitempty"`
+	// Legalities holds the value of the legalities edge.
+	Legalities []*Legality `json:"legalities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // FacesOrErr returns the Faces value or an error if the edge
@@ -57,6 +63,15 @@ func (e CardEdges) RulingsOrErr() ([]*Ruling, error) {
 	return nil, &NotLoadedError{edge: "rulings"}
 }
 
+// LegalitiesOrErr returns the Legalities value or an error if the edge
+// was not loaded in eager-loading.
+func (e CardEdges) LegalitiesOrErr() ([]*Legality, error) {
+	if e.loadedTypes[2] {
+		return e.Legalities, nil
+	}
+	return nil, &NotLoadedError{edge: "legalities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Card) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -64,7 +79,7 @@ func (*Card) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case card.FieldID, card.FieldColorIdentity:
 			values[i] = new(sql.NullInt64)
-		case card.FieldName, card.FieldOracleID:
+		case card.FieldName, card.FieldOracleID, card.FieldLayout, card.FieldContentHash:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -105,6 +120,18 @@ func (c *Card) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.ColorIdentity = uint8(value.Int64)
 			}
+		case card.FieldLayout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field layout", values[i])
+			} else if value.Valid {
+				c.Layout = value.String
+			}
+		case card.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				c.ContentHash = value.String
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -128,6 +155,11 @@ func (c *Card) QueryRulings() *RulingQuery {
 	return NewCardClient(c.config).QueryRulings(c)
 }
 
+// QueryLegalities queries the "legalities" edge of the Card entity.
+func (c *Card) QueryLegalities() *LegalityQuery {
+	return NewCardClient(c.config).QueryLegalities(c)
+}
+
 // Update returns a builder for updating this Card.
 // Note that you need to call Card.Unwrap() before calling this method if this Card
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -159,6 +191,12 @@ func (c *Card) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("color_identity=")
 	builder.WriteString(fmt.Sprintf("%v", c.ColorIdentity))
+	builder.WriteString(", ")
+	builder.WriteString("layout=")
+	builder.WriteString(c.Layout)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(c.ContentHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -18,10 +18,16 @@ const (
 	FieldOracleID = "oracle_id"
 	// FieldColorIdentity holds the string denoting the color_identity field in the database.
 	FieldColorIdentity = "color_identity"
+	// FieldLayout holds the string denoting the layout field in the database.
+	FieldLayout = "layout"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
 	// EdgeFaces holds the string denoting the faces edge name in mutations.
 	EdgeFaces = "faces"
 	// EdgeRulings holds the string denoting the rulings edge name in mutations.
 	EdgeRulings = "rulings"
+	// EdgeLegalities holds the string denoting the legalities edge name in mutations.
+	EdgeLegalities = "legalities"
 	// Table holds the table name of the card in the database.
 	Table = "cards"
 	// FacesTable is the table that holds the faces relation/edge.
@@ -38,6 +44,13 @@ const (
 	RulingsInverseTable = "rulings"
 	// RulingsColumn is the table column denoting the rulings relation/edge.
 	RulingsColumn = "ruling_card"
+	// LegalitiesTable is the table that holds the legalities relation/edge.
+	LegalitiesTable = "legalities"
+	// LegalitiesInverseTable is the table name for the Legality entity.
+	// It exists in this package in order to avoid circular dependency with the "legality" package.
+	LegalitiesInverseTable = "legalities"
+	// LegalitiesColumn is the table column denoting the legalities relation/edge.
+	LegalitiesColumn = "legality_card"
 )
 
 // Columns holds all SQL columns for card fields.
@@ -46,6 +59,8 @@ var Columns = []string{
 	FieldName,
 	FieldOracleID,
 	FieldColorIdentity,
+	FieldLayout,
+	FieldContentHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,6 +78,8 @@ var (
 	NameValidator func(string) error
 	// OracleIDValidator is a validator for the "oracle_id" field. It is called by the builders before save.
 	OracleIDValidator func(string) error
+	// DefaultLayout holds the default value on creation for the "layout" field.
+	DefaultLayout string
 )
 
 // OrderOption defines the ordering options for the Card queries.
@@ -88,6 +105,16 @@ func ByColorIdentity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldColorIdentity, opts...).ToFunc()
 }
 
+// ByLayout orders the results by the layout field.
+func ByLayout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLayout, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
 // ByFacesCount orders the results by faces count.
 func ByFacesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -115,6 +142,20 @@ func ByRulings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRulingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLegalitiesCount orders the results by legalities count.
+func ByLegalitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLegalitiesStep(), opts...)
+	}
+}
+
+// ByLegalities orders the results by legalities terms.
+func ByLegalities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLegalitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFacesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -129,3 +170,10 @@ func newRulingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, RulingsTable, RulingsColumn),
 	)
 }
+func newLegalitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LegalitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, LegalitiesTable, LegalitiesColumn),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/ruling"
 )
 
@@ -39,6 +40,34 @@ func (cc *CardCreate) SetColorIdentity(u uint8) *CardCreate {
 	return cc
 }
 
+// SetLayout sets the "layout" field.
+func (cc *CardCreate) SetLayout(s string) *CardCreate {
+	cc.mutation.SetLayout(s)
+	return cc
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (cc *CardCreate) SetNillableLayout(s *string) *CardCreate {
+	if s != nil {
+		cc.SetLayout(*s)
+	}
+	return cc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cc *CardCreate) SetContentHash(s string) *CardCreate {
+	cc.mutation.SetContentHash(s)
+	return cc
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cc *CardCreate) SetNillableContentHash(s *string) *CardCreate {
+	if s != nil {
+		cc.SetContentHash(*s)
+	}
+	return cc
+}
+
 // AddFaceIDs adds the "faces" edge to the CardFace entity by IDs.
 func (cc *CardCreate) AddFaceIDs(ids ...int) *CardCreate {
 	cc.mutation.AddFaceIDs(ids...)
@@ -69,6 +98,21 @@ func (cc *CardCreate) AddRulings(r ...*Ruling) *CardCreate {
 	return cc.AddRulingIDs(ids...)
 }
 
+// AddLegalityIDs adds the "legalities" edge to the Legality entity by IDs.
+func (cc *CardCreate) AddLegalityIDs(ids ...int) *CardCreate {
+	cc.mutation.AddLegalityIDs(ids...)
+	return cc
+}
+
+// AddLegalities adds the "legalities" edges to the Legality entity.
+func (cc *CardCreate) AddLegalities(l ...*Legality) *CardCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cc.AddLegalityIDs(ids...)
+}
+
 // Mutation returns the CardMutation object of the builder.
 func (cc *CardCreate) Mutation() *CardMutation {
 	return cc.mutation
@@ -76,6 +120,7 @@ func (cc *CardCreate) Mutation() *CardMutation {
 
 // Save creates the Card in the database.
 func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
+	cc.defaults()
 	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
 }
 
@@ -101,6 +146,14 @@ func (cc *CardCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (cc *CardCreate) defaults() {
+	if _, ok := cc.mutation.Layout(); !ok {
+		v := card.DefaultLayout
+		cc.mutation.SetLayout(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cc *CardCreate) check() error {
 	if _, ok := cc.mutation.Name(); !ok {
@@ -122,6 +175,9 @@ func (cc *CardCreate) check() error {
 	if _, ok := cc.mutation.ColorIdentity(); !ok {
 		return &ValidationError{Name: "color_identity", err: errors.New(`bones: missing required field "Card.color_identity"`)}
 	}
+	if _, ok := cc.mutation.Layout(); !ok {
+		return &ValidationError{Name: "layout", err: errors.New(`bones: missing required field "Card.layout"`)}
+	}
 	return nil
 }
 
@@ -160,6 +216,14 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 		_spec.SetField(card.FieldColorIdentity, field.TypeUint8, value)
 		_node.ColorIdentity = value
 	}
+	if value, ok := cc.mutation.Layout(); ok {
+		_spec.SetField(card.FieldLayout, field.TypeString, value)
+		_node.Layout = value
+	}
+	if value, ok := cc.mutation.ContentHash(); ok {
+		_spec.SetField(card.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if nodes := cc.mutation.FacesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -192,6 +256,22 @@ func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.LegalitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -213,6 +293,7 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*CardMutation)
 				if !ok {
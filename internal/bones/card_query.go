@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/predicate"
 	"github.com/SethCurry/stax/internal/bones/ruling"
 )
@@ -20,12 +21,13 @@ import (
 // CardQuery is the builder for querying Card entities.
 type CardQuery struct {
 	config
-	ctx         *QueryContext
-	order       []card.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Card
-	withFaces   *CardFaceQuery
-	withRulings *RulingQuery
+	ctx            *QueryContext
+	order          []card.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Card
+	withFaces      *CardFaceQuery
+	withRulings    *RulingQuery
+	withLegalities *LegalityQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (cq *CardQuery) QueryRulings() *RulingQuery {
 	return query
 }
 
+// QueryLegalities chains the current query on the "legalities" edge.
+func (cq *CardQuery) QueryLegalities() *LegalityQuery {
+	query := (&LegalityClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(card.Table, card.FieldID, selector),
+			sqlgraph.To(legality.Table, legality.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, card.LegalitiesTable, card.LegalitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Card entity from the query.
 // Returns a *NotFoundError when no Card was found.
 func (cq *CardQuery) First(ctx context.Context) (*Card, error) {
@@ -293,13 +317,14 @@ func (cq *CardQuery) Clone() *CardQuery {
 		return nil
 	}
 	return &CardQuery{
-		config:      cq.config,
-		ctx:         cq.ctx.Clone(),
-		order:       append([]card.OrderOption{}, cq.order...),
-		inters:      append([]Interceptor{}, cq.inters...),
-		predicates:  append([]predicate.Card{}, cq.predicates...),
-		withFaces:   cq.withFaces.Clone(),
-		withRulings: cq.withRulings.Clone(),
+		config:         cq.config,
+		ctx:            cq.ctx.Clone(),
+		order:          append([]card.OrderOption{}, cq.order...),
+		inters:         append([]Interceptor{}, cq.inters...),
+		predicates:     append([]predicate.Card{}, cq.predicates...),
+		withFaces:      cq.withFaces.Clone(),
+		withRulings:    cq.withRulings.Clone(),
+		withLegalities: cq.withLegalities.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -328,6 +353,17 @@ func (cq *CardQuery) WithRulings(opts ...func(*RulingQuery)) *CardQuery {
 	return cq
 }
 
+// WithLegalities tells the query-builder to eager-load the nodes that are connected to
+// the "legalities" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CardQuery) WithLegalities(opts ...func(*LegalityQuery)) *CardQuery {
+	query := (&LegalityClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withLegalities = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (cq *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 	var (
 		nodes       = []*Card{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			cq.withFaces != nil,
 			cq.withRulings != nil,
+			cq.withLegalities != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +480,13 @@ func (cq *CardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Card, e
 			return nil, err
 		}
 	}
+	if query := cq.withLegalities; query != nil {
+		if err := cq.loadLegalities(ctx, query, nodes,
+			func(n *Card) { n.Edges.Legalities = []*Legality{} },
+			func(n *Card, e *Legality) { n.Edges.Legalities = append(n.Edges.Legalities, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +552,37 @@ func (cq *CardQuery) loadRulings(ctx context.Context, query *RulingQuery, nodes
 	}
 	return nil
 }
+func (cq *CardQuery) loadLegalities(ctx context.Context, query *LegalityQuery, nodes []*Card, init func(*Card), assign func(*Card, *Legality)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Card)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Legality(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(card.LegalitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.legality_card
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "legality_card" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "legality_card" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
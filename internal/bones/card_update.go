@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/predicate"
 	"github.com/SethCurry/stax/internal/bones/ruling"
 )
@@ -78,6 +79,40 @@ func (cu *CardUpdate) AddColorIdentity(u int8) *CardUpdate {
 	return cu
 }
 
+// SetLayout sets the "layout" field.
+func (cu *CardUpdate) SetLayout(s string) *CardUpdate {
+	cu.mutation.SetLayout(s)
+	return cu
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (cu *CardUpdate) SetNillableLayout(s *string) *CardUpdate {
+	if s != nil {
+		cu.SetLayout(*s)
+	}
+	return cu
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cu *CardUpdate) SetContentHash(s string) *CardUpdate {
+	cu.mutation.SetContentHash(s)
+	return cu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cu *CardUpdate) SetNillableContentHash(s *string) *CardUpdate {
+	if s != nil {
+		cu.SetContentHash(*s)
+	}
+	return cu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (cu *CardUpdate) ClearContentHash() *CardUpdate {
+	cu.mutation.ClearContentHash()
+	return cu
+}
+
 // AddFaceIDs adds the "faces" edge to the CardFace entity by IDs.
 func (cu *CardUpdate) AddFaceIDs(ids ...int) *CardUpdate {
 	cu.mutation.AddFaceIDs(ids...)
@@ -108,6 +143,21 @@ func (cu *CardUpdate) AddRulings(r ...*Ruling) *CardUpdate {
 	return cu.AddRulingIDs(ids...)
 }
 
+// AddLegalityIDs adds the "legalities" edge to the Legality entity by IDs.
+func (cu *CardUpdate) AddLegalityIDs(ids ...int) *CardUpdate {
+	cu.mutation.AddLegalityIDs(ids...)
+	return cu
+}
+
+// AddLegalities adds the "legalities" edges to the Legality entity.
+func (cu *CardUpdate) AddLegalities(l ...*Legality) *CardUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.AddLegalityIDs(ids...)
+}
+
 // Mutation returns the CardMutation object of the builder.
 func (cu *CardUpdate) Mutation() *CardMutation {
 	return cu.mutation
@@ -155,6 +205,27 @@ func (cu *CardUpdate) RemoveRulings(r ...*Ruling) *CardUpdate {
 	return cu.RemoveRulingIDs(ids...)
 }
 
+// ClearLegalities clears all "legalities" edges to the Legality entity.
+func (cu *CardUpdate) ClearLegalities() *CardUpdate {
+	cu.mutation.ClearLegalities()
+	return cu
+}
+
+// RemoveLegalityIDs removes the "legalities" edge to Legality entities by IDs.
+func (cu *CardUpdate) RemoveLegalityIDs(ids ...int) *CardUpdate {
+	cu.mutation.RemoveLegalityIDs(ids...)
+	return cu
+}
+
+// RemoveLegalities removes "legalities" edges to Legality entities.
+func (cu *CardUpdate) RemoveLegalities(l ...*Legality) *CardUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cu.RemoveLegalityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
@@ -221,6 +292,15 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.AddedColorIdentity(); ok {
 		_spec.AddField(card.FieldColorIdentity, field.TypeUint8, value)
 	}
+	if value, ok := cu.mutation.Layout(); ok {
+		_spec.SetField(card.FieldLayout, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.ContentHash(); ok {
+		_spec.SetField(card.FieldContentHash, field.TypeString, value)
+	}
+	if cu.mutation.ContentHashCleared() {
+		_spec.ClearField(card.FieldContentHash, field.TypeString)
+	}
 	if cu.mutation.FacesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -311,6 +391,51 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.LegalitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedLegalitiesIDs(); len(nodes) > 0 && !cu.mutation.LegalitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.LegalitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
@@ -380,6 +505,40 @@ func (cuo *CardUpdateOne) AddColorIdentity(u int8) *CardUpdateOne {
 	return cuo
 }
 
+// SetLayout sets the "layout" field.
+func (cuo *CardUpdateOne) SetLayout(s string) *CardUpdateOne {
+	cuo.mutation.SetLayout(s)
+	return cuo
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableLayout(s *string) *CardUpdateOne {
+	if s != nil {
+		cuo.SetLayout(*s)
+	}
+	return cuo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cuo *CardUpdateOne) SetContentHash(s string) *CardUpdateOne {
+	cuo.mutation.SetContentHash(s)
+	return cuo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableContentHash(s *string) *CardUpdateOne {
+	if s != nil {
+		cuo.SetContentHash(*s)
+	}
+	return cuo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (cuo *CardUpdateOne) ClearContentHash() *CardUpdateOne {
+	cuo.mutation.ClearContentHash()
+	return cuo
+}
+
 // AddFaceIDs adds the "faces" edge to the CardFace entity by IDs.
 func (cuo *CardUpdateOne) AddFaceIDs(ids ...int) *CardUpdateOne {
 	cuo.mutation.AddFaceIDs(ids...)
@@ -410,6 +569,21 @@ func (cuo *CardUpdateOne) AddRulings(r ...*Ruling) *CardUpdateOne {
 	return cuo.AddRulingIDs(ids...)
 }
 
+// AddLegalityIDs adds the "legalities" edge to the Legality entity by IDs.
+func (cuo *CardUpdateOne) AddLegalityIDs(ids ...int) *CardUpdateOne {
+	cuo.mutation.AddLegalityIDs(ids...)
+	return cuo
+}
+
+// AddLegalities adds the "legalities" edges to the Legality entity.
+func (cuo *CardUpdateOne) AddLegalities(l ...*Legality) *CardUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.AddLegalityIDs(ids...)
+}
+
 // Mutation returns the CardMutation object of the builder.
 func (cuo *CardUpdateOne) Mutation() *CardMutation {
 	return cuo.mutation
@@ -457,6 +631,27 @@ func (cuo *CardUpdateOne) RemoveRulings(r ...*Ruling) *CardUpdateOne {
 	return cuo.RemoveRulingIDs(ids...)
 }
 
+// ClearLegalities clears all "legalities" edges to the Legality entity.
+func (cuo *CardUpdateOne) ClearLegalities() *CardUpdateOne {
+	cuo.mutation.ClearLegalities()
+	return cuo
+}
+
+// RemoveLegalityIDs removes the "legalities" edge to Legality entities by IDs.
+func (cuo *CardUpdateOne) RemoveLegalityIDs(ids ...int) *CardUpdateOne {
+	cuo.mutation.RemoveLegalityIDs(ids...)
+	return cuo
+}
+
+// RemoveLegalities removes "legalities" edges to Legality entities.
+func (cuo *CardUpdateOne) RemoveLegalities(l ...*Legality) *CardUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return cuo.RemoveLegalityIDs(ids...)
+}
+
 // Where appends a list predicates to the CardUpdate builder.
 func (cuo *CardUpdateOne) Where(ps ...predicate.Card) *CardUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -553,6 +748,15 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 	if value, ok := cuo.mutation.AddedColorIdentity(); ok {
 		_spec.AddField(card.FieldColorIdentity, field.TypeUint8, value)
 	}
+	if value, ok := cuo.mutation.Layout(); ok {
+		_spec.SetField(card.FieldLayout, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.ContentHash(); ok {
+		_spec.SetField(card.FieldContentHash, field.TypeString, value)
+	}
+	if cuo.mutation.ContentHashCleared() {
+		_spec.ClearField(card.FieldContentHash, field.TypeString)
+	}
 	if cuo.mutation.FacesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -643,6 +847,51 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.LegalitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedLegalitiesIDs(); len(nodes) > 0 && !cuo.mutation.LegalitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.LegalitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   card.LegalitiesTable,
+			Columns: []string{card.LegalitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Card{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
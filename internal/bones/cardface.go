@@ -33,12 +33,22 @@ type CardFace struct {
 	Toughness string `json:"toughness,omitempty"`
 	// Loyalty holds the value of the "loyalty" field.
 	Loyalty string `json:"loyalty,omitempty"`
+	// PowerValue holds the value of the "power_value" field.
+	PowerValue *float32 `json:"power_value,omitempty"`
+	// ToughnessValue holds the value of the "toughness_value" field.
+	ToughnessValue *float32 `json:"toughness_value,omitempty"`
+	// LoyaltyValue holds the value of the "loyalty_value" field.
+	LoyaltyValue *float32 `json:"loyalty_value,omitempty"`
 	// ManaCost holds the value of the "mana_cost" field.
 	ManaCost string `json:"mana_cost,omitempty"`
 	// TypeLine holds the value of the "type_line" field.
 	TypeLine string `json:"type_line,omitempty"`
 	// Colors holds the value of the "colors" field.
 	Colors string `json:"colors,omitempty"`
+	// ColorField holds the value of the "color_field" field.
+	ColorField uint8 `json:"color_field,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CardFaceQuery when eager-loading is set.
 	Edges          CardFaceEdges `json:"edges"`
@@ -82,11 +92,11 @@ func (*CardFace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case cardface.FieldCmc:
+		case cardface.FieldCmc, cardface.FieldPowerValue, cardface.FieldToughnessValue, cardface.FieldLoyaltyValue:
 			values[i] = new(sql.NullFloat64)
-		case cardface.FieldID:
+		case cardface.FieldID, cardface.FieldColorField:
 			values[i] = new(sql.NullInt64)
-		case cardface.FieldName, cardface.FieldFlavorText, cardface.FieldOracleText, cardface.FieldLanguage, cardface.FieldPower, cardface.FieldToughness, cardface.FieldLoyalty, cardface.FieldManaCost, cardface.FieldTypeLine, cardface.FieldColors:
+		case cardface.FieldName, cardface.FieldFlavorText, cardface.FieldOracleText, cardface.FieldLanguage, cardface.FieldPower, cardface.FieldToughness, cardface.FieldLoyalty, cardface.FieldManaCost, cardface.FieldTypeLine, cardface.FieldColors, cardface.FieldContentHash:
 			values[i] = new(sql.NullString)
 		case cardface.ForeignKeys[0]: // card_face_card
 			values[i] = new(sql.NullInt64)
@@ -159,6 +169,27 @@ func (cf *CardFace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cf.Loyalty = value.String
 			}
+		case cardface.FieldPowerValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field power_value", values[i])
+			} else if value.Valid {
+				cf.PowerValue = new(float32)
+				*cf.PowerValue = float32(value.Float64)
+			}
+		case cardface.FieldToughnessValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field toughness_value", values[i])
+			} else if value.Valid {
+				cf.ToughnessValue = new(float32)
+				*cf.ToughnessValue = float32(value.Float64)
+			}
+		case cardface.FieldLoyaltyValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field loyalty_value", values[i])
+			} else if value.Valid {
+				cf.LoyaltyValue = new(float32)
+				*cf.LoyaltyValue = float32(value.Float64)
+			}
 		case cardface.FieldManaCost:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field mana_cost", values[i])
@@ -177,6 +208,18 @@ func (cf *CardFace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cf.Colors = value.String
 			}
+		case cardface.FieldColorField:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field color_field", values[i])
+			} else if value.Valid {
+				cf.ColorField = uint8(value.Int64)
+			}
+		case cardface.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				cf.ContentHash = value.String
+			}
 		case cardface.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field card_face_card", value)
@@ -254,6 +297,21 @@ func (cf *CardFace) String() string {
 	builder.WriteString("loyalty=")
 	builder.WriteString(cf.Loyalty)
 	builder.WriteString(", ")
+	if v := cf.PowerValue; v != nil {
+		builder.WriteString("power_value=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cf.ToughnessValue; v != nil {
+		builder.WriteString("toughness_value=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := cf.LoyaltyValue; v != nil {
+		builder.WriteString("loyalty_value=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("mana_cost=")
 	builder.WriteString(cf.ManaCost)
 	builder.WriteString(", ")
@@ -262,6 +320,12 @@ func (cf *CardFace) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("colors=")
 	builder.WriteString(cf.Colors)
+	builder.WriteString(", ")
+	builder.WriteString("color_field=")
+	builder.WriteString(fmt.Sprintf("%v", cf.ColorField))
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(cf.ContentHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,12 +28,22 @@ const (
 	FieldToughness = "toughness"
 	// FieldLoyalty holds the string denoting the loyalty field in the database.
 	FieldLoyalty = "loyalty"
+	// FieldPowerValue holds the string denoting the power_value field in the database.
+	FieldPowerValue = "power_value"
+	// FieldToughnessValue holds the string denoting the toughness_value field in the database.
+	FieldToughnessValue = "toughness_value"
+	// FieldLoyaltyValue holds the string denoting the loyalty_value field in the database.
+	FieldLoyaltyValue = "loyalty_value"
 	// FieldManaCost holds the string denoting the mana_cost field in the database.
 	FieldManaCost = "mana_cost"
 	// FieldTypeLine holds the string denoting the type_line field in the database.
 	FieldTypeLine = "type_line"
 	// FieldColors holds the string denoting the colors field in the database.
 	FieldColors = "colors"
+	// FieldColorField holds the string denoting the color_field field in the database.
+	FieldColorField = "color_field"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
 	// EdgeCard holds the string denoting the card edge name in mutations.
 	EdgeCard = "card"
 	// EdgePrintings holds the string denoting the printings edge name in mutations.
@@ -67,9 +77,14 @@ var Columns = []string{
 	FieldPower,
 	FieldToughness,
 	FieldLoyalty,
+	FieldPowerValue,
+	FieldToughnessValue,
+	FieldLoyaltyValue,
 	FieldManaCost,
 	FieldTypeLine,
 	FieldColors,
+	FieldColorField,
+	FieldContentHash,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "card_faces"
@@ -100,6 +115,8 @@ var (
 	FlavorTextValidator func(string) error
 	// OracleTextValidator is a validator for the "oracle_text" field. It is called by the builders before save.
 	OracleTextValidator func(string) error
+	// DefaultColorField holds the default value on creation for the "color_field" field.
+	DefaultColorField uint8
 )
 
 // OrderOption defines the ordering options for the CardFace queries.
@@ -150,6 +167,21 @@ func ByLoyalty(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLoyalty, opts...).ToFunc()
 }
 
+// ByPowerValue orders the results by the power_value field.
+func ByPowerValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPowerValue, opts...).ToFunc()
+}
+
+// ByToughnessValue orders the results by the toughness_value field.
+func ByToughnessValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToughnessValue, opts...).ToFunc()
+}
+
+// ByLoyaltyValue orders the results by the loyalty_value field.
+func ByLoyaltyValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoyaltyValue, opts...).ToFunc()
+}
+
 // ByManaCost orders the results by the mana_cost field.
 func ByManaCost(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldManaCost, opts...).ToFunc()
@@ -165,6 +197,16 @@ func ByColors(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldColors, opts...).ToFunc()
 }
 
+// ByColorField orders the results by the color_field field.
+func ByColorField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColorField, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
 // ByCardField orders the results by card field.
 func ByCardField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
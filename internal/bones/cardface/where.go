@@ -93,6 +93,21 @@ func Loyalty(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldEQ(FieldLoyalty, v))
 }
 
+// PowerValue applies equality check predicate on the "power_value" field. It's identical to PowerValueEQ.
+func PowerValue(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldPowerValue, v))
+}
+
+// ToughnessValue applies equality check predicate on the "toughness_value" field. It's identical to ToughnessValueEQ.
+func ToughnessValue(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldToughnessValue, v))
+}
+
+// LoyaltyValue applies equality check predicate on the "loyalty_value" field. It's identical to LoyaltyValueEQ.
+func LoyaltyValue(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldLoyaltyValue, v))
+}
+
 // ManaCost applies equality check predicate on the "mana_cost" field. It's identical to ManaCostEQ.
 func ManaCost(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldEQ(FieldManaCost, v))
@@ -108,6 +123,16 @@ func Colors(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldEQ(FieldColors, v))
 }
 
+// ColorField applies equality check predicate on the "color_field" field. It's identical to ColorFieldEQ.
+func ColorField(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldColorField, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldContentHash, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldEQ(FieldName, v))
@@ -603,6 +628,156 @@ func LoyaltyContainsFold(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldContainsFold(FieldLoyalty, v))
 }
 
+// PowerValueEQ applies the EQ predicate on the "power_value" field.
+func PowerValueEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldPowerValue, v))
+}
+
+// PowerValueNEQ applies the NEQ predicate on the "power_value" field.
+func PowerValueNEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNEQ(FieldPowerValue, v))
+}
+
+// PowerValueIn applies the In predicate on the "power_value" field.
+func PowerValueIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldIn(FieldPowerValue, vs...))
+}
+
+// PowerValueNotIn applies the NotIn predicate on the "power_value" field.
+func PowerValueNotIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotIn(FieldPowerValue, vs...))
+}
+
+// PowerValueGT applies the GT predicate on the "power_value" field.
+func PowerValueGT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGT(FieldPowerValue, v))
+}
+
+// PowerValueGTE applies the GTE predicate on the "power_value" field.
+func PowerValueGTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGTE(FieldPowerValue, v))
+}
+
+// PowerValueLT applies the LT predicate on the "power_value" field.
+func PowerValueLT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLT(FieldPowerValue, v))
+}
+
+// PowerValueLTE applies the LTE predicate on the "power_value" field.
+func PowerValueLTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLTE(FieldPowerValue, v))
+}
+
+// PowerValueIsNil applies the IsNil predicate on the "power_value" field.
+func PowerValueIsNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldIsNull(FieldPowerValue))
+}
+
+// PowerValueNotNil applies the NotNil predicate on the "power_value" field.
+func PowerValueNotNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotNull(FieldPowerValue))
+}
+
+// ToughnessValueEQ applies the EQ predicate on the "toughness_value" field.
+func ToughnessValueEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldToughnessValue, v))
+}
+
+// ToughnessValueNEQ applies the NEQ predicate on the "toughness_value" field.
+func ToughnessValueNEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNEQ(FieldToughnessValue, v))
+}
+
+// ToughnessValueIn applies the In predicate on the "toughness_value" field.
+func ToughnessValueIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldIn(FieldToughnessValue, vs...))
+}
+
+// ToughnessValueNotIn applies the NotIn predicate on the "toughness_value" field.
+func ToughnessValueNotIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotIn(FieldToughnessValue, vs...))
+}
+
+// ToughnessValueGT applies the GT predicate on the "toughness_value" field.
+func ToughnessValueGT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGT(FieldToughnessValue, v))
+}
+
+// ToughnessValueGTE applies the GTE predicate on the "toughness_value" field.
+func ToughnessValueGTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGTE(FieldToughnessValue, v))
+}
+
+// ToughnessValueLT applies the LT predicate on the "toughness_value" field.
+func ToughnessValueLT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLT(FieldToughnessValue, v))
+}
+
+// ToughnessValueLTE applies the LTE predicate on the "toughness_value" field.
+func ToughnessValueLTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLTE(FieldToughnessValue, v))
+}
+
+// ToughnessValueIsNil applies the IsNil predicate on the "toughness_value" field.
+func ToughnessValueIsNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldIsNull(FieldToughnessValue))
+}
+
+// ToughnessValueNotNil applies the NotNil predicate on the "toughness_value" field.
+func ToughnessValueNotNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotNull(FieldToughnessValue))
+}
+
+// LoyaltyValueEQ applies the EQ predicate on the "loyalty_value" field.
+func LoyaltyValueEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueNEQ applies the NEQ predicate on the "loyalty_value" field.
+func LoyaltyValueNEQ(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNEQ(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueIn applies the In predicate on the "loyalty_value" field.
+func LoyaltyValueIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldIn(FieldLoyaltyValue, vs...))
+}
+
+// LoyaltyValueNotIn applies the NotIn predicate on the "loyalty_value" field.
+func LoyaltyValueNotIn(vs ...float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotIn(FieldLoyaltyValue, vs...))
+}
+
+// LoyaltyValueGT applies the GT predicate on the "loyalty_value" field.
+func LoyaltyValueGT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGT(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueGTE applies the GTE predicate on the "loyalty_value" field.
+func LoyaltyValueGTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGTE(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueLT applies the LT predicate on the "loyalty_value" field.
+func LoyaltyValueLT(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLT(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueLTE applies the LTE predicate on the "loyalty_value" field.
+func LoyaltyValueLTE(v float32) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLTE(FieldLoyaltyValue, v))
+}
+
+// LoyaltyValueIsNil applies the IsNil predicate on the "loyalty_value" field.
+func LoyaltyValueIsNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldIsNull(FieldLoyaltyValue))
+}
+
+// LoyaltyValueNotNil applies the NotNil predicate on the "loyalty_value" field.
+func LoyaltyValueNotNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotNull(FieldLoyaltyValue))
+}
+
 // ManaCostEQ applies the EQ predicate on the "mana_cost" field.
 func ManaCostEQ(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldEQ(FieldManaCost, v))
@@ -798,6 +973,121 @@ func ColorsContainsFold(v string) predicate.CardFace {
 	return predicate.CardFace(sql.FieldContainsFold(FieldColors, v))
 }
 
+// ColorFieldEQ applies the EQ predicate on the "color_field" field.
+func ColorFieldEQ(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldColorField, v))
+}
+
+// ColorFieldNEQ applies the NEQ predicate on the "color_field" field.
+func ColorFieldNEQ(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNEQ(FieldColorField, v))
+}
+
+// ColorFieldIn applies the In predicate on the "color_field" field.
+func ColorFieldIn(vs ...uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldIn(FieldColorField, vs...))
+}
+
+// ColorFieldNotIn applies the NotIn predicate on the "color_field" field.
+func ColorFieldNotIn(vs ...uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotIn(FieldColorField, vs...))
+}
+
+// ColorFieldGT applies the GT predicate on the "color_field" field.
+func ColorFieldGT(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGT(FieldColorField, v))
+}
+
+// ColorFieldGTE applies the GTE predicate on the "color_field" field.
+func ColorFieldGTE(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGTE(FieldColorField, v))
+}
+
+// ColorFieldLT applies the LT predicate on the "color_field" field.
+func ColorFieldLT(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLT(FieldColorField, v))
+}
+
+// ColorFieldLTE applies the LTE predicate on the "color_field" field.
+func ColorFieldLTE(v uint8) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLTE(FieldColorField, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.CardFace {
+	return predicate.CardFace(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.CardFace {
+	return predicate.CardFace(sql.FieldContainsFold(FieldContentHash, v))
+}
+
 // HasCard applies the HasEdge predicate on the "card" edge.
 func HasCard() predicate.CardFace {
 	return predicate.CardFace(func(s *sql.Selector) {
@@ -69,6 +69,48 @@ func (cfc *CardFaceCreate) SetLoyalty(s string) *CardFaceCreate {
 	return cfc
 }
 
+// SetPowerValue sets the "power_value" field.
+func (cfc *CardFaceCreate) SetPowerValue(f float32) *CardFaceCreate {
+	cfc.mutation.SetPowerValue(f)
+	return cfc
+}
+
+// SetNillablePowerValue sets the "power_value" field if the given value is not nil.
+func (cfc *CardFaceCreate) SetNillablePowerValue(f *float32) *CardFaceCreate {
+	if f != nil {
+		cfc.SetPowerValue(*f)
+	}
+	return cfc
+}
+
+// SetToughnessValue sets the "toughness_value" field.
+func (cfc *CardFaceCreate) SetToughnessValue(f float32) *CardFaceCreate {
+	cfc.mutation.SetToughnessValue(f)
+	return cfc
+}
+
+// SetNillableToughnessValue sets the "toughness_value" field if the given value is not nil.
+func (cfc *CardFaceCreate) SetNillableToughnessValue(f *float32) *CardFaceCreate {
+	if f != nil {
+		cfc.SetToughnessValue(*f)
+	}
+	return cfc
+}
+
+// SetLoyaltyValue sets the "loyalty_value" field.
+func (cfc *CardFaceCreate) SetLoyaltyValue(f float32) *CardFaceCreate {
+	cfc.mutation.SetLoyaltyValue(f)
+	return cfc
+}
+
+// SetNillableLoyaltyValue sets the "loyalty_value" field if the given value is not nil.
+func (cfc *CardFaceCreate) SetNillableLoyaltyValue(f *float32) *CardFaceCreate {
+	if f != nil {
+		cfc.SetLoyaltyValue(*f)
+	}
+	return cfc
+}
+
 // SetManaCost sets the "mana_cost" field.
 func (cfc *CardFaceCreate) SetManaCost(s string) *CardFaceCreate {
 	cfc.mutation.SetManaCost(s)
@@ -87,6 +129,34 @@ func (cfc *CardFaceCreate) SetColors(s string) *CardFaceCreate {
 	return cfc
 }
 
+// SetColorField sets the "color_field" field.
+func (cfc *CardFaceCreate) SetColorField(u uint8) *CardFaceCreate {
+	cfc.mutation.SetColorField(u)
+	return cfc
+}
+
+// SetNillableColorField sets the "color_field" field if the given value is not nil.
+func (cfc *CardFaceCreate) SetNillableColorField(u *uint8) *CardFaceCreate {
+	if u != nil {
+		cfc.SetColorField(*u)
+	}
+	return cfc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cfc *CardFaceCreate) SetContentHash(s string) *CardFaceCreate {
+	cfc.mutation.SetContentHash(s)
+	return cfc
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cfc *CardFaceCreate) SetNillableContentHash(s *string) *CardFaceCreate {
+	if s != nil {
+		cfc.SetContentHash(*s)
+	}
+	return cfc
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (cfc *CardFaceCreate) SetCardID(id int) *CardFaceCreate {
 	cfc.mutation.SetCardID(id)
@@ -128,6 +198,7 @@ func (cfc *CardFaceCreate) Mutation() *CardFaceMutation {
 
 // Save creates the CardFace in the database.
 func (cfc *CardFaceCreate) Save(ctx context.Context) (*CardFace, error) {
+	cfc.defaults()
 	return withHooks(ctx, cfc.sqlSave, cfc.mutation, cfc.hooks)
 }
 
@@ -153,6 +224,14 @@ func (cfc *CardFaceCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (cfc *CardFaceCreate) defaults() {
+	if _, ok := cfc.mutation.ColorField(); !ok {
+		v := cardface.DefaultColorField
+		cfc.mutation.SetColorField(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (cfc *CardFaceCreate) check() error {
 	if _, ok := cfc.mutation.Name(); !ok {
@@ -203,6 +282,9 @@ func (cfc *CardFaceCreate) check() error {
 	if _, ok := cfc.mutation.Colors(); !ok {
 		return &ValidationError{Name: "colors", err: errors.New(`bones: missing required field "CardFace.colors"`)}
 	}
+	if _, ok := cfc.mutation.ColorField(); !ok {
+		return &ValidationError{Name: "color_field", err: errors.New(`bones: missing required field "CardFace.color_field"`)}
+	}
 	return nil
 }
 
@@ -261,6 +343,18 @@ func (cfc *CardFaceCreate) createSpec() (*CardFace, *sqlgraph.CreateSpec) {
 		_spec.SetField(cardface.FieldLoyalty, field.TypeString, value)
 		_node.Loyalty = value
 	}
+	if value, ok := cfc.mutation.PowerValue(); ok {
+		_spec.SetField(cardface.FieldPowerValue, field.TypeFloat32, value)
+		_node.PowerValue = &value
+	}
+	if value, ok := cfc.mutation.ToughnessValue(); ok {
+		_spec.SetField(cardface.FieldToughnessValue, field.TypeFloat32, value)
+		_node.ToughnessValue = &value
+	}
+	if value, ok := cfc.mutation.LoyaltyValue(); ok {
+		_spec.SetField(cardface.FieldLoyaltyValue, field.TypeFloat32, value)
+		_node.LoyaltyValue = &value
+	}
 	if value, ok := cfc.mutation.ManaCost(); ok {
 		_spec.SetField(cardface.FieldManaCost, field.TypeString, value)
 		_node.ManaCost = value
@@ -273,6 +367,14 @@ func (cfc *CardFaceCreate) createSpec() (*CardFace, *sqlgraph.CreateSpec) {
 		_spec.SetField(cardface.FieldColors, field.TypeString, value)
 		_node.Colors = value
 	}
+	if value, ok := cfc.mutation.ColorField(); ok {
+		_spec.SetField(cardface.FieldColorField, field.TypeUint8, value)
+		_node.ColorField = value
+	}
+	if value, ok := cfc.mutation.ContentHash(); ok {
+		_spec.SetField(cardface.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if nodes := cfc.mutation.CardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -327,6 +429,7 @@ func (cfcb *CardFaceCreateBulk) Save(ctx context.Context) ([]*CardFace, error) {
 	for i := range cfcb.builders {
 		func(i int, root context.Context) {
 			builder := cfcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*CardFaceMutation)
 				if !ok {
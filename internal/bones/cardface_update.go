@@ -148,6 +148,87 @@ func (cfu *CardFaceUpdate) SetNillableLoyalty(s *string) *CardFaceUpdate {
 	return cfu
 }
 
+// SetPowerValue sets the "power_value" field.
+func (cfu *CardFaceUpdate) SetPowerValue(f float32) *CardFaceUpdate {
+	cfu.mutation.ResetPowerValue()
+	cfu.mutation.SetPowerValue(f)
+	return cfu
+}
+
+// SetNillablePowerValue sets the "power_value" field if the given value is not nil.
+func (cfu *CardFaceUpdate) SetNillablePowerValue(f *float32) *CardFaceUpdate {
+	if f != nil {
+		cfu.SetPowerValue(*f)
+	}
+	return cfu
+}
+
+// AddPowerValue adds f to the "power_value" field.
+func (cfu *CardFaceUpdate) AddPowerValue(f float32) *CardFaceUpdate {
+	cfu.mutation.AddPowerValue(f)
+	return cfu
+}
+
+// ClearPowerValue clears the value of the "power_value" field.
+func (cfu *CardFaceUpdate) ClearPowerValue() *CardFaceUpdate {
+	cfu.mutation.ClearPowerValue()
+	return cfu
+}
+
+// SetToughnessValue sets the "toughness_value" field.
+func (cfu *CardFaceUpdate) SetToughnessValue(f float32) *CardFaceUpdate {
+	cfu.mutation.ResetToughnessValue()
+	cfu.mutation.SetToughnessValue(f)
+	return cfu
+}
+
+// SetNillableToughnessValue sets the "toughness_value" field if the given value is not nil.
+func (cfu *CardFaceUpdate) SetNillableToughnessValue(f *float32) *CardFaceUpdate {
+	if f != nil {
+		cfu.SetToughnessValue(*f)
+	}
+	return cfu
+}
+
+// AddToughnessValue adds f to the "toughness_value" field.
+func (cfu *CardFaceUpdate) AddToughnessValue(f float32) *CardFaceUpdate {
+	cfu.mutation.AddToughnessValue(f)
+	return cfu
+}
+
+// ClearToughnessValue clears the value of the "toughness_value" field.
+func (cfu *CardFaceUpdate) ClearToughnessValue() *CardFaceUpdate {
+	cfu.mutation.ClearToughnessValue()
+	return cfu
+}
+
+// SetLoyaltyValue sets the "loyalty_value" field.
+func (cfu *CardFaceUpdate) SetLoyaltyValue(f float32) *CardFaceUpdate {
+	cfu.mutation.ResetLoyaltyValue()
+	cfu.mutation.SetLoyaltyValue(f)
+	return cfu
+}
+
+// SetNillableLoyaltyValue sets the "loyalty_value" field if the given value is not nil.
+func (cfu *CardFaceUpdate) SetNillableLoyaltyValue(f *float32) *CardFaceUpdate {
+	if f != nil {
+		cfu.SetLoyaltyValue(*f)
+	}
+	return cfu
+}
+
+// AddLoyaltyValue adds f to the "loyalty_value" field.
+func (cfu *CardFaceUpdate) AddLoyaltyValue(f float32) *CardFaceUpdate {
+	cfu.mutation.AddLoyaltyValue(f)
+	return cfu
+}
+
+// ClearLoyaltyValue clears the value of the "loyalty_value" field.
+func (cfu *CardFaceUpdate) ClearLoyaltyValue() *CardFaceUpdate {
+	cfu.mutation.ClearLoyaltyValue()
+	return cfu
+}
+
 // SetManaCost sets the "mana_cost" field.
 func (cfu *CardFaceUpdate) SetManaCost(s string) *CardFaceUpdate {
 	cfu.mutation.SetManaCost(s)
@@ -190,6 +271,47 @@ func (cfu *CardFaceUpdate) SetNillableColors(s *string) *CardFaceUpdate {
 	return cfu
 }
 
+// SetColorField sets the "color_field" field.
+func (cfu *CardFaceUpdate) SetColorField(u uint8) *CardFaceUpdate {
+	cfu.mutation.ResetColorField()
+	cfu.mutation.SetColorField(u)
+	return cfu
+}
+
+// SetNillableColorField sets the "color_field" field if the given value is not nil.
+func (cfu *CardFaceUpdate) SetNillableColorField(u *uint8) *CardFaceUpdate {
+	if u != nil {
+		cfu.SetColorField(*u)
+	}
+	return cfu
+}
+
+// AddColorField adds u to the "color_field" field.
+func (cfu *CardFaceUpdate) AddColorField(u int8) *CardFaceUpdate {
+	cfu.mutation.AddColorField(u)
+	return cfu
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cfu *CardFaceUpdate) SetContentHash(s string) *CardFaceUpdate {
+	cfu.mutation.SetContentHash(s)
+	return cfu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cfu *CardFaceUpdate) SetNillableContentHash(s *string) *CardFaceUpdate {
+	if s != nil {
+		cfu.SetContentHash(*s)
+	}
+	return cfu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (cfu *CardFaceUpdate) ClearContentHash() *CardFaceUpdate {
+	cfu.mutation.ClearContentHash()
+	return cfu
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (cfu *CardFaceUpdate) SetCardID(id int) *CardFaceUpdate {
 	cfu.mutation.SetCardID(id)
@@ -342,6 +464,33 @@ func (cfu *CardFaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cfu.mutation.Loyalty(); ok {
 		_spec.SetField(cardface.FieldLoyalty, field.TypeString, value)
 	}
+	if value, ok := cfu.mutation.PowerValue(); ok {
+		_spec.SetField(cardface.FieldPowerValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfu.mutation.AddedPowerValue(); ok {
+		_spec.AddField(cardface.FieldPowerValue, field.TypeFloat32, value)
+	}
+	if cfu.mutation.PowerValueCleared() {
+		_spec.ClearField(cardface.FieldPowerValue, field.TypeFloat32)
+	}
+	if value, ok := cfu.mutation.ToughnessValue(); ok {
+		_spec.SetField(cardface.FieldToughnessValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfu.mutation.AddedToughnessValue(); ok {
+		_spec.AddField(cardface.FieldToughnessValue, field.TypeFloat32, value)
+	}
+	if cfu.mutation.ToughnessValueCleared() {
+		_spec.ClearField(cardface.FieldToughnessValue, field.TypeFloat32)
+	}
+	if value, ok := cfu.mutation.LoyaltyValue(); ok {
+		_spec.SetField(cardface.FieldLoyaltyValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfu.mutation.AddedLoyaltyValue(); ok {
+		_spec.AddField(cardface.FieldLoyaltyValue, field.TypeFloat32, value)
+	}
+	if cfu.mutation.LoyaltyValueCleared() {
+		_spec.ClearField(cardface.FieldLoyaltyValue, field.TypeFloat32)
+	}
 	if value, ok := cfu.mutation.ManaCost(); ok {
 		_spec.SetField(cardface.FieldManaCost, field.TypeString, value)
 	}
@@ -351,6 +500,18 @@ func (cfu *CardFaceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cfu.mutation.Colors(); ok {
 		_spec.SetField(cardface.FieldColors, field.TypeString, value)
 	}
+	if value, ok := cfu.mutation.ColorField(); ok {
+		_spec.SetField(cardface.FieldColorField, field.TypeUint8, value)
+	}
+	if value, ok := cfu.mutation.AddedColorField(); ok {
+		_spec.AddField(cardface.FieldColorField, field.TypeUint8, value)
+	}
+	if value, ok := cfu.mutation.ContentHash(); ok {
+		_spec.SetField(cardface.FieldContentHash, field.TypeString, value)
+	}
+	if cfu.mutation.ContentHashCleared() {
+		_spec.ClearField(cardface.FieldContentHash, field.TypeString)
+	}
 	if cfu.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -564,6 +725,87 @@ func (cfuo *CardFaceUpdateOne) SetNillableLoyalty(s *string) *CardFaceUpdateOne
 	return cfuo
 }
 
+// SetPowerValue sets the "power_value" field.
+func (cfuo *CardFaceUpdateOne) SetPowerValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.ResetPowerValue()
+	cfuo.mutation.SetPowerValue(f)
+	return cfuo
+}
+
+// SetNillablePowerValue sets the "power_value" field if the given value is not nil.
+func (cfuo *CardFaceUpdateOne) SetNillablePowerValue(f *float32) *CardFaceUpdateOne {
+	if f != nil {
+		cfuo.SetPowerValue(*f)
+	}
+	return cfuo
+}
+
+// AddPowerValue adds f to the "power_value" field.
+func (cfuo *CardFaceUpdateOne) AddPowerValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.AddPowerValue(f)
+	return cfuo
+}
+
+// ClearPowerValue clears the value of the "power_value" field.
+func (cfuo *CardFaceUpdateOne) ClearPowerValue() *CardFaceUpdateOne {
+	cfuo.mutation.ClearPowerValue()
+	return cfuo
+}
+
+// SetToughnessValue sets the "toughness_value" field.
+func (cfuo *CardFaceUpdateOne) SetToughnessValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.ResetToughnessValue()
+	cfuo.mutation.SetToughnessValue(f)
+	return cfuo
+}
+
+// SetNillableToughnessValue sets the "toughness_value" field if the given value is not nil.
+func (cfuo *CardFaceUpdateOne) SetNillableToughnessValue(f *float32) *CardFaceUpdateOne {
+	if f != nil {
+		cfuo.SetToughnessValue(*f)
+	}
+	return cfuo
+}
+
+// AddToughnessValue adds f to the "toughness_value" field.
+func (cfuo *CardFaceUpdateOne) AddToughnessValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.AddToughnessValue(f)
+	return cfuo
+}
+
+// ClearToughnessValue clears the value of the "toughness_value" field.
+func (cfuo *CardFaceUpdateOne) ClearToughnessValue() *CardFaceUpdateOne {
+	cfuo.mutation.ClearToughnessValue()
+	return cfuo
+}
+
+// SetLoyaltyValue sets the "loyalty_value" field.
+func (cfuo *CardFaceUpdateOne) SetLoyaltyValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.ResetLoyaltyValue()
+	cfuo.mutation.SetLoyaltyValue(f)
+	return cfuo
+}
+
+// SetNillableLoyaltyValue sets the "loyalty_value" field if the given value is not nil.
+func (cfuo *CardFaceUpdateOne) SetNillableLoyaltyValue(f *float32) *CardFaceUpdateOne {
+	if f != nil {
+		cfuo.SetLoyaltyValue(*f)
+	}
+	return cfuo
+}
+
+// AddLoyaltyValue adds f to the "loyalty_value" field.
+func (cfuo *CardFaceUpdateOne) AddLoyaltyValue(f float32) *CardFaceUpdateOne {
+	cfuo.mutation.AddLoyaltyValue(f)
+	return cfuo
+}
+
+// ClearLoyaltyValue clears the value of the "loyalty_value" field.
+func (cfuo *CardFaceUpdateOne) ClearLoyaltyValue() *CardFaceUpdateOne {
+	cfuo.mutation.ClearLoyaltyValue()
+	return cfuo
+}
+
 // SetManaCost sets the "mana_cost" field.
 func (cfuo *CardFaceUpdateOne) SetManaCost(s string) *CardFaceUpdateOne {
 	cfuo.mutation.SetManaCost(s)
@@ -606,6 +848,47 @@ func (cfuo *CardFaceUpdateOne) SetNillableColors(s *string) *CardFaceUpdateOne {
 	return cfuo
 }
 
+// SetColorField sets the "color_field" field.
+func (cfuo *CardFaceUpdateOne) SetColorField(u uint8) *CardFaceUpdateOne {
+	cfuo.mutation.ResetColorField()
+	cfuo.mutation.SetColorField(u)
+	return cfuo
+}
+
+// SetNillableColorField sets the "color_field" field if the given value is not nil.
+func (cfuo *CardFaceUpdateOne) SetNillableColorField(u *uint8) *CardFaceUpdateOne {
+	if u != nil {
+		cfuo.SetColorField(*u)
+	}
+	return cfuo
+}
+
+// AddColorField adds u to the "color_field" field.
+func (cfuo *CardFaceUpdateOne) AddColorField(u int8) *CardFaceUpdateOne {
+	cfuo.mutation.AddColorField(u)
+	return cfuo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (cfuo *CardFaceUpdateOne) SetContentHash(s string) *CardFaceUpdateOne {
+	cfuo.mutation.SetContentHash(s)
+	return cfuo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (cfuo *CardFaceUpdateOne) SetNillableContentHash(s *string) *CardFaceUpdateOne {
+	if s != nil {
+		cfuo.SetContentHash(*s)
+	}
+	return cfuo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (cfuo *CardFaceUpdateOne) ClearContentHash() *CardFaceUpdateOne {
+	cfuo.mutation.ClearContentHash()
+	return cfuo
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (cfuo *CardFaceUpdateOne) SetCardID(id int) *CardFaceUpdateOne {
 	cfuo.mutation.SetCardID(id)
@@ -788,6 +1071,33 @@ func (cfuo *CardFaceUpdateOne) sqlSave(ctx context.Context) (_node *CardFace, er
 	if value, ok := cfuo.mutation.Loyalty(); ok {
 		_spec.SetField(cardface.FieldLoyalty, field.TypeString, value)
 	}
+	if value, ok := cfuo.mutation.PowerValue(); ok {
+		_spec.SetField(cardface.FieldPowerValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfuo.mutation.AddedPowerValue(); ok {
+		_spec.AddField(cardface.FieldPowerValue, field.TypeFloat32, value)
+	}
+	if cfuo.mutation.PowerValueCleared() {
+		_spec.ClearField(cardface.FieldPowerValue, field.TypeFloat32)
+	}
+	if value, ok := cfuo.mutation.ToughnessValue(); ok {
+		_spec.SetField(cardface.FieldToughnessValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfuo.mutation.AddedToughnessValue(); ok {
+		_spec.AddField(cardface.FieldToughnessValue, field.TypeFloat32, value)
+	}
+	if cfuo.mutation.ToughnessValueCleared() {
+		_spec.ClearField(cardface.FieldToughnessValue, field.TypeFloat32)
+	}
+	if value, ok := cfuo.mutation.LoyaltyValue(); ok {
+		_spec.SetField(cardface.FieldLoyaltyValue, field.TypeFloat32, value)
+	}
+	if value, ok := cfuo.mutation.AddedLoyaltyValue(); ok {
+		_spec.AddField(cardface.FieldLoyaltyValue, field.TypeFloat32, value)
+	}
+	if cfuo.mutation.LoyaltyValueCleared() {
+		_spec.ClearField(cardface.FieldLoyaltyValue, field.TypeFloat32)
+	}
 	if value, ok := cfuo.mutation.ManaCost(); ok {
 		_spec.SetField(cardface.FieldManaCost, field.TypeString, value)
 	}
@@ -797,6 +1107,18 @@ func (cfuo *CardFaceUpdateOne) sqlSave(ctx context.Context) (_node *CardFace, er
 	if value, ok := cfuo.mutation.Colors(); ok {
 		_spec.SetField(cardface.FieldColors, field.TypeString, value)
 	}
+	if value, ok := cfuo.mutation.ColorField(); ok {
+		_spec.SetField(cardface.FieldColorField, field.TypeUint8, value)
+	}
+	if value, ok := cfuo.mutation.AddedColorField(); ok {
+		_spec.AddField(cardface.FieldColorField, field.TypeUint8, value)
+	}
+	if value, ok := cfuo.mutation.ContentHash(); ok {
+		_spec.SetField(cardface.FieldContentHash, field.TypeString, value)
+	}
+	if cfuo.mutation.ContentHashCleared() {
+		_spec.ClearField(cardface.FieldContentHash, field.TypeString)
+	}
 	if cfuo.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
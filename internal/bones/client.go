@@ -16,8 +16,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/printing"
 	"github.com/SethCurry/stax/internal/bones/printingimage"
 	"github.com/SethCurry/stax/internal/bones/ruling"
@@ -31,10 +33,14 @@ type Client struct {
 	Schema *migrate.Schema
 	// Artist is the client for interacting with the Artist builders.
 	Artist *ArtistClient
+	// BulkImport is the client for interacting with the BulkImport builders.
+	BulkImport *BulkImportClient
 	// Card is the client for interacting with the Card builders.
 	Card *CardClient
 	// CardFace is the client for interacting with the CardFace builders.
 	CardFace *CardFaceClient
+	// Legality is the client for interacting with the Legality builders.
+	Legality *LegalityClient
 	// Printing is the client for interacting with the Printing builders.
 	Printing *PrintingClient
 	// PrintingImage is the client for interacting with the PrintingImage builders.
@@ -55,8 +61,10 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Artist = NewArtistClient(c.config)
+	c.BulkImport = NewBulkImportClient(c.config)
 	c.Card = NewCardClient(c.config)
 	c.CardFace = NewCardFaceClient(c.config)
+	c.Legality = NewLegalityClient(c.config)
 	c.Printing = NewPrintingClient(c.config)
 	c.PrintingImage = NewPrintingImageClient(c.config)
 	c.Ruling = NewRulingClient(c.config)
@@ -154,8 +162,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:           ctx,
 		config:        cfg,
 		Artist:        NewArtistClient(cfg),
+		BulkImport:    NewBulkImportClient(cfg),
 		Card:          NewCardClient(cfg),
 		CardFace:      NewCardFaceClient(cfg),
+		Legality:      NewLegalityClient(cfg),
 		Printing:      NewPrintingClient(cfg),
 		PrintingImage: NewPrintingImageClient(cfg),
 		Ruling:        NewRulingClient(cfg),
@@ -180,8 +190,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:           ctx,
 		config:        cfg,
 		Artist:        NewArtistClient(cfg),
+		BulkImport:    NewBulkImportClient(cfg),
 		Card:          NewCardClient(cfg),
 		CardFace:      NewCardFaceClient(cfg),
+		Legality:      NewLegalityClient(cfg),
 		Printing:      NewPrintingClient(cfg),
 		PrintingImage: NewPrintingImageClient(cfg),
 		Ruling:        NewRulingClient(cfg),
@@ -215,7 +227,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Artist, c.Card, c.CardFace, c.Printing, c.PrintingImage, c.Ruling, c.Set,
+		c.Artist, c.BulkImport, c.Card, c.CardFace, c.Legality, c.Printing,
+		c.PrintingImage, c.Ruling, c.Set,
 	} {
 		n.Use(hooks...)
 	}
@@ -225,7 +238,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Artist, c.Card, c.CardFace, c.Printing, c.PrintingImage, c.Ruling, c.Set,
+		c.Artist, c.BulkImport, c.Card, c.CardFace, c.Legality, c.Printing,
+		c.PrintingImage, c.Ruling, c.Set,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -236,10 +250,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ArtistMutation:
 		return c.Artist.mutate(ctx, m)
+	case *BulkImportMutation:
+		return c.BulkImport.mutate(ctx, m)
 	case *CardMutation:
 		return c.Card.mutate(ctx, m)
 	case *CardFaceMutation:
 		return c.CardFace.mutate(ctx, m)
+	case *LegalityMutation:
+		return c.Legality.mutate(ctx, m)
 	case *PrintingMutation:
 		return c.Printing.mutate(ctx, m)
 	case *PrintingImageMutation:
@@ -402,6 +420,139 @@ func (c *ArtistClient) mutate(ctx context.Context, m *ArtistMutation) (Value, er
 	}
 }
 
+// BulkImportClient is a client for the BulkImport schema.
+type BulkImportClient struct {
+	config
+}
+
+// NewBulkImportClient returns a client for the BulkImport from the given config.
+func NewBulkImportClient(c config) *BulkImportClient {
+	return &BulkImportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bulkimport.Hooks(f(g(h())))`.
+func (c *BulkImportClient) Use(hooks ...Hook) {
+	c.hooks.BulkImport = append(c.hooks.BulkImport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bulkimport.Intercept(f(g(h())))`.
+func (c *BulkImportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BulkImport = append(c.inters.BulkImport, interceptors...)
+}
+
+// Create returns a builder for creating a BulkImport entity.
+func (c *BulkImportClient) Create() *BulkImportCreate {
+	mutation := newBulkImportMutation(c.config, OpCreate)
+	return &BulkImportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BulkImport entities.
+func (c *BulkImportClient) CreateBulk(builders ...*BulkImportCreate) *BulkImportCreateBulk {
+	return &BulkImportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BulkImportClient) MapCreateBulk(slice any, setFunc func(*BulkImportCreate, int)) *BulkImportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BulkImportCreateBulk{err: fmt.Errorf("calling to BulkImportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BulkImportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BulkImportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BulkImport.
+func (c *BulkImportClient) Update() *BulkImportUpdate {
+	mutation := newBulkImportMutation(c.config, OpUpdate)
+	return &BulkImportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BulkImportClient) UpdateOne(bi *BulkImport) *BulkImportUpdateOne {
+	mutation := newBulkImportMutation(c.config, OpUpdateOne, withBulkImport(bi))
+	return &BulkImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BulkImportClient) UpdateOneID(id int) *BulkImportUpdateOne {
+	mutation := newBulkImportMutation(c.config, OpUpdateOne, withBulkImportID(id))
+	return &BulkImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BulkImport.
+func (c *BulkImportClient) Delete() *BulkImportDelete {
+	mutation := newBulkImportMutation(c.config, OpDelete)
+	return &BulkImportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BulkImportClient) DeleteOne(bi *BulkImport) *BulkImportDeleteOne {
+	return c.DeleteOneID(bi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BulkImportClient) DeleteOneID(id int) *BulkImportDeleteOne {
+	builder := c.Delete().Where(bulkimport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BulkImportDeleteOne{builder}
+}
+
+// Query returns a query builder for BulkImport.
+func (c *BulkImportClient) Query() *BulkImportQuery {
+	return &BulkImportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBulkImport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BulkImport entity by its id.
+func (c *BulkImportClient) Get(ctx context.Context, id int) (*BulkImport, error) {
+	return c.Query().Where(bulkimport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BulkImportClient) GetX(ctx context.Context, id int) *BulkImport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BulkImportClient) Hooks() []Hook {
+	return c.hooks.BulkImport
+}
+
+// Interceptors returns the client interceptors.
+func (c *BulkImportClient) Interceptors() []Interceptor {
+	return c.inters.BulkImport
+}
+
+func (c *BulkImportClient) mutate(ctx context.Context, m *BulkImportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BulkImportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BulkImportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BulkImportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BulkImportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("bones: unknown BulkImport mutation op: %q", m.Op())
+	}
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -542,6 +693,22 @@ func (c *CardClient) QueryRulings(ca *Card) *RulingQuery {
 	return query
 }
 
+// QueryLegalities queries the legalities edge of a Card.
+func (c *CardClient) QueryLegalities(ca *Card) *LegalityQuery {
+	query := (&LegalityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(card.Table, card.FieldID, id),
+			sqlgraph.To(legality.Table, legality.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, card.LegalitiesTable, card.LegalitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CardClient) Hooks() []Hook {
 	return c.hooks.Card
@@ -732,6 +899,155 @@ func (c *CardFaceClient) mutate(ctx context.Context, m *CardFaceMutation) (Value
 	}
 }
 
+// LegalityClient is a client for the Legality schema.
+type LegalityClient struct {
+	config
+}
+
+// NewLegalityClient returns a client for the Legality from the given config.
+func NewLegalityClient(c config) *LegalityClient {
+	return &LegalityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `legality.Hooks(f(g(h())))`.
+func (c *LegalityClient) Use(hooks ...Hook) {
+	c.hooks.Legality = append(c.hooks.Legality, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `legality.Intercept(f(g(h())))`.
+func (c *LegalityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Legality = append(c.inters.Legality, interceptors...)
+}
+
+// Create returns a builder for creating a Legality entity.
+func (c *LegalityClient) Create() *LegalityCreate {
+	mutation := newLegalityMutation(c.config, OpCreate)
+	return &LegalityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Legality entities.
+func (c *LegalityClient) CreateBulk(builders ...*LegalityCreate) *LegalityCreateBulk {
+	return &LegalityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LegalityClient) MapCreateBulk(slice any, setFunc func(*LegalityCreate, int)) *LegalityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LegalityCreateBulk{err: fmt.Errorf("calling to LegalityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LegalityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LegalityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Legality.
+func (c *LegalityClient) Update() *LegalityUpdate {
+	mutation := newLegalityMutation(c.config, OpUpdate)
+	return &LegalityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LegalityClient) UpdateOne(l *Legality) *LegalityUpdateOne {
+	mutation := newLegalityMutation(c.config, OpUpdateOne, withLegality(l))
+	return &LegalityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LegalityClient) UpdateOneID(id int) *LegalityUpdateOne {
+	mutation := newLegalityMutation(c.config, OpUpdateOne, withLegalityID(id))
+	return &LegalityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Legality.
+func (c *LegalityClient) Delete() *LegalityDelete {
+	mutation := newLegalityMutation(c.config, OpDelete)
+	return &LegalityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LegalityClient) DeleteOne(l *Legality) *LegalityDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LegalityClient) DeleteOneID(id int) *LegalityDeleteOne {
+	builder := c.Delete().Where(legality.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LegalityDeleteOne{builder}
+}
+
+// Query returns a query builder for Legality.
+func (c *LegalityClient) Query() *LegalityQuery {
+	return &LegalityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLegality},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Legality entity by its id.
+func (c *LegalityClient) Get(ctx context.Context, id int) (*Legality, error) {
+	return c.Query().Where(legality.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LegalityClient) GetX(ctx context.Context, id int) *Legality {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCard queries the card edge of a Legality.
+func (c *LegalityClient) QueryCard(l *Legality) *CardQuery {
+	query := (&CardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(legality.Table, legality.FieldID, id),
+			sqlgraph.To(card.Table, card.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, legality.CardTable, legality.CardColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LegalityClient) Hooks() []Hook {
+	return c.hooks.Legality
+}
+
+// Interceptors returns the client interceptors.
+func (c *LegalityClient) Interceptors() []Interceptor {
+	return c.inters.Legality
+}
+
+func (c *LegalityClient) mutate(ctx context.Context, m *LegalityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LegalityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LegalityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LegalityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LegalityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("bones: unknown Legality mutation op: %q", m.Op())
+	}
+}
+
 // PrintingClient is a client for the Printing schema.
 type PrintingClient struct {
 	config
@@ -1379,9 +1695,11 @@ func (c *SetClient) mutate(ctx context.Context, m *SetMutation) (Value, error) {
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Artist, Card, CardFace, Printing, PrintingImage, Ruling, Set []ent.Hook
+		Artist, BulkImport, Card, CardFace, Legality, Printing, PrintingImage, Ruling,
+		Set []ent.Hook
 	}
 	inters struct {
-		Artist, Card, CardFace, Printing, PrintingImage, Ruling, Set []ent.Interceptor
+		Artist, BulkImport, Card, CardFace, Legality, Printing, PrintingImage, Ruling,
+		Set []ent.Interceptor
 	}
 )
@@ -13,8 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/printing"
 	"github.com/SethCurry/stax/internal/bones/printingimage"
 	"github.com/SethCurry/stax/internal/bones/ruling"
@@ -80,8 +82,10 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			artist.Table:        artist.ValidColumn,
+			bulkimport.Table:    bulkimport.ValidColumn,
 			card.Table:          card.ValidColumn,
 			cardface.Table:      cardface.ValidColumn,
+			legality.Table:      legality.ValidColumn,
 			printing.Table:      printing.ValidColumn,
 			printingimage.Table: printingimage.ValidColumn,
 			ruling.Table:        ruling.ValidColumn,
@@ -21,6 +21,18 @@ func (f ArtistFunc) Mutate(ctx context.Context, m bones.Mutation) (bones.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *bones.ArtistMutation", m)
 }
 
+// The BulkImportFunc type is an adapter to allow the use of ordinary
+// function as BulkImport mutator.
+type BulkImportFunc func(context.Context, *bones.BulkImportMutation) (bones.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BulkImportFunc) Mutate(ctx context.Context, m bones.Mutation) (bones.Value, error) {
+	if mv, ok := m.(*bones.BulkImportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *bones.BulkImportMutation", m)
+}
+
 // The CardFunc type is an adapter to allow the use of ordinary
 // function as Card mutator.
 type CardFunc func(context.Context, *bones.CardMutation) (bones.Value, error)
@@ -45,6 +57,18 @@ func (f CardFaceFunc) Mutate(ctx context.Context, m bones.Mutation) (bones.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *bones.CardFaceMutation", m)
 }
 
+// The LegalityFunc type is an adapter to allow the use of ordinary
+// function as Legality mutator.
+type LegalityFunc func(context.Context, *bones.LegalityMutation) (bones.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LegalityFunc) Mutate(ctx context.Context, m bones.Mutation) (bones.Value, error) {
+	if mv, ok := m.(*bones.LegalityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *bones.LegalityMutation", m)
+}
+
 // The PrintingFunc type is an adapter to allow the use of ordinary
 // function as Printing mutator.
 type PrintingFunc func(context.Context, *bones.PrintingMutation) (bones.Value, error)
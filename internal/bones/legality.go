@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/legality"
+)
+
+// Legality is the model entity for the Legality schema.
+type Legality struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// Legality holds the value of the "legality" field.
+	Legality legality.Legality `json:"legality,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LegalityQuery when eager-loading is set.
+	Edges         LegalityEdges `json:"edges"`
+	legality_card *int
+	selectValues  sql.SelectValues
+}
+
+// LegalityEdges holds the relations/edges for other nodes in the graph.
+type LegalityEdges struct {
+	// Card holds the value of the card edge.
+	Card *Card `json:"card,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CardOrErr returns the Card value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LegalityEdges) CardOrErr() (*Card, error) {
+	if e.Card != nil {
+		return e.Card, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: card.Label}
+	}
+	return nil, &NotLoadedError{edge: "card"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Legality) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case legality.FieldID:
+			values[i] = new(sql.NullInt64)
+		case legality.FieldFormat, legality.FieldLegality:
+			values[i] = new(sql.NullString)
+		case legality.ForeignKeys[0]: // legality_card
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Legality fields.
+func (l *Legality) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case legality.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case legality.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				l.Format = value.String
+			}
+		case legality.FieldLegality:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legality", values[i])
+			} else if value.Valid {
+				l.Legality = legality.Legality(value.String)
+			}
+		case legality.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field legality_card", value)
+			} else if value.Valid {
+				l.legality_card = new(int)
+				*l.legality_card = int(value.Int64)
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Legality.
+// This includes values selected through modifiers, order, etc.
+func (l *Legality) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// QueryCard queries the "card" edge of the Legality entity.
+func (l *Legality) QueryCard() *CardQuery {
+	return NewLegalityClient(l.config).QueryCard(l)
+}
+
+// Update returns a builder for updating this Legality.
+// Note that you need to call Legality.Unwrap() before calling this method if this Legality
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Legality) Update() *LegalityUpdateOne {
+	return NewLegalityClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Legality entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Legality) Unwrap() *Legality {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("bones: Legality is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Legality) String() string {
+	var builder strings.Builder
+	builder.WriteString("Legality(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("format=")
+	builder.WriteString(l.Format)
+	builder.WriteString(", ")
+	builder.WriteString("legality=")
+	builder.WriteString(fmt.Sprintf("%v", l.Legality))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Legalities is a parsable slice of Legality.
+type Legalities []*Legality
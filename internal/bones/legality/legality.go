@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legality
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the legality type in the database.
+	Label = "legality"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldLegality holds the string denoting the legality field in the database.
+	FieldLegality = "legality"
+	// EdgeCard holds the string denoting the card edge name in mutations.
+	EdgeCard = "card"
+	// Table holds the table name of the legality in the database.
+	Table = "legalities"
+	// CardTable is the table that holds the card relation/edge.
+	CardTable = "legalities"
+	// CardInverseTable is the table name for the Card entity.
+	// It exists in this package in order to avoid circular dependency with the "card" package.
+	CardInverseTable = "cards"
+	// CardColumn is the table column denoting the card relation/edge.
+	CardColumn = "legality_card"
+)
+
+// Columns holds all SQL columns for legality fields.
+var Columns = []string{
+	FieldID,
+	FieldFormat,
+	FieldLegality,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "legalities"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"legality_card",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FormatValidator is a validator for the "format" field. It is called by the builders before save.
+	FormatValidator func(string) error
+)
+
+// Legality defines the type for the "legality" enum field.
+type Legality string
+
+// Legality values.
+const (
+	LegalityLegal      Legality = "legal"
+	LegalityNotLegal   Legality = "not_legal"
+	LegalityRestricted Legality = "restricted"
+	LegalityBanned     Legality = "banned"
+)
+
+func (l Legality) String() string {
+	return string(l)
+}
+
+// LegalityValidator is a validator for the "legality" field enum values. It is called by the builders before save.
+func LegalityValidator(l Legality) error {
+	switch l {
+	case LegalityLegal, LegalityNotLegal, LegalityRestricted, LegalityBanned:
+		return nil
+	default:
+		return fmt.Errorf("legality: invalid enum value for legality field: %q", l)
+	}
+}
+
+// OrderOption defines the ordering options for the Legality queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByLegality orders the results by the legality field.
+func ByLegality(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegality, opts...).ToFunc()
+}
+
+// ByCardField orders the results by card field.
+func ByCardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CardTable, CardColumn),
+	)
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legality
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Legality {
+	return predicate.Legality(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Legality {
+	return predicate.Legality(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Legality {
+	return predicate.Legality(sql.FieldLTE(FieldID, id))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.Legality {
+	return predicate.Legality(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.Legality {
+	return predicate.Legality(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.Legality {
+	return predicate.Legality(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// LegalityEQ applies the EQ predicate on the "legality" field.
+func LegalityEQ(v Legality) predicate.Legality {
+	return predicate.Legality(sql.FieldEQ(FieldLegality, v))
+}
+
+// LegalityNEQ applies the NEQ predicate on the "legality" field.
+func LegalityNEQ(v Legality) predicate.Legality {
+	return predicate.Legality(sql.FieldNEQ(FieldLegality, v))
+}
+
+// LegalityIn applies the In predicate on the "legality" field.
+func LegalityIn(vs ...Legality) predicate.Legality {
+	return predicate.Legality(sql.FieldIn(FieldLegality, vs...))
+}
+
+// LegalityNotIn applies the NotIn predicate on the "legality" field.
+func LegalityNotIn(vs ...Legality) predicate.Legality {
+	return predicate.Legality(sql.FieldNotIn(FieldLegality, vs...))
+}
+
+// HasCard applies the HasEdge predicate on the "card" edge.
+func HasCard() predicate.Legality {
+	return predicate.Legality(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CardTable, CardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCardWith applies the HasEdge predicate on the "card" edge with a given conditions (other predicates).
+func HasCardWith(preds ...predicate.Card) predicate.Legality {
+	return predicate.Legality(func(s *sql.Selector) {
+		step := newCardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Legality) predicate.Legality {
+	return predicate.Legality(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Legality) predicate.Legality {
+	return predicate.Legality(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Legality) predicate.Legality {
+	return predicate.Legality(sql.NotPredicates(p))
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/legality"
+)
+
+// LegalityCreate is the builder for creating a Legality entity.
+type LegalityCreate struct {
+	config
+	mutation *LegalityMutation
+	hooks    []Hook
+}
+
+// SetFormat sets the "format" field.
+func (lc *LegalityCreate) SetFormat(s string) *LegalityCreate {
+	lc.mutation.SetFormat(s)
+	return lc
+}
+
+// SetLegality sets the "legality" field.
+func (lc *LegalityCreate) SetLegality(l legality.Legality) *LegalityCreate {
+	lc.mutation.SetLegality(l)
+	return lc
+}
+
+// SetCardID sets the "card" edge to the Card entity by ID.
+func (lc *LegalityCreate) SetCardID(id int) *LegalityCreate {
+	lc.mutation.SetCardID(id)
+	return lc
+}
+
+// SetNillableCardID sets the "card" edge to the Card entity by ID if the given value is not nil.
+func (lc *LegalityCreate) SetNillableCardID(id *int) *LegalityCreate {
+	if id != nil {
+		lc = lc.SetCardID(*id)
+	}
+	return lc
+}
+
+// SetCard sets the "card" edge to the Card entity.
+func (lc *LegalityCreate) SetCard(c *Card) *LegalityCreate {
+	return lc.SetCardID(c.ID)
+}
+
+// Mutation returns the LegalityMutation object of the builder.
+func (lc *LegalityCreate) Mutation() *LegalityMutation {
+	return lc.mutation
+}
+
+// Save creates the Legality in the database.
+func (lc *LegalityCreate) Save(ctx context.Context) (*Legality, error) {
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LegalityCreate) SaveX(ctx context.Context) *Legality {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LegalityCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LegalityCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LegalityCreate) check() error {
+	if _, ok := lc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`bones: missing required field "Legality.format"`)}
+	}
+	if v, ok := lc.mutation.Format(); ok {
+		if err := legality.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`bones: validator failed for field "Legality.format": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.Legality(); !ok {
+		return &ValidationError{Name: "legality", err: errors.New(`bones: missing required field "Legality.legality"`)}
+	}
+	if v, ok := lc.mutation.Legality(); ok {
+		if err := legality.LegalityValidator(v); err != nil {
+			return &ValidationError{Name: "legality", err: fmt.Errorf(`bones: validator failed for field "Legality.legality": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lc *LegalityCreate) sqlSave(ctx context.Context) (*Legality, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LegalityCreate) createSpec() (*Legality, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Legality{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(legality.Table, sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt))
+	)
+	if value, ok := lc.mutation.Format(); ok {
+		_spec.SetField(legality.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := lc.mutation.Legality(); ok {
+		_spec.SetField(legality.FieldLegality, field.TypeEnum, value)
+		_node.Legality = value
+	}
+	if nodes := lc.mutation.CardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   legality.CardTable,
+			Columns: []string{legality.CardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.legality_card = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LegalityCreateBulk is the builder for creating many Legality entities in bulk.
+type LegalityCreateBulk struct {
+	config
+	err      error
+	builders []*LegalityCreate
+}
+
+// Save creates the Legality entities in the database.
+func (lcb *LegalityCreateBulk) Save(ctx context.Context) ([]*Legality, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Legality, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LegalityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LegalityCreateBulk) SaveX(ctx context.Context) []*Legality {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LegalityCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LegalityCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
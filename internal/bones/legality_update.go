@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/legality"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+)
+
+// LegalityUpdate is the builder for updating Legality entities.
+type LegalityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LegalityMutation
+}
+
+// Where appends a list predicates to the LegalityUpdate builder.
+func (lu *LegalityUpdate) Where(ps ...predicate.Legality) *LegalityUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetFormat sets the "format" field.
+func (lu *LegalityUpdate) SetFormat(s string) *LegalityUpdate {
+	lu.mutation.SetFormat(s)
+	return lu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (lu *LegalityUpdate) SetNillableFormat(s *string) *LegalityUpdate {
+	if s != nil {
+		lu.SetFormat(*s)
+	}
+	return lu
+}
+
+// SetLegality sets the "legality" field.
+func (lu *LegalityUpdate) SetLegality(l legality.Legality) *LegalityUpdate {
+	lu.mutation.SetLegality(l)
+	return lu
+}
+
+// SetNillableLegality sets the "legality" field if the given value is not nil.
+func (lu *LegalityUpdate) SetNillableLegality(l *legality.Legality) *LegalityUpdate {
+	if l != nil {
+		lu.SetLegality(*l)
+	}
+	return lu
+}
+
+// SetCardID sets the "card" edge to the Card entity by ID.
+func (lu *LegalityUpdate) SetCardID(id int) *LegalityUpdate {
+	lu.mutation.SetCardID(id)
+	return lu
+}
+
+// SetNillableCardID sets the "card" edge to the Card entity by ID if the given value is not nil.
+func (lu *LegalityUpdate) SetNillableCardID(id *int) *LegalityUpdate {
+	if id != nil {
+		lu = lu.SetCardID(*id)
+	}
+	return lu
+}
+
+// SetCard sets the "card" edge to the Card entity.
+func (lu *LegalityUpdate) SetCard(c *Card) *LegalityUpdate {
+	return lu.SetCardID(c.ID)
+}
+
+// Mutation returns the LegalityMutation object of the builder.
+func (lu *LegalityUpdate) Mutation() *LegalityMutation {
+	return lu.mutation
+}
+
+// ClearCard clears the "card" edge to the Card entity.
+func (lu *LegalityUpdate) ClearCard() *LegalityUpdate {
+	lu.mutation.ClearCard()
+	return lu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LegalityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LegalityUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LegalityUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LegalityUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LegalityUpdate) check() error {
+	if v, ok := lu.mutation.Format(); ok {
+		if err := legality.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`bones: validator failed for field "Legality.format": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.Legality(); ok {
+		if err := legality.LegalityValidator(v); err != nil {
+			return &ValidationError{Name: "legality", err: fmt.Errorf(`bones: validator failed for field "Legality.legality": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LegalityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(legality.Table, legality.Columns, sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Format(); ok {
+		_spec.SetField(legality.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Legality(); ok {
+		_spec.SetField(legality.FieldLegality, field.TypeEnum, value)
+	}
+	if lu.mutation.CardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   legality.CardTable,
+			Columns: []string{legality.CardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.CardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   legality.CardTable,
+			Columns: []string{legality.CardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{legality.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LegalityUpdateOne is the builder for updating a single Legality entity.
+type LegalityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LegalityMutation
+}
+
+// SetFormat sets the "format" field.
+func (luo *LegalityUpdateOne) SetFormat(s string) *LegalityUpdateOne {
+	luo.mutation.SetFormat(s)
+	return luo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (luo *LegalityUpdateOne) SetNillableFormat(s *string) *LegalityUpdateOne {
+	if s != nil {
+		luo.SetFormat(*s)
+	}
+	return luo
+}
+
+// SetLegality sets the "legality" field.
+func (luo *LegalityUpdateOne) SetLegality(l legality.Legality) *LegalityUpdateOne {
+	luo.mutation.SetLegality(l)
+	return luo
+}
+
+// SetNillableLegality sets the "legality" field if the given value is not nil.
+func (luo *LegalityUpdateOne) SetNillableLegality(l *legality.Legality) *LegalityUpdateOne {
+	if l != nil {
+		luo.SetLegality(*l)
+	}
+	return luo
+}
+
+// SetCardID sets the "card" edge to the Card entity by ID.
+func (luo *LegalityUpdateOne) SetCardID(id int) *LegalityUpdateOne {
+	luo.mutation.SetCardID(id)
+	return luo
+}
+
+// SetNillableCardID sets the "card" edge to the Card entity by ID if the given value is not nil.
+func (luo *LegalityUpdateOne) SetNillableCardID(id *int) *LegalityUpdateOne {
+	if id != nil {
+		luo = luo.SetCardID(*id)
+	}
+	return luo
+}
+
+// SetCard sets the "card" edge to the Card entity.
+func (luo *LegalityUpdateOne) SetCard(c *Card) *LegalityUpdateOne {
+	return luo.SetCardID(c.ID)
+}
+
+// Mutation returns the LegalityMutation object of the builder.
+func (luo *LegalityUpdateOne) Mutation() *LegalityMutation {
+	return luo.mutation
+}
+
+// ClearCard clears the "card" edge to the Card entity.
+func (luo *LegalityUpdateOne) ClearCard() *LegalityUpdateOne {
+	luo.mutation.ClearCard()
+	return luo
+}
+
+// Where appends a list predicates to the LegalityUpdate builder.
+func (luo *LegalityUpdateOne) Where(ps ...predicate.Legality) *LegalityUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LegalityUpdateOne) Select(field string, fields ...string) *LegalityUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Legality entity.
+func (luo *LegalityUpdateOne) Save(ctx context.Context) (*Legality, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LegalityUpdateOne) SaveX(ctx context.Context) *Legality {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LegalityUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LegalityUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LegalityUpdateOne) check() error {
+	if v, ok := luo.mutation.Format(); ok {
+		if err := legality.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`bones: validator failed for field "Legality.format": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.Legality(); ok {
+		if err := legality.LegalityValidator(v); err != nil {
+			return &ValidationError{Name: "legality", err: fmt.Errorf(`bones: validator failed for field "Legality.legality": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LegalityUpdateOne) sqlSave(ctx context.Context) (_node *Legality, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(legality.Table, legality.Columns, sqlgraph.NewFieldSpec(legality.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`bones: missing "Legality.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, legality.FieldID)
+		for _, f := range fields {
+			if !legality.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("bones: invalid field %q for query", f)}
+			}
+			if f != legality.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Format(); ok {
+		_spec.SetField(legality.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Legality(); ok {
+		_spec.SetField(legality.FieldLegality, field.TypeEnum, value)
+	}
+	if luo.mutation.CardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   legality.CardTable,
+			Columns: []string{legality.CardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.CardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   legality.CardTable,
+			Columns: []string{legality.CardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(card.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Legality{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{legality.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
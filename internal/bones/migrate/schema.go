@@ -19,12 +19,27 @@ var (
 		Columns:    ArtistsColumns,
 		PrimaryKey: []*schema.Column{ArtistsColumns[0]},
 	}
+	// BulkImportsColumns holds the columns for the "bulk_imports" table.
+	BulkImportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "imported_at", Type: field.TypeTime},
+	}
+	// BulkImportsTable holds the schema information for the "bulk_imports" table.
+	BulkImportsTable = &schema.Table{
+		Name:       "bulk_imports",
+		Columns:    BulkImportsColumns,
+		PrimaryKey: []*schema.Column{BulkImportsColumns[0]},
+	}
 	// CardsColumns holds the columns for the "cards" table.
 	CardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "oracle_id", Type: field.TypeString},
 		{Name: "color_identity", Type: field.TypeUint8},
+		{Name: "layout", Type: field.TypeString, Default: "normal"},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 	}
 	// CardsTable holds the schema information for the "cards" table.
 	CardsTable = &schema.Table{
@@ -43,9 +58,14 @@ var (
 		{Name: "power", Type: field.TypeString},
 		{Name: "toughness", Type: field.TypeString},
 		{Name: "loyalty", Type: field.TypeString},
+		{Name: "power_value", Type: field.TypeFloat32, Nullable: true},
+		{Name: "toughness_value", Type: field.TypeFloat32, Nullable: true},
+		{Name: "loyalty_value", Type: field.TypeFloat32, Nullable: true},
 		{Name: "mana_cost", Type: field.TypeString},
 		{Name: "type_line", Type: field.TypeString},
 		{Name: "colors", Type: field.TypeString},
+		{Name: "color_field", Type: field.TypeUint8, Default: 0},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 		{Name: "card_face_card", Type: field.TypeInt, Nullable: true},
 	}
 	// CardFacesTable holds the schema information for the "card_faces" table.
@@ -56,7 +76,28 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "card_faces_cards_card",
-				Columns:    []*schema.Column{CardFacesColumns[12]},
+				Columns:    []*schema.Column{CardFacesColumns[17]},
+				RefColumns: []*schema.Column{CardsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// LegalitiesColumns holds the columns for the "legalities" table.
+	LegalitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeString},
+		{Name: "legality", Type: field.TypeEnum, Enums: []string{"legal", "not_legal", "restricted", "banned"}},
+		{Name: "legality_card", Type: field.TypeInt, Nullable: true},
+	}
+	// LegalitiesTable holds the schema information for the "legalities" table.
+	LegalitiesTable = &schema.Table{
+		Name:       "legalities",
+		Columns:    LegalitiesColumns,
+		PrimaryKey: []*schema.Column{LegalitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "legalities_cards_card",
+				Columns:    []*schema.Column{LegalitiesColumns[3]},
 				RefColumns: []*schema.Column{CardsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -66,6 +107,20 @@ var (
 	PrintingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "rarity", Type: field.TypeEnum, Enums: []string{"common", "uncommon", "rare", "mythic", "special", "bonus"}},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "illustration_id", Type: field.TypeString, Nullable: true},
+		{Name: "scryfall_id", Type: field.TypeString, Nullable: true},
+		{Name: "collector_number", Type: field.TypeString, Nullable: true},
+		{Name: "multiverse_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "mtgo_id", Type: field.TypeInt, Nullable: true},
+		{Name: "arena_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tcgplayer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "language", Type: field.TypeString, Default: "en"},
+		{Name: "printed_name", Type: field.TypeString, Nullable: true},
+		{Name: "printed_text", Type: field.TypeString, Nullable: true},
+		{Name: "printed_type_line", Type: field.TypeString, Nullable: true},
+		{Name: "flavor_text", Type: field.TypeString, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 		{Name: "printing_artist", Type: field.TypeInt, Nullable: true},
 		{Name: "printing_set", Type: field.TypeInt, Nullable: true},
 		{Name: "printing_card_face", Type: field.TypeInt, Nullable: true},
@@ -78,23 +133,45 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "printings_artists_artist",
-				Columns:    []*schema.Column{PrintingsColumns[2]},
+				Columns:    []*schema.Column{PrintingsColumns[16]},
 				RefColumns: []*schema.Column{ArtistsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "printings_sets_set",
-				Columns:    []*schema.Column{PrintingsColumns[3]},
+				Columns:    []*schema.Column{PrintingsColumns[17]},
 				RefColumns: []*schema.Column{SetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "printings_card_faces_card_face",
-				Columns:    []*schema.Column{PrintingsColumns[4]},
+				Columns:    []*schema.Column{PrintingsColumns[18]},
 				RefColumns: []*schema.Column{CardFacesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "printing_scryfall_id",
+				Unique:  false,
+				Columns: []*schema.Column{PrintingsColumns[4]},
+			},
+			{
+				Name:    "printing_illustration_id",
+				Unique:  false,
+				Columns: []*schema.Column{PrintingsColumns[3]},
+			},
+			{
+				Name:    "printing_collector_number_printing_set",
+				Unique:  false,
+				Columns: []*schema.Column{PrintingsColumns[5], PrintingsColumns[17]},
+			},
+			{
+				Name:    "printing_language",
+				Unique:  false,
+				Columns: []*schema.Column{PrintingsColumns[10]},
+			},
+		},
 	}
 	// PrintingImagesColumns holds the columns for the "printing_images" table.
 	PrintingImagesColumns = []*schema.Column{
@@ -123,6 +200,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "text", Type: field.TypeString},
 		{Name: "date", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"wotc", "scryfall"}, Default: "wotc"},
 		{Name: "ruling_card", Type: field.TypeInt, Nullable: true},
 	}
 	// RulingsTable holds the schema information for the "rulings" table.
@@ -133,7 +211,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "rulings_cards_card",
-				Columns:    []*schema.Column{RulingsColumns[3]},
+				Columns:    []*schema.Column{RulingsColumns[4]},
 				RefColumns: []*schema.Column{CardsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -144,6 +222,15 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "code", Type: field.TypeString, Size: 255},
+		{Name: "scryfall_id", Type: field.TypeString, Nullable: true},
+		{Name: "set_type", Type: field.TypeString, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "card_count", Type: field.TypeInt, Default: 0},
+		{Name: "parent_set_code", Type: field.TypeString, Nullable: true},
+		{Name: "block", Type: field.TypeString, Nullable: true},
+		{Name: "block_code", Type: field.TypeString, Nullable: true},
+		{Name: "digital", Type: field.TypeBool, Default: false},
+		{Name: "icon_svg_uri", Type: field.TypeString, Nullable: true},
 	}
 	// SetsTable holds the schema information for the "sets" table.
 	SetsTable = &schema.Table{
@@ -154,8 +241,10 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ArtistsTable,
+		BulkImportsTable,
 		CardsTable,
 		CardFacesTable,
+		LegalitiesTable,
 		PrintingsTable,
 		PrintingImagesTable,
 		RulingsTable,
@@ -165,6 +254,7 @@ var (
 
 func init() {
 	CardFacesTable.ForeignKeys[0].RefTable = CardsTable
+	LegalitiesTable.ForeignKeys[0].RefTable = CardsTable
 	PrintingsTable.ForeignKeys[0].RefTable = ArtistsTable
 	PrintingsTable.ForeignKeys[1].RefTable = SetsTable
 	PrintingsTable.ForeignKeys[2].RefTable = CardFacesTable
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/predicate"
 	"github.com/SethCurry/stax/internal/bones/printing"
 	"github.com/SethCurry/stax/internal/bones/printingimage"
@@ -31,8 +33,10 @@ const (
 
 	// Node types.
 	TypeArtist        = "Artist"
+	TypeBulkImport    = "BulkImport"
 	TypeCard          = "Card"
 	TypeCardFace      = "CardFace"
+	TypeLegality      = "Legality"
 	TypePrinting      = "Printing"
 	TypePrintingImage = "PrintingImage"
 	TypeRuling        = "Ruling"
@@ -458,39 +462,32 @@ func (m *ArtistMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Artist edge %s", name)
 }
 
-// CardMutation represents an operation that mutates the Card nodes in the graph.
-type CardMutation struct {
+// BulkImportMutation represents an operation that mutates the BulkImport nodes in the graph.
+type BulkImportMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	name              *string
-	oracle_id         *string
-	color_identity    *uint8
-	addcolor_identity *int8
-	clearedFields     map[string]struct{}
-	faces             map[int]struct{}
-	removedfaces      map[int]struct{}
-	clearedfaces      bool
-	rulings           map[int]struct{}
-	removedrulings    map[int]struct{}
-	clearedrulings    bool
-	done              bool
-	oldValue          func(context.Context) (*Card, error)
-	predicates        []predicate.Card
+	op            Op
+	typ           string
+	id            *int
+	_type         *string
+	updated_at    *time.Time
+	imported_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*BulkImport, error)
+	predicates    []predicate.BulkImport
 }
 
-var _ ent.Mutation = (*CardMutation)(nil)
+var _ ent.Mutation = (*BulkImportMutation)(nil)
 
-// cardOption allows management of the mutation configuration using functional options.
-type cardOption func(*CardMutation)
+// bulkimportOption allows management of the mutation configuration using functional options.
+type bulkimportOption func(*BulkImportMutation)
 
-// newCardMutation creates new mutation for the Card entity.
-func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
-	m := &CardMutation{
+// newBulkImportMutation creates new mutation for the BulkImport entity.
+func newBulkImportMutation(c config, op Op, opts ...bulkimportOption) *BulkImportMutation {
+	m := &BulkImportMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCard,
+		typ:           TypeBulkImport,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -499,20 +496,20 @@ func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
 	return m
 }
 
-// withCardID sets the ID field of the mutation.
-func withCardID(id int) cardOption {
-	return func(m *CardMutation) {
+// withBulkImportID sets the ID field of the mutation.
+func withBulkImportID(id int) bulkimportOption {
+	return func(m *BulkImportMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Card
+			value *BulkImport
 		)
-		m.oldValue = func(ctx context.Context) (*Card, error) {
+		m.oldValue = func(ctx context.Context) (*BulkImport, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Card.Get(ctx, id)
+					value, err = m.Client().BulkImport.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -521,10 +518,10 @@ func withCardID(id int) cardOption {
 	}
 }
 
-// withCard sets the old Card of the mutation.
-func withCard(node *Card) cardOption {
-	return func(m *CardMutation) {
-		m.oldValue = func(context.Context) (*Card, error) {
+// withBulkImport sets the old BulkImport of the mutation.
+func withBulkImport(node *BulkImport) bulkimportOption {
+	return func(m *BulkImportMutation) {
+		m.oldValue = func(context.Context) (*BulkImport, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -533,7 +530,7 @@ func withCard(node *Card) cardOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CardMutation) Client() *Client {
+func (m BulkImportMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -541,7 +538,7 @@ func (m CardMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CardMutation) Tx() (*Tx, error) {
+func (m BulkImportMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
@@ -552,7 +549,7 @@ func (m CardMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CardMutation) ID() (id int, exists bool) {
+func (m *BulkImportMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -563,7 +560,7 @@ func (m *CardMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BulkImportMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -572,257 +569,129 @@ func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Card.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BulkImport.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *CardMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *BulkImportMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CardMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *BulkImportMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Card entity.
-// If the Card object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the BulkImport entity.
+// If the BulkImport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BulkImportMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Type, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CardMutation) ResetName() {
-	m.name = nil
+// ResetType resets all changes to the "type" field.
+func (m *BulkImportMutation) ResetType() {
+	m._type = nil
 }
 
-// SetOracleID sets the "oracle_id" field.
-func (m *CardMutation) SetOracleID(s string) {
-	m.oracle_id = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BulkImportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// OracleID returns the value of the "oracle_id" field in the mutation.
-func (m *CardMutation) OracleID() (r string, exists bool) {
-	v := m.oracle_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BulkImportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOracleID returns the old "oracle_id" field's value of the Card entity.
-// If the Card object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BulkImport entity.
+// If the BulkImport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardMutation) OldOracleID(ctx context.Context) (v string, err error) {
+func (m *BulkImportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOracleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOracleID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOracleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.OracleID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetOracleID resets all changes to the "oracle_id" field.
-func (m *CardMutation) ResetOracleID() {
-	m.oracle_id = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BulkImportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetColorIdentity sets the "color_identity" field.
-func (m *CardMutation) SetColorIdentity(u uint8) {
-	m.color_identity = &u
-	m.addcolor_identity = nil
+// SetImportedAt sets the "imported_at" field.
+func (m *BulkImportMutation) SetImportedAt(t time.Time) {
+	m.imported_at = &t
 }
 
-// ColorIdentity returns the value of the "color_identity" field in the mutation.
-func (m *CardMutation) ColorIdentity() (r uint8, exists bool) {
-	v := m.color_identity
+// ImportedAt returns the value of the "imported_at" field in the mutation.
+func (m *BulkImportMutation) ImportedAt() (r time.Time, exists bool) {
+	v := m.imported_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColorIdentity returns the old "color_identity" field's value of the Card entity.
-// If the Card object wasn't provided to the builder, the object is fetched from the database.
+// OldImportedAt returns the old "imported_at" field's value of the BulkImport entity.
+// If the BulkImport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardMutation) OldColorIdentity(ctx context.Context) (v uint8, err error) {
+func (m *BulkImportMutation) OldImportedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColorIdentity is only allowed on UpdateOne operations")
+		return v, errors.New("OldImportedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColorIdentity requires an ID field in the mutation")
+		return v, errors.New("OldImportedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColorIdentity: %w", err)
-	}
-	return oldValue.ColorIdentity, nil
-}
-
-// AddColorIdentity adds u to the "color_identity" field.
-func (m *CardMutation) AddColorIdentity(u int8) {
-	if m.addcolor_identity != nil {
-		*m.addcolor_identity += u
-	} else {
-		m.addcolor_identity = &u
-	}
-}
-
-// AddedColorIdentity returns the value that was added to the "color_identity" field in this mutation.
-func (m *CardMutation) AddedColorIdentity() (r int8, exists bool) {
-	v := m.addcolor_identity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetColorIdentity resets all changes to the "color_identity" field.
-func (m *CardMutation) ResetColorIdentity() {
-	m.color_identity = nil
-	m.addcolor_identity = nil
-}
-
-// AddFaceIDs adds the "faces" edge to the CardFace entity by ids.
-func (m *CardMutation) AddFaceIDs(ids ...int) {
-	if m.faces == nil {
-		m.faces = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.faces[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFaces clears the "faces" edge to the CardFace entity.
-func (m *CardMutation) ClearFaces() {
-	m.clearedfaces = true
-}
-
-// FacesCleared reports if the "faces" edge to the CardFace entity was cleared.
-func (m *CardMutation) FacesCleared() bool {
-	return m.clearedfaces
-}
-
-// RemoveFaceIDs removes the "faces" edge to the CardFace entity by IDs.
-func (m *CardMutation) RemoveFaceIDs(ids ...int) {
-	if m.removedfaces == nil {
-		m.removedfaces = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.faces, ids[i])
-		m.removedfaces[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFaces returns the removed IDs of the "faces" edge to the CardFace entity.
-func (m *CardMutation) RemovedFacesIDs() (ids []int) {
-	for id := range m.removedfaces {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// FacesIDs returns the "faces" edge IDs in the mutation.
-func (m *CardMutation) FacesIDs() (ids []int) {
-	for id := range m.faces {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetFaces resets all changes to the "faces" edge.
-func (m *CardMutation) ResetFaces() {
-	m.faces = nil
-	m.clearedfaces = false
-	m.removedfaces = nil
-}
-
-// AddRulingIDs adds the "rulings" edge to the Ruling entity by ids.
-func (m *CardMutation) AddRulingIDs(ids ...int) {
-	if m.rulings == nil {
-		m.rulings = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.rulings[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRulings clears the "rulings" edge to the Ruling entity.
-func (m *CardMutation) ClearRulings() {
-	m.clearedrulings = true
-}
-
-// RulingsCleared reports if the "rulings" edge to the Ruling entity was cleared.
-func (m *CardMutation) RulingsCleared() bool {
-	return m.clearedrulings
-}
-
-// RemoveRulingIDs removes the "rulings" edge to the Ruling entity by IDs.
-func (m *CardMutation) RemoveRulingIDs(ids ...int) {
-	if m.removedrulings == nil {
-		m.removedrulings = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.rulings, ids[i])
-		m.removedrulings[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedRulings returns the removed IDs of the "rulings" edge to the Ruling entity.
-func (m *CardMutation) RemovedRulingsIDs() (ids []int) {
-	for id := range m.removedrulings {
-		ids = append(ids, id)
+		return v, fmt.Errorf("querying old value for OldImportedAt: %w", err)
 	}
-	return
-}
-
-// RulingsIDs returns the "rulings" edge IDs in the mutation.
-func (m *CardMutation) RulingsIDs() (ids []int) {
-	for id := range m.rulings {
-		ids = append(ids, id)
-	}
-	return
+	return oldValue.ImportedAt, nil
 }
 
-// ResetRulings resets all changes to the "rulings" edge.
-func (m *CardMutation) ResetRulings() {
-	m.rulings = nil
-	m.clearedrulings = false
-	m.removedrulings = nil
+// ResetImportedAt resets all changes to the "imported_at" field.
+func (m *BulkImportMutation) ResetImportedAt() {
+	m.imported_at = nil
 }
 
-// Where appends a list predicates to the CardMutation builder.
-func (m *CardMutation) Where(ps ...predicate.Card) {
+// Where appends a list predicates to the BulkImportMutation builder.
+func (m *BulkImportMutation) Where(ps ...predicate.BulkImport) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BulkImportMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Card, len(ps))
+func (m *BulkImportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BulkImport, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -830,33 +699,33 @@ func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CardMutation) Op() Op {
+func (m *BulkImportMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CardMutation) SetOp(op Op) {
+func (m *BulkImportMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Card).
-func (m *CardMutation) Type() string {
+// Type returns the node type of this mutation (BulkImport).
+func (m *BulkImportMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CardMutation) Fields() []string {
+func (m *BulkImportMutation) Fields() []string {
 	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, card.FieldName)
+	if m._type != nil {
+		fields = append(fields, bulkimport.FieldType)
 	}
-	if m.oracle_id != nil {
-		fields = append(fields, card.FieldOracleID)
+	if m.updated_at != nil {
+		fields = append(fields, bulkimport.FieldUpdatedAt)
 	}
-	if m.color_identity != nil {
-		fields = append(fields, card.FieldColorIdentity)
+	if m.imported_at != nil {
+		fields = append(fields, bulkimport.FieldImportedAt)
 	}
 	return fields
 }
@@ -864,14 +733,14 @@ func (m *CardMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CardMutation) Field(name string) (ent.Value, bool) {
+func (m *BulkImportMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case card.FieldName:
-		return m.Name()
-	case card.FieldOracleID:
-		return m.OracleID()
-	case card.FieldColorIdentity:
-		return m.ColorIdentity()
+	case bulkimport.FieldType:
+		return m.GetType()
+	case bulkimport.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case bulkimport.FieldImportedAt:
+		return m.ImportedAt()
 	}
 	return nil, false
 }
@@ -879,271 +748,192 @@ func (m *CardMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BulkImportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case card.FieldName:
-		return m.OldName(ctx)
-	case card.FieldOracleID:
-		return m.OldOracleID(ctx)
-	case card.FieldColorIdentity:
-		return m.OldColorIdentity(ctx)
+	case bulkimport.FieldType:
+		return m.OldType(ctx)
+	case bulkimport.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case bulkimport.FieldImportedAt:
+		return m.OldImportedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Card field %s", name)
+	return nil, fmt.Errorf("unknown BulkImport field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardMutation) SetField(name string, value ent.Value) error {
+func (m *BulkImportMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case card.FieldName:
+	case bulkimport.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case card.FieldOracleID:
-		v, ok := value.(string)
+	case bulkimport.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOracleID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case card.FieldColorIdentity:
-		v, ok := value.(uint8)
+	case bulkimport.FieldImportedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColorIdentity(v)
+		m.SetImportedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Card field %s", name)
+	return fmt.Errorf("unknown BulkImport field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CardMutation) AddedFields() []string {
-	var fields []string
-	if m.addcolor_identity != nil {
-		fields = append(fields, card.FieldColorIdentity)
-	}
-	return fields
+func (m *BulkImportMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CardMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case card.FieldColorIdentity:
-		return m.AddedColorIdentity()
-	}
+func (m *BulkImportMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardMutation) AddField(name string, value ent.Value) error {
+func (m *BulkImportMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case card.FieldColorIdentity:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddColorIdentity(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Card numeric field %s", name)
+	return fmt.Errorf("unknown BulkImport numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CardMutation) ClearedFields() []string {
+func (m *BulkImportMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CardMutation) FieldCleared(name string) bool {
+func (m *BulkImportMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CardMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Card nullable field %s", name)
+func (m *BulkImportMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BulkImport nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CardMutation) ResetField(name string) error {
+func (m *BulkImportMutation) ResetField(name string) error {
 	switch name {
-	case card.FieldName:
-		m.ResetName()
+	case bulkimport.FieldType:
+		m.ResetType()
 		return nil
-	case card.FieldOracleID:
-		m.ResetOracleID()
+	case bulkimport.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case card.FieldColorIdentity:
-		m.ResetColorIdentity()
+	case bulkimport.FieldImportedAt:
+		m.ResetImportedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Card field %s", name)
+	return fmt.Errorf("unknown BulkImport field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CardMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.faces != nil {
-		edges = append(edges, card.EdgeFaces)
-	}
-	if m.rulings != nil {
-		edges = append(edges, card.EdgeRulings)
-	}
+func (m *BulkImportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CardMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case card.EdgeFaces:
-		ids := make([]ent.Value, 0, len(m.faces))
-		for id := range m.faces {
-			ids = append(ids, id)
-		}
-		return ids
-	case card.EdgeRulings:
-		ids := make([]ent.Value, 0, len(m.rulings))
-		for id := range m.rulings {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BulkImportMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CardMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedfaces != nil {
-		edges = append(edges, card.EdgeFaces)
-	}
-	if m.removedrulings != nil {
-		edges = append(edges, card.EdgeRulings)
-	}
+func (m *BulkImportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CardMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case card.EdgeFaces:
-		ids := make([]ent.Value, 0, len(m.removedfaces))
-		for id := range m.removedfaces {
-			ids = append(ids, id)
-		}
-		return ids
-	case card.EdgeRulings:
-		ids := make([]ent.Value, 0, len(m.removedrulings))
-		for id := range m.removedrulings {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BulkImportMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CardMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedfaces {
-		edges = append(edges, card.EdgeFaces)
-	}
-	if m.clearedrulings {
-		edges = append(edges, card.EdgeRulings)
-	}
+func (m *BulkImportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CardMutation) EdgeCleared(name string) bool {
-	switch name {
-	case card.EdgeFaces:
-		return m.clearedfaces
-	case card.EdgeRulings:
-		return m.clearedrulings
-	}
+func (m *BulkImportMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CardMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Card unique edge %s", name)
+func (m *BulkImportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BulkImport unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CardMutation) ResetEdge(name string) error {
-	switch name {
-	case card.EdgeFaces:
-		m.ResetFaces()
-		return nil
-	case card.EdgeRulings:
-		m.ResetRulings()
-		return nil
-	}
-	return fmt.Errorf("unknown Card edge %s", name)
+func (m *BulkImportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BulkImport edge %s", name)
 }
 
-// CardFaceMutation represents an operation that mutates the CardFace nodes in the graph.
-type CardFaceMutation struct {
+// CardMutation represents an operation that mutates the Card nodes in the graph.
+type CardMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	flavor_text      *string
-	oracle_text      *string
-	language         *string
-	cmc              *float32
-	addcmc           *float32
-	power            *string
-	toughness        *string
-	loyalty          *string
-	mana_cost        *string
-	type_line        *string
-	colors           *string
-	clearedFields    map[string]struct{}
-	card             *int
-	clearedcard      bool
-	printings        map[int]struct{}
-	removedprintings map[int]struct{}
-	clearedprintings bool
-	done             bool
-	oldValue         func(context.Context) (*CardFace, error)
-	predicates       []predicate.CardFace
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	oracle_id         *string
+	color_identity    *uint8
+	addcolor_identity *int8
+	layout            *string
+	content_hash      *string
+	clearedFields     map[string]struct{}
+	faces             map[int]struct{}
+	removedfaces      map[int]struct{}
+	clearedfaces      bool
+	rulings           map[int]struct{}
+	removedrulings    map[int]struct{}
+	clearedrulings    bool
+	legalities        map[int]struct{}
+	removedlegalities map[int]struct{}
+	clearedlegalities bool
+	done              bool
+	oldValue          func(context.Context) (*Card, error)
+	predicates        []predicate.Card
 }
 
-var _ ent.Mutation = (*CardFaceMutation)(nil)
+var _ ent.Mutation = (*CardMutation)(nil)
 
-// cardfaceOption allows management of the mutation configuration using functional options.
-type cardfaceOption func(*CardFaceMutation)
+// cardOption allows management of the mutation configuration using functional options.
+type cardOption func(*CardMutation)
 
-// newCardFaceMutation creates new mutation for the CardFace entity.
-func newCardFaceMutation(c config, op Op, opts ...cardfaceOption) *CardFaceMutation {
-	m := &CardFaceMutation{
+// newCardMutation creates new mutation for the Card entity.
+func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
+	m := &CardMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCardFace,
+		typ:           TypeCard,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1152,20 +942,20 @@ func newCardFaceMutation(c config, op Op, opts ...cardfaceOption) *CardFaceMutat
 	return m
 }
 
-// withCardFaceID sets the ID field of the mutation.
-func withCardFaceID(id int) cardfaceOption {
-	return func(m *CardFaceMutation) {
+// withCardID sets the ID field of the mutation.
+func withCardID(id int) cardOption {
+	return func(m *CardMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CardFace
+			value *Card
 		)
-		m.oldValue = func(ctx context.Context) (*CardFace, error) {
+		m.oldValue = func(ctx context.Context) (*Card, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CardFace.Get(ctx, id)
+					value, err = m.Client().Card.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1174,10 +964,10 @@ func withCardFaceID(id int) cardfaceOption {
 	}
 }
 
-// withCardFace sets the old CardFace of the mutation.
-func withCardFace(node *CardFace) cardfaceOption {
-	return func(m *CardFaceMutation) {
-		m.oldValue = func(context.Context) (*CardFace, error) {
+// withCard sets the old Card of the mutation.
+func withCard(node *Card) cardOption {
+	return func(m *CardMutation) {
+		m.oldValue = func(context.Context) (*Card, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1186,7 +976,7 @@ func withCardFace(node *CardFace) cardfaceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CardFaceMutation) Client() *Client {
+func (m CardMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1194,7 +984,7 @@ func (m CardFaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CardFaceMutation) Tx() (*Tx, error) {
+func (m CardMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
@@ -1205,7 +995,7 @@ func (m CardFaceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CardFaceMutation) ID() (id int, exists bool) {
+func (m *CardMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1216,7 +1006,7 @@ func (m *CardFaceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CardFaceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1225,19 +1015,19 @@ func (m *CardFaceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CardFace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Card.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *CardFaceMutation) SetName(s string) {
+func (m *CardMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *CardFaceMutation) Name() (r string, exists bool) {
+func (m *CardMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1245,10 +1035,10 @@ func (m *CardFaceMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Card entity.
+// If the Card object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CardMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1263,492 +1053,358 @@ func (m *CardFaceMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *CardFaceMutation) ResetName() {
+func (m *CardMutation) ResetName() {
 	m.name = nil
 }
 
-// SetFlavorText sets the "flavor_text" field.
-func (m *CardFaceMutation) SetFlavorText(s string) {
-	m.flavor_text = &s
+// SetOracleID sets the "oracle_id" field.
+func (m *CardMutation) SetOracleID(s string) {
+	m.oracle_id = &s
 }
 
-// FlavorText returns the value of the "flavor_text" field in the mutation.
-func (m *CardFaceMutation) FlavorText() (r string, exists bool) {
-	v := m.flavor_text
+// OracleID returns the value of the "oracle_id" field in the mutation.
+func (m *CardMutation) OracleID() (r string, exists bool) {
+	v := m.oracle_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlavorText returns the old "flavor_text" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// OldOracleID returns the old "oracle_id" field's value of the Card entity.
+// If the Card object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldFlavorText(ctx context.Context) (v string, err error) {
+func (m *CardMutation) OldOracleID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlavorText is only allowed on UpdateOne operations")
+		return v, errors.New("OldOracleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlavorText requires an ID field in the mutation")
+		return v, errors.New("OldOracleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlavorText: %w", err)
+		return v, fmt.Errorf("querying old value for OldOracleID: %w", err)
 	}
-	return oldValue.FlavorText, nil
+	return oldValue.OracleID, nil
 }
 
-// ResetFlavorText resets all changes to the "flavor_text" field.
-func (m *CardFaceMutation) ResetFlavorText() {
-	m.flavor_text = nil
+// ResetOracleID resets all changes to the "oracle_id" field.
+func (m *CardMutation) ResetOracleID() {
+	m.oracle_id = nil
 }
 
-// SetOracleText sets the "oracle_text" field.
-func (m *CardFaceMutation) SetOracleText(s string) {
-	m.oracle_text = &s
+// SetColorIdentity sets the "color_identity" field.
+func (m *CardMutation) SetColorIdentity(u uint8) {
+	m.color_identity = &u
+	m.addcolor_identity = nil
 }
 
-// OracleText returns the value of the "oracle_text" field in the mutation.
-func (m *CardFaceMutation) OracleText() (r string, exists bool) {
-	v := m.oracle_text
+// ColorIdentity returns the value of the "color_identity" field in the mutation.
+func (m *CardMutation) ColorIdentity() (r uint8, exists bool) {
+	v := m.color_identity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOracleText returns the old "oracle_text" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// OldColorIdentity returns the old "color_identity" field's value of the Card entity.
+// If the Card object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldOracleText(ctx context.Context) (v string, err error) {
+func (m *CardMutation) OldColorIdentity(ctx context.Context) (v uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOracleText is only allowed on UpdateOne operations")
+		return v, errors.New("OldColorIdentity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOracleText requires an ID field in the mutation")
+		return v, errors.New("OldColorIdentity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOracleText: %w", err)
+		return v, fmt.Errorf("querying old value for OldColorIdentity: %w", err)
 	}
-	return oldValue.OracleText, nil
-}
-
-// ResetOracleText resets all changes to the "oracle_text" field.
-func (m *CardFaceMutation) ResetOracleText() {
-	m.oracle_text = nil
+	return oldValue.ColorIdentity, nil
 }
 
-// SetLanguage sets the "language" field.
-func (m *CardFaceMutation) SetLanguage(s string) {
-	m.language = &s
+// AddColorIdentity adds u to the "color_identity" field.
+func (m *CardMutation) AddColorIdentity(u int8) {
+	if m.addcolor_identity != nil {
+		*m.addcolor_identity += u
+	} else {
+		m.addcolor_identity = &u
+	}
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *CardFaceMutation) Language() (r string, exists bool) {
-	v := m.language
+// AddedColorIdentity returns the value that was added to the "color_identity" field in this mutation.
+func (m *CardMutation) AddedColorIdentity() (r int8, exists bool) {
+	v := m.addcolor_identity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldLanguage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
-	}
-	return oldValue.Language, nil
-}
-
-// ResetLanguage resets all changes to the "language" field.
-func (m *CardFaceMutation) ResetLanguage() {
-	m.language = nil
+// ResetColorIdentity resets all changes to the "color_identity" field.
+func (m *CardMutation) ResetColorIdentity() {
+	m.color_identity = nil
+	m.addcolor_identity = nil
 }
 
-// SetCmc sets the "cmc" field.
-func (m *CardFaceMutation) SetCmc(f float32) {
-	m.cmc = &f
-	m.addcmc = nil
+// SetLayout sets the "layout" field.
+func (m *CardMutation) SetLayout(s string) {
+	m.layout = &s
 }
 
-// Cmc returns the value of the "cmc" field in the mutation.
-func (m *CardFaceMutation) Cmc() (r float32, exists bool) {
-	v := m.cmc
+// Layout returns the value of the "layout" field in the mutation.
+func (m *CardMutation) Layout() (r string, exists bool) {
+	v := m.layout
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCmc returns the old "cmc" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// OldLayout returns the old "layout" field's value of the Card entity.
+// If the Card object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldCmc(ctx context.Context) (v float32, err error) {
+func (m *CardMutation) OldLayout(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCmc is only allowed on UpdateOne operations")
+		return v, errors.New("OldLayout is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCmc requires an ID field in the mutation")
+		return v, errors.New("OldLayout requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCmc: %w", err)
-	}
-	return oldValue.Cmc, nil
-}
-
-// AddCmc adds f to the "cmc" field.
-func (m *CardFaceMutation) AddCmc(f float32) {
-	if m.addcmc != nil {
-		*m.addcmc += f
-	} else {
-		m.addcmc = &f
-	}
-}
-
-// AddedCmc returns the value that was added to the "cmc" field in this mutation.
-func (m *CardFaceMutation) AddedCmc() (r float32, exists bool) {
-	v := m.addcmc
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldLayout: %w", err)
 	}
-	return *v, true
+	return oldValue.Layout, nil
 }
 
-// ResetCmc resets all changes to the "cmc" field.
-func (m *CardFaceMutation) ResetCmc() {
-	m.cmc = nil
-	m.addcmc = nil
+// ResetLayout resets all changes to the "layout" field.
+func (m *CardMutation) ResetLayout() {
+	m.layout = nil
 }
 
-// SetPower sets the "power" field.
-func (m *CardFaceMutation) SetPower(s string) {
-	m.power = &s
+// SetContentHash sets the "content_hash" field.
+func (m *CardMutation) SetContentHash(s string) {
+	m.content_hash = &s
 }
 
-// Power returns the value of the "power" field in the mutation.
-func (m *CardFaceMutation) Power() (r string, exists bool) {
-	v := m.power
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *CardMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPower returns the old "power" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// OldContentHash returns the old "content_hash" field's value of the Card entity.
+// If the Card object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldPower(ctx context.Context) (v string, err error) {
+func (m *CardMutation) OldContentHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPower is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPower requires an ID field in the mutation")
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPower: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
 	}
-	return oldValue.Power, nil
+	return oldValue.ContentHash, nil
 }
 
-// ResetPower resets all changes to the "power" field.
-func (m *CardFaceMutation) ResetPower() {
-	m.power = nil
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *CardMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[card.FieldContentHash] = struct{}{}
 }
 
-// SetToughness sets the "toughness" field.
-func (m *CardFaceMutation) SetToughness(s string) {
-	m.toughness = &s
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *CardMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[card.FieldContentHash]
+	return ok
 }
 
-// Toughness returns the value of the "toughness" field in the mutation.
-func (m *CardFaceMutation) Toughness() (r string, exists bool) {
-	v := m.toughness
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *CardMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, card.FieldContentHash)
 }
 
-// OldToughness returns the old "toughness" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldToughness(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToughness is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToughness requires an ID field in the mutation")
+// AddFaceIDs adds the "faces" edge to the CardFace entity by ids.
+func (m *CardMutation) AddFaceIDs(ids ...int) {
+	if m.faces == nil {
+		m.faces = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToughness: %w", err)
+	for i := range ids {
+		m.faces[ids[i]] = struct{}{}
 	}
-	return oldValue.Toughness, nil
-}
-
-// ResetToughness resets all changes to the "toughness" field.
-func (m *CardFaceMutation) ResetToughness() {
-	m.toughness = nil
 }
 
-// SetLoyalty sets the "loyalty" field.
-func (m *CardFaceMutation) SetLoyalty(s string) {
-	m.loyalty = &s
+// ClearFaces clears the "faces" edge to the CardFace entity.
+func (m *CardMutation) ClearFaces() {
+	m.clearedfaces = true
 }
 
-// Loyalty returns the value of the "loyalty" field in the mutation.
-func (m *CardFaceMutation) Loyalty() (r string, exists bool) {
-	v := m.loyalty
-	if v == nil {
-		return
-	}
-	return *v, true
+// FacesCleared reports if the "faces" edge to the CardFace entity was cleared.
+func (m *CardMutation) FacesCleared() bool {
+	return m.clearedfaces
 }
 
-// OldLoyalty returns the old "loyalty" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldLoyalty(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLoyalty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLoyalty requires an ID field in the mutation")
+// RemoveFaceIDs removes the "faces" edge to the CardFace entity by IDs.
+func (m *CardMutation) RemoveFaceIDs(ids ...int) {
+	if m.removedfaces == nil {
+		m.removedfaces = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLoyalty: %w", err)
+	for i := range ids {
+		delete(m.faces, ids[i])
+		m.removedfaces[ids[i]] = struct{}{}
 	}
-	return oldValue.Loyalty, nil
 }
 
-// ResetLoyalty resets all changes to the "loyalty" field.
-func (m *CardFaceMutation) ResetLoyalty() {
-	m.loyalty = nil
+// RemovedFaces returns the removed IDs of the "faces" edge to the CardFace entity.
+func (m *CardMutation) RemovedFacesIDs() (ids []int) {
+	for id := range m.removedfaces {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetManaCost sets the "mana_cost" field.
-func (m *CardFaceMutation) SetManaCost(s string) {
-	m.mana_cost = &s
+// FacesIDs returns the "faces" edge IDs in the mutation.
+func (m *CardMutation) FacesIDs() (ids []int) {
+	for id := range m.faces {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ManaCost returns the value of the "mana_cost" field in the mutation.
-func (m *CardFaceMutation) ManaCost() (r string, exists bool) {
-	v := m.mana_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetFaces resets all changes to the "faces" edge.
+func (m *CardMutation) ResetFaces() {
+	m.faces = nil
+	m.clearedfaces = false
+	m.removedfaces = nil
 }
 
-// OldManaCost returns the old "mana_cost" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldManaCost(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManaCost is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManaCost requires an ID field in the mutation")
+// AddRulingIDs adds the "rulings" edge to the Ruling entity by ids.
+func (m *CardMutation) AddRulingIDs(ids ...int) {
+	if m.rulings == nil {
+		m.rulings = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManaCost: %w", err)
+	for i := range ids {
+		m.rulings[ids[i]] = struct{}{}
 	}
-	return oldValue.ManaCost, nil
 }
 
-// ResetManaCost resets all changes to the "mana_cost" field.
-func (m *CardFaceMutation) ResetManaCost() {
-	m.mana_cost = nil
+// ClearRulings clears the "rulings" edge to the Ruling entity.
+func (m *CardMutation) ClearRulings() {
+	m.clearedrulings = true
 }
 
-// SetTypeLine sets the "type_line" field.
-func (m *CardFaceMutation) SetTypeLine(s string) {
-	m.type_line = &s
+// RulingsCleared reports if the "rulings" edge to the Ruling entity was cleared.
+func (m *CardMutation) RulingsCleared() bool {
+	return m.clearedrulings
 }
 
-// TypeLine returns the value of the "type_line" field in the mutation.
-func (m *CardFaceMutation) TypeLine() (r string, exists bool) {
-	v := m.type_line
-	if v == nil {
-		return
+// RemoveRulingIDs removes the "rulings" edge to the Ruling entity by IDs.
+func (m *CardMutation) RemoveRulingIDs(ids ...int) {
+	if m.removedrulings == nil {
+		m.removedrulings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rulings, ids[i])
+		m.removedrulings[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTypeLine returns the old "type_line" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldTypeLine(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTypeLine is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTypeLine requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTypeLine: %w", err)
-	}
-	return oldValue.TypeLine, nil
-}
-
-// ResetTypeLine resets all changes to the "type_line" field.
-func (m *CardFaceMutation) ResetTypeLine() {
-	m.type_line = nil
-}
-
-// SetColors sets the "colors" field.
-func (m *CardFaceMutation) SetColors(s string) {
-	m.colors = &s
-}
-
-// Colors returns the value of the "colors" field in the mutation.
-func (m *CardFaceMutation) Colors() (r string, exists bool) {
-	v := m.colors
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldColors returns the old "colors" field's value of the CardFace entity.
-// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CardFaceMutation) OldColors(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColors is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColors requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColors: %w", err)
-	}
-	return oldValue.Colors, nil
-}
-
-// ResetColors resets all changes to the "colors" field.
-func (m *CardFaceMutation) ResetColors() {
-	m.colors = nil
-}
-
-// SetCardID sets the "card" edge to the Card entity by id.
-func (m *CardFaceMutation) SetCardID(id int) {
-	m.card = &id
-}
-
-// ClearCard clears the "card" edge to the Card entity.
-func (m *CardFaceMutation) ClearCard() {
-	m.clearedcard = true
-}
-
-// CardCleared reports if the "card" edge to the Card entity was cleared.
-func (m *CardFaceMutation) CardCleared() bool {
-	return m.clearedcard
-}
-
-// CardID returns the "card" edge ID in the mutation.
-func (m *CardFaceMutation) CardID() (id int, exists bool) {
-	if m.card != nil {
-		return *m.card, true
+// RemovedRulings returns the removed IDs of the "rulings" edge to the Ruling entity.
+func (m *CardMutation) RemovedRulingsIDs() (ids []int) {
+	for id := range m.removedrulings {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// CardIDs returns the "card" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CardID instead. It exists only for internal usage by the builders.
-func (m *CardFaceMutation) CardIDs() (ids []int) {
-	if id := m.card; id != nil {
-		ids = append(ids, *id)
+// RulingsIDs returns the "rulings" edge IDs in the mutation.
+func (m *CardMutation) RulingsIDs() (ids []int) {
+	for id := range m.rulings {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCard resets all changes to the "card" edge.
-func (m *CardFaceMutation) ResetCard() {
-	m.card = nil
-	m.clearedcard = false
+// ResetRulings resets all changes to the "rulings" edge.
+func (m *CardMutation) ResetRulings() {
+	m.rulings = nil
+	m.clearedrulings = false
+	m.removedrulings = nil
 }
 
-// AddPrintingIDs adds the "printings" edge to the Printing entity by ids.
-func (m *CardFaceMutation) AddPrintingIDs(ids ...int) {
-	if m.printings == nil {
-		m.printings = make(map[int]struct{})
+// AddLegalityIDs adds the "legalities" edge to the Legality entity by ids.
+func (m *CardMutation) AddLegalityIDs(ids ...int) {
+	if m.legalities == nil {
+		m.legalities = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.printings[ids[i]] = struct{}{}
+		m.legalities[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPrintings clears the "printings" edge to the Printing entity.
-func (m *CardFaceMutation) ClearPrintings() {
-	m.clearedprintings = true
+// ClearLegalities clears the "legalities" edge to the Legality entity.
+func (m *CardMutation) ClearLegalities() {
+	m.clearedlegalities = true
 }
 
-// PrintingsCleared reports if the "printings" edge to the Printing entity was cleared.
-func (m *CardFaceMutation) PrintingsCleared() bool {
-	return m.clearedprintings
+// LegalitiesCleared reports if the "legalities" edge to the Legality entity was cleared.
+func (m *CardMutation) LegalitiesCleared() bool {
+	return m.clearedlegalities
 }
 
-// RemovePrintingIDs removes the "printings" edge to the Printing entity by IDs.
-func (m *CardFaceMutation) RemovePrintingIDs(ids ...int) {
-	if m.removedprintings == nil {
-		m.removedprintings = make(map[int]struct{})
+// RemoveLegalityIDs removes the "legalities" edge to the Legality entity by IDs.
+func (m *CardMutation) RemoveLegalityIDs(ids ...int) {
+	if m.removedlegalities == nil {
+		m.removedlegalities = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.printings, ids[i])
-		m.removedprintings[ids[i]] = struct{}{}
+		delete(m.legalities, ids[i])
+		m.removedlegalities[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPrintings returns the removed IDs of the "printings" edge to the Printing entity.
-func (m *CardFaceMutation) RemovedPrintingsIDs() (ids []int) {
-	for id := range m.removedprintings {
+// RemovedLegalities returns the removed IDs of the "legalities" edge to the Legality entity.
+func (m *CardMutation) RemovedLegalitiesIDs() (ids []int) {
+	for id := range m.removedlegalities {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PrintingsIDs returns the "printings" edge IDs in the mutation.
-func (m *CardFaceMutation) PrintingsIDs() (ids []int) {
-	for id := range m.printings {
+// LegalitiesIDs returns the "legalities" edge IDs in the mutation.
+func (m *CardMutation) LegalitiesIDs() (ids []int) {
+	for id := range m.legalities {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPrintings resets all changes to the "printings" edge.
-func (m *CardFaceMutation) ResetPrintings() {
-	m.printings = nil
-	m.clearedprintings = false
-	m.removedprintings = nil
+// ResetLegalities resets all changes to the "legalities" edge.
+func (m *CardMutation) ResetLegalities() {
+	m.legalities = nil
+	m.clearedlegalities = false
+	m.removedlegalities = nil
 }
 
-// Where appends a list predicates to the CardFaceMutation builder.
-func (m *CardFaceMutation) Where(ps ...predicate.CardFace) {
+// Where appends a list predicates to the CardMutation builder.
+func (m *CardMutation) Where(ps ...predicate.Card) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CardFaceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CardFaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CardFace, len(ps))
+func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Card, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1756,57 +1412,39 @@ func (m *CardFaceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CardFaceMutation) Op() Op {
+func (m *CardMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CardFaceMutation) SetOp(op Op) {
+func (m *CardMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CardFace).
-func (m *CardFaceMutation) Type() string {
+// Type returns the node type of this mutation (Card).
+func (m *CardMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CardFaceMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *CardMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.name != nil {
-		fields = append(fields, cardface.FieldName)
-	}
-	if m.flavor_text != nil {
-		fields = append(fields, cardface.FieldFlavorText)
-	}
-	if m.oracle_text != nil {
-		fields = append(fields, cardface.FieldOracleText)
-	}
-	if m.language != nil {
-		fields = append(fields, cardface.FieldLanguage)
-	}
-	if m.cmc != nil {
-		fields = append(fields, cardface.FieldCmc)
-	}
-	if m.power != nil {
-		fields = append(fields, cardface.FieldPower)
-	}
-	if m.toughness != nil {
-		fields = append(fields, cardface.FieldToughness)
+		fields = append(fields, card.FieldName)
 	}
-	if m.loyalty != nil {
-		fields = append(fields, cardface.FieldLoyalty)
+	if m.oracle_id != nil {
+		fields = append(fields, card.FieldOracleID)
 	}
-	if m.mana_cost != nil {
-		fields = append(fields, cardface.FieldManaCost)
+	if m.color_identity != nil {
+		fields = append(fields, card.FieldColorIdentity)
 	}
-	if m.type_line != nil {
-		fields = append(fields, cardface.FieldTypeLine)
+	if m.layout != nil {
+		fields = append(fields, card.FieldLayout)
 	}
-	if m.colors != nil {
-		fields = append(fields, cardface.FieldColors)
+	if m.content_hash != nil {
+		fields = append(fields, card.FieldContentHash)
 	}
 	return fields
 }
@@ -1814,30 +1452,18 @@ func (m *CardFaceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CardFaceMutation) Field(name string) (ent.Value, bool) {
+func (m *CardMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cardface.FieldName:
+	case card.FieldName:
 		return m.Name()
-	case cardface.FieldFlavorText:
-		return m.FlavorText()
-	case cardface.FieldOracleText:
-		return m.OracleText()
-	case cardface.FieldLanguage:
-		return m.Language()
-	case cardface.FieldCmc:
-		return m.Cmc()
-	case cardface.FieldPower:
-		return m.Power()
-	case cardface.FieldToughness:
-		return m.Toughness()
-	case cardface.FieldLoyalty:
-		return m.Loyalty()
-	case cardface.FieldManaCost:
-		return m.ManaCost()
-	case cardface.FieldTypeLine:
-		return m.TypeLine()
-	case cardface.FieldColors:
-		return m.Colors()
+	case card.FieldOracleID:
+		return m.OracleID()
+	case card.FieldColorIdentity:
+		return m.ColorIdentity()
+	case card.FieldLayout:
+		return m.Layout()
+	case card.FieldContentHash:
+		return m.ContentHash()
 	}
 	return nil, false
 }
@@ -1845,126 +1471,72 @@ func (m *CardFaceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CardFaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cardface.FieldName:
+	case card.FieldName:
 		return m.OldName(ctx)
-	case cardface.FieldFlavorText:
-		return m.OldFlavorText(ctx)
-	case cardface.FieldOracleText:
-		return m.OldOracleText(ctx)
-	case cardface.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case cardface.FieldCmc:
-		return m.OldCmc(ctx)
-	case cardface.FieldPower:
-		return m.OldPower(ctx)
-	case cardface.FieldToughness:
-		return m.OldToughness(ctx)
-	case cardface.FieldLoyalty:
-		return m.OldLoyalty(ctx)
-	case cardface.FieldManaCost:
-		return m.OldManaCost(ctx)
-	case cardface.FieldTypeLine:
-		return m.OldTypeLine(ctx)
-	case cardface.FieldColors:
-		return m.OldColors(ctx)
+	case card.FieldOracleID:
+		return m.OldOracleID(ctx)
+	case card.FieldColorIdentity:
+		return m.OldColorIdentity(ctx)
+	case card.FieldLayout:
+		return m.OldLayout(ctx)
+	case card.FieldContentHash:
+		return m.OldContentHash(ctx)
 	}
-	return nil, fmt.Errorf("unknown CardFace field %s", name)
+	return nil, fmt.Errorf("unknown Card field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardFaceMutation) SetField(name string, value ent.Value) error {
+func (m *CardMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cardface.FieldName:
+	case card.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case cardface.FieldFlavorText:
+	case card.FieldOracleID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlavorText(v)
+		m.SetOracleID(v)
 		return nil
-	case cardface.FieldOracleText:
-		v, ok := value.(string)
+	case card.FieldColorIdentity:
+		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOracleText(v)
+		m.SetColorIdentity(v)
 		return nil
-	case cardface.FieldLanguage:
+	case card.FieldLayout:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetLayout(v)
 		return nil
-	case cardface.FieldCmc:
-		v, ok := value.(float32)
+	case card.FieldContentHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCmc(v)
-		return nil
-	case cardface.FieldPower:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPower(v)
-		return nil
-	case cardface.FieldToughness:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToughness(v)
-		return nil
-	case cardface.FieldLoyalty:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLoyalty(v)
-		return nil
-	case cardface.FieldManaCost:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetManaCost(v)
-		return nil
-	case cardface.FieldTypeLine:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTypeLine(v)
-		return nil
-	case cardface.FieldColors:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetColors(v)
+		m.SetContentHash(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CardFace field %s", name)
+	return fmt.Errorf("unknown Card field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CardFaceMutation) AddedFields() []string {
+func (m *CardMutation) AddedFields() []string {
 	var fields []string
-	if m.addcmc != nil {
-		fields = append(fields, cardface.FieldCmc)
+	if m.addcolor_identity != nil {
+		fields = append(fields, card.FieldColorIdentity)
 	}
 	return fields
 }
@@ -1972,10 +1544,10 @@ func (m *CardFaceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CardFaceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CardMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case cardface.FieldCmc:
-		return m.AddedCmc()
+	case card.FieldColorIdentity:
+		return m.AddedColorIdentity()
 	}
 	return nil, false
 }
@@ -1983,102 +1555,104 @@ func (m *CardFaceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardFaceMutation) AddField(name string, value ent.Value) error {
+func (m *CardMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cardface.FieldCmc:
-		v, ok := value.(float32)
+	case card.FieldColorIdentity:
+		v, ok := value.(int8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCmc(v)
+		m.AddColorIdentity(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CardFace numeric field %s", name)
+	return fmt.Errorf("unknown Card numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CardFaceMutation) ClearedFields() []string {
-	return nil
+func (m *CardMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(card.FieldContentHash) {
+		fields = append(fields, card.FieldContentHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CardFaceMutation) FieldCleared(name string) bool {
+func (m *CardMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CardFaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown CardFace nullable field %s", name)
+func (m *CardMutation) ClearField(name string) error {
+	switch name {
+	case card.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Card nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CardFaceMutation) ResetField(name string) error {
+func (m *CardMutation) ResetField(name string) error {
 	switch name {
-	case cardface.FieldName:
+	case card.FieldName:
 		m.ResetName()
 		return nil
-	case cardface.FieldFlavorText:
-		m.ResetFlavorText()
-		return nil
-	case cardface.FieldOracleText:
-		m.ResetOracleText()
-		return nil
-	case cardface.FieldLanguage:
-		m.ResetLanguage()
-		return nil
-	case cardface.FieldCmc:
-		m.ResetCmc()
-		return nil
-	case cardface.FieldPower:
-		m.ResetPower()
-		return nil
-	case cardface.FieldToughness:
-		m.ResetToughness()
-		return nil
-	case cardface.FieldLoyalty:
-		m.ResetLoyalty()
+	case card.FieldOracleID:
+		m.ResetOracleID()
 		return nil
-	case cardface.FieldManaCost:
-		m.ResetManaCost()
+	case card.FieldColorIdentity:
+		m.ResetColorIdentity()
 		return nil
-	case cardface.FieldTypeLine:
-		m.ResetTypeLine()
+	case card.FieldLayout:
+		m.ResetLayout()
 		return nil
-	case cardface.FieldColors:
-		m.ResetColors()
+	case card.FieldContentHash:
+		m.ResetContentHash()
 		return nil
 	}
-	return fmt.Errorf("unknown CardFace field %s", name)
+	return fmt.Errorf("unknown Card field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CardFaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.card != nil {
-		edges = append(edges, cardface.EdgeCard)
+func (m *CardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.faces != nil {
+		edges = append(edges, card.EdgeFaces)
 	}
-	if m.printings != nil {
-		edges = append(edges, cardface.EdgePrintings)
+	if m.rulings != nil {
+		edges = append(edges, card.EdgeRulings)
+	}
+	if m.legalities != nil {
+		edges = append(edges, card.EdgeLegalities)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CardFaceMutation) AddedIDs(name string) []ent.Value {
+func (m *CardMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case cardface.EdgeCard:
-		if id := m.card; id != nil {
-			return []ent.Value{*id}
+	case card.EdgeFaces:
+		ids := make([]ent.Value, 0, len(m.faces))
+		for id := range m.faces {
+			ids = append(ids, id)
 		}
-	case cardface.EdgePrintings:
-		ids := make([]ent.Value, 0, len(m.printings))
-		for id := range m.printings {
+		return ids
+	case card.EdgeRulings:
+		ids := make([]ent.Value, 0, len(m.rulings))
+		for id := range m.rulings {
+			ids = append(ids, id)
+		}
+		return ids
+	case card.EdgeLegalities:
+		ids := make([]ent.Value, 0, len(m.legalities))
+		for id := range m.legalities {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2087,21 +1661,39 @@ func (m *CardFaceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CardFaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedprintings != nil {
-		edges = append(edges, cardface.EdgePrintings)
+func (m *CardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedfaces != nil {
+		edges = append(edges, card.EdgeFaces)
+	}
+	if m.removedrulings != nil {
+		edges = append(edges, card.EdgeRulings)
+	}
+	if m.removedlegalities != nil {
+		edges = append(edges, card.EdgeLegalities)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CardFaceMutation) RemovedIDs(name string) []ent.Value {
+func (m *CardMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case cardface.EdgePrintings:
-		ids := make([]ent.Value, 0, len(m.removedprintings))
-		for id := range m.removedprintings {
+	case card.EdgeFaces:
+		ids := make([]ent.Value, 0, len(m.removedfaces))
+		for id := range m.removedfaces {
+			ids = append(ids, id)
+		}
+		return ids
+	case card.EdgeRulings:
+		ids := make([]ent.Value, 0, len(m.removedrulings))
+		for id := range m.removedrulings {
+			ids = append(ids, id)
+		}
+		return ids
+	case card.EdgeLegalities:
+		ids := make([]ent.Value, 0, len(m.removedlegalities))
+		for id := range m.removedlegalities {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2110,87 +1702,108 @@ func (m *CardFaceMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CardFaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcard {
-		edges = append(edges, cardface.EdgeCard)
+func (m *CardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedfaces {
+		edges = append(edges, card.EdgeFaces)
 	}
-	if m.clearedprintings {
-		edges = append(edges, cardface.EdgePrintings)
+	if m.clearedrulings {
+		edges = append(edges, card.EdgeRulings)
+	}
+	if m.clearedlegalities {
+		edges = append(edges, card.EdgeLegalities)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CardFaceMutation) EdgeCleared(name string) bool {
+func (m *CardMutation) EdgeCleared(name string) bool {
 	switch name {
-	case cardface.EdgeCard:
-		return m.clearedcard
-	case cardface.EdgePrintings:
-		return m.clearedprintings
+	case card.EdgeFaces:
+		return m.clearedfaces
+	case card.EdgeRulings:
+		return m.clearedrulings
+	case card.EdgeLegalities:
+		return m.clearedlegalities
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CardFaceMutation) ClearEdge(name string) error {
+func (m *CardMutation) ClearEdge(name string) error {
 	switch name {
-	case cardface.EdgeCard:
-		m.ClearCard()
-		return nil
 	}
-	return fmt.Errorf("unknown CardFace unique edge %s", name)
+	return fmt.Errorf("unknown Card unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CardFaceMutation) ResetEdge(name string) error {
+func (m *CardMutation) ResetEdge(name string) error {
 	switch name {
-	case cardface.EdgeCard:
-		m.ResetCard()
+	case card.EdgeFaces:
+		m.ResetFaces()
 		return nil
-	case cardface.EdgePrintings:
-		m.ResetPrintings()
+	case card.EdgeRulings:
+		m.ResetRulings()
+		return nil
+	case card.EdgeLegalities:
+		m.ResetLegalities()
 		return nil
 	}
-	return fmt.Errorf("unknown CardFace edge %s", name)
+	return fmt.Errorf("unknown Card edge %s", name)
 }
 
-// PrintingMutation represents an operation that mutates the Printing nodes in the graph.
-type PrintingMutation struct {
+// CardFaceMutation represents an operation that mutates the CardFace nodes in the graph.
+type CardFaceMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	rarity           *printing.Rarity
-	clearedFields    map[string]struct{}
-	artist           *int
-	clearedartist    bool
-	set              *int
-	clearedset       bool
-	card_face        *int
-	clearedcard_face bool
-	images           map[int]struct{}
-	removedimages    map[int]struct{}
-	clearedimages    bool
-	done             bool
-	oldValue         func(context.Context) (*Printing, error)
-	predicates       []predicate.Printing
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	flavor_text        *string
+	oracle_text        *string
+	language           *string
+	cmc                *float32
+	addcmc             *float32
+	power              *string
+	toughness          *string
+	loyalty            *string
+	power_value        *float32
+	addpower_value     *float32
+	toughness_value    *float32
+	addtoughness_value *float32
+	loyalty_value      *float32
+	addloyalty_value   *float32
+	mana_cost          *string
+	type_line          *string
+	colors             *string
+	color_field        *uint8
+	addcolor_field     *int8
+	content_hash       *string
+	clearedFields      map[string]struct{}
+	card               *int
+	clearedcard        bool
+	printings          map[int]struct{}
+	removedprintings   map[int]struct{}
+	clearedprintings   bool
+	done               bool
+	oldValue           func(context.Context) (*CardFace, error)
+	predicates         []predicate.CardFace
 }
 
-var _ ent.Mutation = (*PrintingMutation)(nil)
+var _ ent.Mutation = (*CardFaceMutation)(nil)
 
-// printingOption allows management of the mutation configuration using functional options.
-type printingOption func(*PrintingMutation)
+// cardfaceOption allows management of the mutation configuration using functional options.
+type cardfaceOption func(*CardFaceMutation)
 
-// newPrintingMutation creates new mutation for the Printing entity.
-func newPrintingMutation(c config, op Op, opts ...printingOption) *PrintingMutation {
-	m := &PrintingMutation{
+// newCardFaceMutation creates new mutation for the CardFace entity.
+func newCardFaceMutation(c config, op Op, opts ...cardfaceOption) *CardFaceMutation {
+	m := &CardFaceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePrinting,
+		typ:           TypeCardFace,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2199,20 +1812,20 @@ func newPrintingMutation(c config, op Op, opts ...printingOption) *PrintingMutat
 	return m
 }
 
-// withPrintingID sets the ID field of the mutation.
-func withPrintingID(id int) printingOption {
-	return func(m *PrintingMutation) {
+// withCardFaceID sets the ID field of the mutation.
+func withCardFaceID(id int) cardfaceOption {
+	return func(m *CardFaceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Printing
+			value *CardFace
 		)
-		m.oldValue = func(ctx context.Context) (*Printing, error) {
+		m.oldValue = func(ctx context.Context) (*CardFace, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Printing.Get(ctx, id)
+					value, err = m.Client().CardFace.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2221,11 +1834,11 @@ func withPrintingID(id int) printingOption {
 	}
 }
 
-// withPrinting sets the old Printing of the mutation.
-func withPrinting(node *Printing) printingOption {
-	return func(m *PrintingMutation) {
-		m.oldValue = func(context.Context) (*Printing, error) {
-			return node, nil
+// withCardFace sets the old CardFace of the mutation.
+func withCardFace(node *CardFace) cardfaceOption {
+	return func(m *CardFaceMutation) {
+		m.oldValue = func(context.Context) (*CardFace, error) {
+			return node, nil
 		}
 		m.id = &node.ID
 	}
@@ -2233,7 +1846,7 @@ func withPrinting(node *Printing) printingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PrintingMutation) Client() *Client {
+func (m CardFaceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2241,7 +1854,7 @@ func (m PrintingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PrintingMutation) Tx() (*Tx, error) {
+func (m CardFaceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
@@ -2252,7 +1865,7 @@ func (m PrintingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PrintingMutation) ID() (id int, exists bool) {
+func (m *CardFaceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2263,7 +1876,7 @@ func (m *PrintingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PrintingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CardFaceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2272,228 +1885,3869 @@ func (m *PrintingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Printing.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CardFace.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRarity sets the "rarity" field.
-func (m *PrintingMutation) SetRarity(pr printing.Rarity) {
-	m.rarity = &pr
+// SetName sets the "name" field.
+func (m *CardFaceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Rarity returns the value of the "rarity" field in the mutation.
-func (m *PrintingMutation) Rarity() (r printing.Rarity, exists bool) {
-	v := m.rarity
+// Name returns the value of the "name" field in the mutation.
+func (m *CardFaceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRarity returns the old "rarity" field's value of the Printing entity.
-// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrintingMutation) OldRarity(ctx context.Context) (v printing.Rarity, err error) {
+func (m *CardFaceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRarity is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRarity requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRarity: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Rarity, nil
+	return oldValue.Name, nil
 }
 
-// ResetRarity resets all changes to the "rarity" field.
-func (m *PrintingMutation) ResetRarity() {
-	m.rarity = nil
+// ResetName resets all changes to the "name" field.
+func (m *CardFaceMutation) ResetName() {
+	m.name = nil
 }
 
-// SetArtistID sets the "artist" edge to the Artist entity by id.
-func (m *PrintingMutation) SetArtistID(id int) {
-	m.artist = &id
+// SetFlavorText sets the "flavor_text" field.
+func (m *CardFaceMutation) SetFlavorText(s string) {
+	m.flavor_text = &s
 }
 
-// ClearArtist clears the "artist" edge to the Artist entity.
-func (m *PrintingMutation) ClearArtist() {
-	m.clearedartist = true
+// FlavorText returns the value of the "flavor_text" field in the mutation.
+func (m *CardFaceMutation) FlavorText() (r string, exists bool) {
+	v := m.flavor_text
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ArtistCleared reports if the "artist" edge to the Artist entity was cleared.
-func (m *PrintingMutation) ArtistCleared() bool {
-	return m.clearedartist
+// OldFlavorText returns the old "flavor_text" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldFlavorText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlavorText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlavorText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlavorText: %w", err)
+	}
+	return oldValue.FlavorText, nil
 }
 
-// ArtistID returns the "artist" edge ID in the mutation.
-func (m *PrintingMutation) ArtistID() (id int, exists bool) {
-	if m.artist != nil {
-		return *m.artist, true
-	}
-	return
+// ResetFlavorText resets all changes to the "flavor_text" field.
+func (m *CardFaceMutation) ResetFlavorText() {
+	m.flavor_text = nil
 }
 
-// ArtistIDs returns the "artist" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ArtistID instead. It exists only for internal usage by the builders.
-func (m *PrintingMutation) ArtistIDs() (ids []int) {
-	if id := m.artist; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetOracleText sets the "oracle_text" field.
+func (m *CardFaceMutation) SetOracleText(s string) {
+	m.oracle_text = &s
 }
 
-// ResetArtist resets all changes to the "artist" edge.
-func (m *PrintingMutation) ResetArtist() {
-	m.artist = nil
-	m.clearedartist = false
+// OracleText returns the value of the "oracle_text" field in the mutation.
+func (m *CardFaceMutation) OracleText() (r string, exists bool) {
+	v := m.oracle_text
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetSetID sets the "set" edge to the Set entity by id.
-func (m *PrintingMutation) SetSetID(id int) {
-	m.set = &id
+// OldOracleText returns the old "oracle_text" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldOracleText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOracleText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOracleText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOracleText: %w", err)
+	}
+	return oldValue.OracleText, nil
 }
 
-// ClearSet clears the "set" edge to the Set entity.
-func (m *PrintingMutation) ClearSet() {
-	m.clearedset = true
+// ResetOracleText resets all changes to the "oracle_text" field.
+func (m *CardFaceMutation) ResetOracleText() {
+	m.oracle_text = nil
 }
 
-// SetCleared reports if the "set" edge to the Set entity was cleared.
-func (m *PrintingMutation) SetCleared() bool {
-	return m.clearedset
+// SetLanguage sets the "language" field.
+func (m *CardFaceMutation) SetLanguage(s string) {
+	m.language = &s
 }
 
-// SetID returns the "set" edge ID in the mutation.
-func (m *PrintingMutation) SetID() (id int, exists bool) {
-	if m.set != nil {
-		return *m.set, true
+// Language returns the value of the "language" field in the mutation.
+func (m *CardFaceMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SetIDs returns the "set" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SetID instead. It exists only for internal usage by the builders.
-func (m *PrintingMutation) SetIDs() (ids []int) {
-	if id := m.set; id != nil {
-		ids = append(ids, *id)
+// OldLanguage returns the old "language" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
 }
 
-// ResetSet resets all changes to the "set" edge.
-func (m *PrintingMutation) ResetSet() {
-	m.set = nil
-	m.clearedset = false
+// ResetLanguage resets all changes to the "language" field.
+func (m *CardFaceMutation) ResetLanguage() {
+	m.language = nil
 }
 
-// SetCardFaceID sets the "card_face" edge to the CardFace entity by id.
-func (m *PrintingMutation) SetCardFaceID(id int) {
-	m.card_face = &id
+// SetCmc sets the "cmc" field.
+func (m *CardFaceMutation) SetCmc(f float32) {
+	m.cmc = &f
+	m.addcmc = nil
 }
 
-// ClearCardFace clears the "card_face" edge to the CardFace entity.
-func (m *PrintingMutation) ClearCardFace() {
-	m.clearedcard_face = true
+// Cmc returns the value of the "cmc" field in the mutation.
+func (m *CardFaceMutation) Cmc() (r float32, exists bool) {
+	v := m.cmc
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CardFaceCleared reports if the "card_face" edge to the CardFace entity was cleared.
-func (m *PrintingMutation) CardFaceCleared() bool {
-	return m.clearedcard_face
+// OldCmc returns the old "cmc" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldCmc(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCmc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCmc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCmc: %w", err)
+	}
+	return oldValue.Cmc, nil
 }
 
-// CardFaceID returns the "card_face" edge ID in the mutation.
-func (m *PrintingMutation) CardFaceID() (id int, exists bool) {
-	if m.card_face != nil {
-		return *m.card_face, true
+// AddCmc adds f to the "cmc" field.
+func (m *CardFaceMutation) AddCmc(f float32) {
+	if m.addcmc != nil {
+		*m.addcmc += f
+	} else {
+		m.addcmc = &f
 	}
-	return
 }
 
-// CardFaceIDs returns the "card_face" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CardFaceID instead. It exists only for internal usage by the builders.
-func (m *PrintingMutation) CardFaceIDs() (ids []int) {
-	if id := m.card_face; id != nil {
-		ids = append(ids, *id)
+// AddedCmc returns the value that was added to the "cmc" field in this mutation.
+func (m *CardFaceMutation) AddedCmc() (r float32, exists bool) {
+	v := m.addcmc
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetCardFace resets all changes to the "card_face" edge.
-func (m *PrintingMutation) ResetCardFace() {
-	m.card_face = nil
-	m.clearedcard_face = false
+// ResetCmc resets all changes to the "cmc" field.
+func (m *CardFaceMutation) ResetCmc() {
+	m.cmc = nil
+	m.addcmc = nil
 }
 
-// AddImageIDs adds the "images" edge to the PrintingImage entity by ids.
-func (m *PrintingMutation) AddImageIDs(ids ...int) {
-	if m.images == nil {
-		m.images = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.images[ids[i]] = struct{}{}
-	}
+// SetPower sets the "power" field.
+func (m *CardFaceMutation) SetPower(s string) {
+	m.power = &s
+}
+
+// Power returns the value of the "power" field in the mutation.
+func (m *CardFaceMutation) Power() (r string, exists bool) {
+	v := m.power
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPower returns the old "power" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldPower(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPower is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPower requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPower: %w", err)
+	}
+	return oldValue.Power, nil
+}
+
+// ResetPower resets all changes to the "power" field.
+func (m *CardFaceMutation) ResetPower() {
+	m.power = nil
+}
+
+// SetToughness sets the "toughness" field.
+func (m *CardFaceMutation) SetToughness(s string) {
+	m.toughness = &s
+}
+
+// Toughness returns the value of the "toughness" field in the mutation.
+func (m *CardFaceMutation) Toughness() (r string, exists bool) {
+	v := m.toughness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToughness returns the old "toughness" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldToughness(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToughness is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToughness requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToughness: %w", err)
+	}
+	return oldValue.Toughness, nil
+}
+
+// ResetToughness resets all changes to the "toughness" field.
+func (m *CardFaceMutation) ResetToughness() {
+	m.toughness = nil
+}
+
+// SetLoyalty sets the "loyalty" field.
+func (m *CardFaceMutation) SetLoyalty(s string) {
+	m.loyalty = &s
+}
+
+// Loyalty returns the value of the "loyalty" field in the mutation.
+func (m *CardFaceMutation) Loyalty() (r string, exists bool) {
+	v := m.loyalty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoyalty returns the old "loyalty" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldLoyalty(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoyalty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoyalty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoyalty: %w", err)
+	}
+	return oldValue.Loyalty, nil
+}
+
+// ResetLoyalty resets all changes to the "loyalty" field.
+func (m *CardFaceMutation) ResetLoyalty() {
+	m.loyalty = nil
+}
+
+// SetPowerValue sets the "power_value" field.
+func (m *CardFaceMutation) SetPowerValue(f float32) {
+	m.power_value = &f
+	m.addpower_value = nil
+}
+
+// PowerValue returns the value of the "power_value" field in the mutation.
+func (m *CardFaceMutation) PowerValue() (r float32, exists bool) {
+	v := m.power_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPowerValue returns the old "power_value" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldPowerValue(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPowerValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPowerValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPowerValue: %w", err)
+	}
+	return oldValue.PowerValue, nil
+}
+
+// AddPowerValue adds f to the "power_value" field.
+func (m *CardFaceMutation) AddPowerValue(f float32) {
+	if m.addpower_value != nil {
+		*m.addpower_value += f
+	} else {
+		m.addpower_value = &f
+	}
+}
+
+// AddedPowerValue returns the value that was added to the "power_value" field in this mutation.
+func (m *CardFaceMutation) AddedPowerValue() (r float32, exists bool) {
+	v := m.addpower_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPowerValue clears the value of the "power_value" field.
+func (m *CardFaceMutation) ClearPowerValue() {
+	m.power_value = nil
+	m.addpower_value = nil
+	m.clearedFields[cardface.FieldPowerValue] = struct{}{}
+}
+
+// PowerValueCleared returns if the "power_value" field was cleared in this mutation.
+func (m *CardFaceMutation) PowerValueCleared() bool {
+	_, ok := m.clearedFields[cardface.FieldPowerValue]
+	return ok
+}
+
+// ResetPowerValue resets all changes to the "power_value" field.
+func (m *CardFaceMutation) ResetPowerValue() {
+	m.power_value = nil
+	m.addpower_value = nil
+	delete(m.clearedFields, cardface.FieldPowerValue)
+}
+
+// SetToughnessValue sets the "toughness_value" field.
+func (m *CardFaceMutation) SetToughnessValue(f float32) {
+	m.toughness_value = &f
+	m.addtoughness_value = nil
+}
+
+// ToughnessValue returns the value of the "toughness_value" field in the mutation.
+func (m *CardFaceMutation) ToughnessValue() (r float32, exists bool) {
+	v := m.toughness_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToughnessValue returns the old "toughness_value" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldToughnessValue(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToughnessValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToughnessValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToughnessValue: %w", err)
+	}
+	return oldValue.ToughnessValue, nil
+}
+
+// AddToughnessValue adds f to the "toughness_value" field.
+func (m *CardFaceMutation) AddToughnessValue(f float32) {
+	if m.addtoughness_value != nil {
+		*m.addtoughness_value += f
+	} else {
+		m.addtoughness_value = &f
+	}
+}
+
+// AddedToughnessValue returns the value that was added to the "toughness_value" field in this mutation.
+func (m *CardFaceMutation) AddedToughnessValue() (r float32, exists bool) {
+	v := m.addtoughness_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearToughnessValue clears the value of the "toughness_value" field.
+func (m *CardFaceMutation) ClearToughnessValue() {
+	m.toughness_value = nil
+	m.addtoughness_value = nil
+	m.clearedFields[cardface.FieldToughnessValue] = struct{}{}
+}
+
+// ToughnessValueCleared returns if the "toughness_value" field was cleared in this mutation.
+func (m *CardFaceMutation) ToughnessValueCleared() bool {
+	_, ok := m.clearedFields[cardface.FieldToughnessValue]
+	return ok
+}
+
+// ResetToughnessValue resets all changes to the "toughness_value" field.
+func (m *CardFaceMutation) ResetToughnessValue() {
+	m.toughness_value = nil
+	m.addtoughness_value = nil
+	delete(m.clearedFields, cardface.FieldToughnessValue)
+}
+
+// SetLoyaltyValue sets the "loyalty_value" field.
+func (m *CardFaceMutation) SetLoyaltyValue(f float32) {
+	m.loyalty_value = &f
+	m.addloyalty_value = nil
+}
+
+// LoyaltyValue returns the value of the "loyalty_value" field in the mutation.
+func (m *CardFaceMutation) LoyaltyValue() (r float32, exists bool) {
+	v := m.loyalty_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLoyaltyValue returns the old "loyalty_value" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldLoyaltyValue(ctx context.Context) (v *float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLoyaltyValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLoyaltyValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLoyaltyValue: %w", err)
+	}
+	return oldValue.LoyaltyValue, nil
+}
+
+// AddLoyaltyValue adds f to the "loyalty_value" field.
+func (m *CardFaceMutation) AddLoyaltyValue(f float32) {
+	if m.addloyalty_value != nil {
+		*m.addloyalty_value += f
+	} else {
+		m.addloyalty_value = &f
+	}
+}
+
+// AddedLoyaltyValue returns the value that was added to the "loyalty_value" field in this mutation.
+func (m *CardFaceMutation) AddedLoyaltyValue() (r float32, exists bool) {
+	v := m.addloyalty_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLoyaltyValue clears the value of the "loyalty_value" field.
+func (m *CardFaceMutation) ClearLoyaltyValue() {
+	m.loyalty_value = nil
+	m.addloyalty_value = nil
+	m.clearedFields[cardface.FieldLoyaltyValue] = struct{}{}
+}
+
+// LoyaltyValueCleared returns if the "loyalty_value" field was cleared in this mutation.
+func (m *CardFaceMutation) LoyaltyValueCleared() bool {
+	_, ok := m.clearedFields[cardface.FieldLoyaltyValue]
+	return ok
+}
+
+// ResetLoyaltyValue resets all changes to the "loyalty_value" field.
+func (m *CardFaceMutation) ResetLoyaltyValue() {
+	m.loyalty_value = nil
+	m.addloyalty_value = nil
+	delete(m.clearedFields, cardface.FieldLoyaltyValue)
+}
+
+// SetManaCost sets the "mana_cost" field.
+func (m *CardFaceMutation) SetManaCost(s string) {
+	m.mana_cost = &s
+}
+
+// ManaCost returns the value of the "mana_cost" field in the mutation.
+func (m *CardFaceMutation) ManaCost() (r string, exists bool) {
+	v := m.mana_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManaCost returns the old "mana_cost" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldManaCost(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManaCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManaCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManaCost: %w", err)
+	}
+	return oldValue.ManaCost, nil
+}
+
+// ResetManaCost resets all changes to the "mana_cost" field.
+func (m *CardFaceMutation) ResetManaCost() {
+	m.mana_cost = nil
+}
+
+// SetTypeLine sets the "type_line" field.
+func (m *CardFaceMutation) SetTypeLine(s string) {
+	m.type_line = &s
+}
+
+// TypeLine returns the value of the "type_line" field in the mutation.
+func (m *CardFaceMutation) TypeLine() (r string, exists bool) {
+	v := m.type_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeLine returns the old "type_line" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldTypeLine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeLine: %w", err)
+	}
+	return oldValue.TypeLine, nil
+}
+
+// ResetTypeLine resets all changes to the "type_line" field.
+func (m *CardFaceMutation) ResetTypeLine() {
+	m.type_line = nil
+}
+
+// SetColors sets the "colors" field.
+func (m *CardFaceMutation) SetColors(s string) {
+	m.colors = &s
+}
+
+// Colors returns the value of the "colors" field in the mutation.
+func (m *CardFaceMutation) Colors() (r string, exists bool) {
+	v := m.colors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColors returns the old "colors" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldColors(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColors: %w", err)
+	}
+	return oldValue.Colors, nil
+}
+
+// ResetColors resets all changes to the "colors" field.
+func (m *CardFaceMutation) ResetColors() {
+	m.colors = nil
+}
+
+// SetColorField sets the "color_field" field.
+func (m *CardFaceMutation) SetColorField(u uint8) {
+	m.color_field = &u
+	m.addcolor_field = nil
+}
+
+// ColorField returns the value of the "color_field" field in the mutation.
+func (m *CardFaceMutation) ColorField() (r uint8, exists bool) {
+	v := m.color_field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColorField returns the old "color_field" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldColorField(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColorField is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColorField requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColorField: %w", err)
+	}
+	return oldValue.ColorField, nil
+}
+
+// AddColorField adds u to the "color_field" field.
+func (m *CardFaceMutation) AddColorField(u int8) {
+	if m.addcolor_field != nil {
+		*m.addcolor_field += u
+	} else {
+		m.addcolor_field = &u
+	}
+}
+
+// AddedColorField returns the value that was added to the "color_field" field in this mutation.
+func (m *CardFaceMutation) AddedColorField() (r int8, exists bool) {
+	v := m.addcolor_field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetColorField resets all changes to the "color_field" field.
+func (m *CardFaceMutation) ResetColorField() {
+	m.color_field = nil
+	m.addcolor_field = nil
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *CardFaceMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *CardFaceMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the CardFace entity.
+// If the CardFace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CardFaceMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *CardFaceMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[cardface.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *CardFaceMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[cardface.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *CardFaceMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, cardface.FieldContentHash)
+}
+
+// SetCardID sets the "card" edge to the Card entity by id.
+func (m *CardFaceMutation) SetCardID(id int) {
+	m.card = &id
+}
+
+// ClearCard clears the "card" edge to the Card entity.
+func (m *CardFaceMutation) ClearCard() {
+	m.clearedcard = true
+}
+
+// CardCleared reports if the "card" edge to the Card entity was cleared.
+func (m *CardFaceMutation) CardCleared() bool {
+	return m.clearedcard
+}
+
+// CardID returns the "card" edge ID in the mutation.
+func (m *CardFaceMutation) CardID() (id int, exists bool) {
+	if m.card != nil {
+		return *m.card, true
+	}
+	return
+}
+
+// CardIDs returns the "card" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CardID instead. It exists only for internal usage by the builders.
+func (m *CardFaceMutation) CardIDs() (ids []int) {
+	if id := m.card; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCard resets all changes to the "card" edge.
+func (m *CardFaceMutation) ResetCard() {
+	m.card = nil
+	m.clearedcard = false
+}
+
+// AddPrintingIDs adds the "printings" edge to the Printing entity by ids.
+func (m *CardFaceMutation) AddPrintingIDs(ids ...int) {
+	if m.printings == nil {
+		m.printings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.printings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPrintings clears the "printings" edge to the Printing entity.
+func (m *CardFaceMutation) ClearPrintings() {
+	m.clearedprintings = true
+}
+
+// PrintingsCleared reports if the "printings" edge to the Printing entity was cleared.
+func (m *CardFaceMutation) PrintingsCleared() bool {
+	return m.clearedprintings
+}
+
+// RemovePrintingIDs removes the "printings" edge to the Printing entity by IDs.
+func (m *CardFaceMutation) RemovePrintingIDs(ids ...int) {
+	if m.removedprintings == nil {
+		m.removedprintings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.printings, ids[i])
+		m.removedprintings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPrintings returns the removed IDs of the "printings" edge to the Printing entity.
+func (m *CardFaceMutation) RemovedPrintingsIDs() (ids []int) {
+	for id := range m.removedprintings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PrintingsIDs returns the "printings" edge IDs in the mutation.
+func (m *CardFaceMutation) PrintingsIDs() (ids []int) {
+	for id := range m.printings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPrintings resets all changes to the "printings" edge.
+func (m *CardFaceMutation) ResetPrintings() {
+	m.printings = nil
+	m.clearedprintings = false
+	m.removedprintings = nil
+}
+
+// Where appends a list predicates to the CardFaceMutation builder.
+func (m *CardFaceMutation) Where(ps ...predicate.CardFace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CardFaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CardFaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CardFace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CardFaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CardFaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CardFace).
+func (m *CardFaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CardFaceMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.name != nil {
+		fields = append(fields, cardface.FieldName)
+	}
+	if m.flavor_text != nil {
+		fields = append(fields, cardface.FieldFlavorText)
+	}
+	if m.oracle_text != nil {
+		fields = append(fields, cardface.FieldOracleText)
+	}
+	if m.language != nil {
+		fields = append(fields, cardface.FieldLanguage)
+	}
+	if m.cmc != nil {
+		fields = append(fields, cardface.FieldCmc)
+	}
+	if m.power != nil {
+		fields = append(fields, cardface.FieldPower)
+	}
+	if m.toughness != nil {
+		fields = append(fields, cardface.FieldToughness)
+	}
+	if m.loyalty != nil {
+		fields = append(fields, cardface.FieldLoyalty)
+	}
+	if m.power_value != nil {
+		fields = append(fields, cardface.FieldPowerValue)
+	}
+	if m.toughness_value != nil {
+		fields = append(fields, cardface.FieldToughnessValue)
+	}
+	if m.loyalty_value != nil {
+		fields = append(fields, cardface.FieldLoyaltyValue)
+	}
+	if m.mana_cost != nil {
+		fields = append(fields, cardface.FieldManaCost)
+	}
+	if m.type_line != nil {
+		fields = append(fields, cardface.FieldTypeLine)
+	}
+	if m.colors != nil {
+		fields = append(fields, cardface.FieldColors)
+	}
+	if m.color_field != nil {
+		fields = append(fields, cardface.FieldColorField)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, cardface.FieldContentHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CardFaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cardface.FieldName:
+		return m.Name()
+	case cardface.FieldFlavorText:
+		return m.FlavorText()
+	case cardface.FieldOracleText:
+		return m.OracleText()
+	case cardface.FieldLanguage:
+		return m.Language()
+	case cardface.FieldCmc:
+		return m.Cmc()
+	case cardface.FieldPower:
+		return m.Power()
+	case cardface.FieldToughness:
+		return m.Toughness()
+	case cardface.FieldLoyalty:
+		return m.Loyalty()
+	case cardface.FieldPowerValue:
+		return m.PowerValue()
+	case cardface.FieldToughnessValue:
+		return m.ToughnessValue()
+	case cardface.FieldLoyaltyValue:
+		return m.LoyaltyValue()
+	case cardface.FieldManaCost:
+		return m.ManaCost()
+	case cardface.FieldTypeLine:
+		return m.TypeLine()
+	case cardface.FieldColors:
+		return m.Colors()
+	case cardface.FieldColorField:
+		return m.ColorField()
+	case cardface.FieldContentHash:
+		return m.ContentHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CardFaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cardface.FieldName:
+		return m.OldName(ctx)
+	case cardface.FieldFlavorText:
+		return m.OldFlavorText(ctx)
+	case cardface.FieldOracleText:
+		return m.OldOracleText(ctx)
+	case cardface.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case cardface.FieldCmc:
+		return m.OldCmc(ctx)
+	case cardface.FieldPower:
+		return m.OldPower(ctx)
+	case cardface.FieldToughness:
+		return m.OldToughness(ctx)
+	case cardface.FieldLoyalty:
+		return m.OldLoyalty(ctx)
+	case cardface.FieldPowerValue:
+		return m.OldPowerValue(ctx)
+	case cardface.FieldToughnessValue:
+		return m.OldToughnessValue(ctx)
+	case cardface.FieldLoyaltyValue:
+		return m.OldLoyaltyValue(ctx)
+	case cardface.FieldManaCost:
+		return m.OldManaCost(ctx)
+	case cardface.FieldTypeLine:
+		return m.OldTypeLine(ctx)
+	case cardface.FieldColors:
+		return m.OldColors(ctx)
+	case cardface.FieldColorField:
+		return m.OldColorField(ctx)
+	case cardface.FieldContentHash:
+		return m.OldContentHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown CardFace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CardFaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cardface.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case cardface.FieldFlavorText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlavorText(v)
+		return nil
+	case cardface.FieldOracleText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOracleText(v)
+		return nil
+	case cardface.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case cardface.FieldCmc:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCmc(v)
+		return nil
+	case cardface.FieldPower:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPower(v)
+		return nil
+	case cardface.FieldToughness:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToughness(v)
+		return nil
+	case cardface.FieldLoyalty:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoyalty(v)
+		return nil
+	case cardface.FieldPowerValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPowerValue(v)
+		return nil
+	case cardface.FieldToughnessValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToughnessValue(v)
+		return nil
+	case cardface.FieldLoyaltyValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoyaltyValue(v)
+		return nil
+	case cardface.FieldManaCost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManaCost(v)
+		return nil
+	case cardface.FieldTypeLine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeLine(v)
+		return nil
+	case cardface.FieldColors:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColors(v)
+		return nil
+	case cardface.FieldColorField:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColorField(v)
+		return nil
+	case cardface.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CardFaceMutation) AddedFields() []string {
+	var fields []string
+	if m.addcmc != nil {
+		fields = append(fields, cardface.FieldCmc)
+	}
+	if m.addpower_value != nil {
+		fields = append(fields, cardface.FieldPowerValue)
+	}
+	if m.addtoughness_value != nil {
+		fields = append(fields, cardface.FieldToughnessValue)
+	}
+	if m.addloyalty_value != nil {
+		fields = append(fields, cardface.FieldLoyaltyValue)
+	}
+	if m.addcolor_field != nil {
+		fields = append(fields, cardface.FieldColorField)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CardFaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cardface.FieldCmc:
+		return m.AddedCmc()
+	case cardface.FieldPowerValue:
+		return m.AddedPowerValue()
+	case cardface.FieldToughnessValue:
+		return m.AddedToughnessValue()
+	case cardface.FieldLoyaltyValue:
+		return m.AddedLoyaltyValue()
+	case cardface.FieldColorField:
+		return m.AddedColorField()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CardFaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cardface.FieldCmc:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCmc(v)
+		return nil
+	case cardface.FieldPowerValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPowerValue(v)
+		return nil
+	case cardface.FieldToughnessValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddToughnessValue(v)
+		return nil
+	case cardface.FieldLoyaltyValue:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLoyaltyValue(v)
+		return nil
+	case cardface.FieldColorField:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddColorField(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CardFaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cardface.FieldPowerValue) {
+		fields = append(fields, cardface.FieldPowerValue)
+	}
+	if m.FieldCleared(cardface.FieldToughnessValue) {
+		fields = append(fields, cardface.FieldToughnessValue)
+	}
+	if m.FieldCleared(cardface.FieldLoyaltyValue) {
+		fields = append(fields, cardface.FieldLoyaltyValue)
+	}
+	if m.FieldCleared(cardface.FieldContentHash) {
+		fields = append(fields, cardface.FieldContentHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CardFaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CardFaceMutation) ClearField(name string) error {
+	switch name {
+	case cardface.FieldPowerValue:
+		m.ClearPowerValue()
+		return nil
+	case cardface.FieldToughnessValue:
+		m.ClearToughnessValue()
+		return nil
+	case cardface.FieldLoyaltyValue:
+		m.ClearLoyaltyValue()
+		return nil
+	case cardface.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CardFaceMutation) ResetField(name string) error {
+	switch name {
+	case cardface.FieldName:
+		m.ResetName()
+		return nil
+	case cardface.FieldFlavorText:
+		m.ResetFlavorText()
+		return nil
+	case cardface.FieldOracleText:
+		m.ResetOracleText()
+		return nil
+	case cardface.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case cardface.FieldCmc:
+		m.ResetCmc()
+		return nil
+	case cardface.FieldPower:
+		m.ResetPower()
+		return nil
+	case cardface.FieldToughness:
+		m.ResetToughness()
+		return nil
+	case cardface.FieldLoyalty:
+		m.ResetLoyalty()
+		return nil
+	case cardface.FieldPowerValue:
+		m.ResetPowerValue()
+		return nil
+	case cardface.FieldToughnessValue:
+		m.ResetToughnessValue()
+		return nil
+	case cardface.FieldLoyaltyValue:
+		m.ResetLoyaltyValue()
+		return nil
+	case cardface.FieldManaCost:
+		m.ResetManaCost()
+		return nil
+	case cardface.FieldTypeLine:
+		m.ResetTypeLine()
+		return nil
+	case cardface.FieldColors:
+		m.ResetColors()
+		return nil
+	case cardface.FieldColorField:
+		m.ResetColorField()
+		return nil
+	case cardface.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CardFaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.card != nil {
+		edges = append(edges, cardface.EdgeCard)
+	}
+	if m.printings != nil {
+		edges = append(edges, cardface.EdgePrintings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CardFaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cardface.EdgeCard:
+		if id := m.card; id != nil {
+			return []ent.Value{*id}
+		}
+	case cardface.EdgePrintings:
+		ids := make([]ent.Value, 0, len(m.printings))
+		for id := range m.printings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CardFaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprintings != nil {
+		edges = append(edges, cardface.EdgePrintings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CardFaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case cardface.EdgePrintings:
+		ids := make([]ent.Value, 0, len(m.removedprintings))
+		for id := range m.removedprintings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CardFaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcard {
+		edges = append(edges, cardface.EdgeCard)
+	}
+	if m.clearedprintings {
+		edges = append(edges, cardface.EdgePrintings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CardFaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cardface.EdgeCard:
+		return m.clearedcard
+	case cardface.EdgePrintings:
+		return m.clearedprintings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CardFaceMutation) ClearEdge(name string) error {
+	switch name {
+	case cardface.EdgeCard:
+		m.ClearCard()
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CardFaceMutation) ResetEdge(name string) error {
+	switch name {
+	case cardface.EdgeCard:
+		m.ResetCard()
+		return nil
+	case cardface.EdgePrintings:
+		m.ResetPrintings()
+		return nil
+	}
+	return fmt.Errorf("unknown CardFace edge %s", name)
+}
+
+// LegalityMutation represents an operation that mutates the Legality nodes in the graph.
+type LegalityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	format        *string
+	legality      *legality.Legality
+	clearedFields map[string]struct{}
+	card          *int
+	clearedcard   bool
+	done          bool
+	oldValue      func(context.Context) (*Legality, error)
+	predicates    []predicate.Legality
+}
+
+var _ ent.Mutation = (*LegalityMutation)(nil)
+
+// legalityOption allows management of the mutation configuration using functional options.
+type legalityOption func(*LegalityMutation)
+
+// newLegalityMutation creates new mutation for the Legality entity.
+func newLegalityMutation(c config, op Op, opts ...legalityOption) *LegalityMutation {
+	m := &LegalityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLegality,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLegalityID sets the ID field of the mutation.
+func withLegalityID(id int) legalityOption {
+	return func(m *LegalityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Legality
+		)
+		m.oldValue = func(ctx context.Context) (*Legality, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Legality.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLegality sets the old Legality of the mutation.
+func withLegality(node *Legality) legalityOption {
+	return func(m *LegalityMutation) {
+		m.oldValue = func(context.Context) (*Legality, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LegalityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LegalityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("bones: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LegalityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LegalityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Legality.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFormat sets the "format" field.
+func (m *LegalityMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *LegalityMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Legality entity.
+// If the Legality object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *LegalityMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetLegality sets the "legality" field.
+func (m *LegalityMutation) SetLegality(l legality.Legality) {
+	m.legality = &l
+}
+
+// Legality returns the value of the "legality" field in the mutation.
+func (m *LegalityMutation) Legality() (r legality.Legality, exists bool) {
+	v := m.legality
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegality returns the old "legality" field's value of the Legality entity.
+// If the Legality object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityMutation) OldLegality(ctx context.Context) (v legality.Legality, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegality is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegality requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegality: %w", err)
+	}
+	return oldValue.Legality, nil
+}
+
+// ResetLegality resets all changes to the "legality" field.
+func (m *LegalityMutation) ResetLegality() {
+	m.legality = nil
+}
+
+// SetCardID sets the "card" edge to the Card entity by id.
+func (m *LegalityMutation) SetCardID(id int) {
+	m.card = &id
+}
+
+// ClearCard clears the "card" edge to the Card entity.
+func (m *LegalityMutation) ClearCard() {
+	m.clearedcard = true
+}
+
+// CardCleared reports if the "card" edge to the Card entity was cleared.
+func (m *LegalityMutation) CardCleared() bool {
+	return m.clearedcard
+}
+
+// CardID returns the "card" edge ID in the mutation.
+func (m *LegalityMutation) CardID() (id int, exists bool) {
+	if m.card != nil {
+		return *m.card, true
+	}
+	return
+}
+
+// CardIDs returns the "card" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CardID instead. It exists only for internal usage by the builders.
+func (m *LegalityMutation) CardIDs() (ids []int) {
+	if id := m.card; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCard resets all changes to the "card" edge.
+func (m *LegalityMutation) ResetCard() {
+	m.card = nil
+	m.clearedcard = false
+}
+
+// Where appends a list predicates to the LegalityMutation builder.
+func (m *LegalityMutation) Where(ps ...predicate.Legality) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LegalityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LegalityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Legality, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LegalityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LegalityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Legality).
+func (m *LegalityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LegalityMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.format != nil {
+		fields = append(fields, legality.FieldFormat)
+	}
+	if m.legality != nil {
+		fields = append(fields, legality.FieldLegality)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LegalityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case legality.FieldFormat:
+		return m.Format()
+	case legality.FieldLegality:
+		return m.Legality()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LegalityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case legality.FieldFormat:
+		return m.OldFormat(ctx)
+	case legality.FieldLegality:
+		return m.OldLegality(ctx)
+	}
+	return nil, fmt.Errorf("unknown Legality field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case legality.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case legality.FieldLegality:
+		v, ok := value.(legality.Legality)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegality(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Legality field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LegalityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LegalityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Legality numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LegalityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LegalityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LegalityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Legality nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LegalityMutation) ResetField(name string) error {
+	switch name {
+	case legality.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case legality.FieldLegality:
+		m.ResetLegality()
+		return nil
+	}
+	return fmt.Errorf("unknown Legality field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LegalityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.card != nil {
+		edges = append(edges, legality.EdgeCard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LegalityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case legality.EdgeCard:
+		if id := m.card; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LegalityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LegalityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LegalityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcard {
+		edges = append(edges, legality.EdgeCard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LegalityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case legality.EdgeCard:
+		return m.clearedcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LegalityMutation) ClearEdge(name string) error {
+	switch name {
+	case legality.EdgeCard:
+		m.ClearCard()
+		return nil
+	}
+	return fmt.Errorf("unknown Legality unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LegalityMutation) ResetEdge(name string) error {
+	switch name {
+	case legality.EdgeCard:
+		m.ResetCard()
+		return nil
+	}
+	return fmt.Errorf("unknown Legality edge %s", name)
+}
+
+// PrintingMutation represents an operation that mutates the Printing nodes in the graph.
+type PrintingMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	rarity               *printing.Rarity
+	released_at          *time.Time
+	illustration_id      *string
+	scryfall_id          *string
+	collector_number     *string
+	multiverse_ids       *[]int
+	appendmultiverse_ids []int
+	mtgo_id              *int
+	addmtgo_id           *int
+	arena_id             *int
+	addarena_id          *int
+	tcgplayer_id         *int
+	addtcgplayer_id      *int
+	language             *string
+	printed_name         *string
+	printed_text         *string
+	printed_type_line    *string
+	flavor_text          *string
+	content_hash         *string
+	clearedFields        map[string]struct{}
+	artist               *int
+	clearedartist        bool
+	set                  *int
+	clearedset           bool
+	card_face            *int
+	clearedcard_face     bool
+	images               map[int]struct{}
+	removedimages        map[int]struct{}
+	clearedimages        bool
+	done                 bool
+	oldValue             func(context.Context) (*Printing, error)
+	predicates           []predicate.Printing
+}
+
+var _ ent.Mutation = (*PrintingMutation)(nil)
+
+// printingOption allows management of the mutation configuration using functional options.
+type printingOption func(*PrintingMutation)
+
+// newPrintingMutation creates new mutation for the Printing entity.
+func newPrintingMutation(c config, op Op, opts ...printingOption) *PrintingMutation {
+	m := &PrintingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrinting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrintingID sets the ID field of the mutation.
+func withPrintingID(id int) printingOption {
+	return func(m *PrintingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Printing
+		)
+		m.oldValue = func(ctx context.Context) (*Printing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Printing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrinting sets the old Printing of the mutation.
+func withPrinting(node *Printing) printingOption {
+	return func(m *PrintingMutation) {
+		m.oldValue = func(context.Context) (*Printing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrintingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrintingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("bones: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrintingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrintingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Printing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRarity sets the "rarity" field.
+func (m *PrintingMutation) SetRarity(pr printing.Rarity) {
+	m.rarity = &pr
+}
+
+// Rarity returns the value of the "rarity" field in the mutation.
+func (m *PrintingMutation) Rarity() (r printing.Rarity, exists bool) {
+	v := m.rarity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRarity returns the old "rarity" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldRarity(ctx context.Context) (v printing.Rarity, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRarity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRarity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRarity: %w", err)
+	}
+	return oldValue.Rarity, nil
+}
+
+// ResetRarity resets all changes to the "rarity" field.
+func (m *PrintingMutation) ResetRarity() {
+	m.rarity = nil
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (m *PrintingMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
+}
+
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *PrintingMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "released_at" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *PrintingMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[printing.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *PrintingMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[printing.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *PrintingMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, printing.FieldReleasedAt)
+}
+
+// SetIllustrationID sets the "illustration_id" field.
+func (m *PrintingMutation) SetIllustrationID(s string) {
+	m.illustration_id = &s
+}
+
+// IllustrationID returns the value of the "illustration_id" field in the mutation.
+func (m *PrintingMutation) IllustrationID() (r string, exists bool) {
+	v := m.illustration_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIllustrationID returns the old "illustration_id" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldIllustrationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIllustrationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIllustrationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIllustrationID: %w", err)
+	}
+	return oldValue.IllustrationID, nil
+}
+
+// ClearIllustrationID clears the value of the "illustration_id" field.
+func (m *PrintingMutation) ClearIllustrationID() {
+	m.illustration_id = nil
+	m.clearedFields[printing.FieldIllustrationID] = struct{}{}
+}
+
+// IllustrationIDCleared returns if the "illustration_id" field was cleared in this mutation.
+func (m *PrintingMutation) IllustrationIDCleared() bool {
+	_, ok := m.clearedFields[printing.FieldIllustrationID]
+	return ok
+}
+
+// ResetIllustrationID resets all changes to the "illustration_id" field.
+func (m *PrintingMutation) ResetIllustrationID() {
+	m.illustration_id = nil
+	delete(m.clearedFields, printing.FieldIllustrationID)
+}
+
+// SetScryfallID sets the "scryfall_id" field.
+func (m *PrintingMutation) SetScryfallID(s string) {
+	m.scryfall_id = &s
+}
+
+// ScryfallID returns the value of the "scryfall_id" field in the mutation.
+func (m *PrintingMutation) ScryfallID() (r string, exists bool) {
+	v := m.scryfall_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScryfallID returns the old "scryfall_id" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldScryfallID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScryfallID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScryfallID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScryfallID: %w", err)
+	}
+	return oldValue.ScryfallID, nil
+}
+
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (m *PrintingMutation) ClearScryfallID() {
+	m.scryfall_id = nil
+	m.clearedFields[printing.FieldScryfallID] = struct{}{}
+}
+
+// ScryfallIDCleared returns if the "scryfall_id" field was cleared in this mutation.
+func (m *PrintingMutation) ScryfallIDCleared() bool {
+	_, ok := m.clearedFields[printing.FieldScryfallID]
+	return ok
+}
+
+// ResetScryfallID resets all changes to the "scryfall_id" field.
+func (m *PrintingMutation) ResetScryfallID() {
+	m.scryfall_id = nil
+	delete(m.clearedFields, printing.FieldScryfallID)
+}
+
+// SetCollectorNumber sets the "collector_number" field.
+func (m *PrintingMutation) SetCollectorNumber(s string) {
+	m.collector_number = &s
+}
+
+// CollectorNumber returns the value of the "collector_number" field in the mutation.
+func (m *PrintingMutation) CollectorNumber() (r string, exists bool) {
+	v := m.collector_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectorNumber returns the old "collector_number" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldCollectorNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectorNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectorNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectorNumber: %w", err)
+	}
+	return oldValue.CollectorNumber, nil
+}
+
+// ClearCollectorNumber clears the value of the "collector_number" field.
+func (m *PrintingMutation) ClearCollectorNumber() {
+	m.collector_number = nil
+	m.clearedFields[printing.FieldCollectorNumber] = struct{}{}
+}
+
+// CollectorNumberCleared returns if the "collector_number" field was cleared in this mutation.
+func (m *PrintingMutation) CollectorNumberCleared() bool {
+	_, ok := m.clearedFields[printing.FieldCollectorNumber]
+	return ok
+}
+
+// ResetCollectorNumber resets all changes to the "collector_number" field.
+func (m *PrintingMutation) ResetCollectorNumber() {
+	m.collector_number = nil
+	delete(m.clearedFields, printing.FieldCollectorNumber)
+}
+
+// SetMultiverseIds sets the "multiverse_ids" field.
+func (m *PrintingMutation) SetMultiverseIds(i []int) {
+	m.multiverse_ids = &i
+	m.appendmultiverse_ids = nil
+}
+
+// MultiverseIds returns the value of the "multiverse_ids" field in the mutation.
+func (m *PrintingMutation) MultiverseIds() (r []int, exists bool) {
+	v := m.multiverse_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultiverseIds returns the old "multiverse_ids" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldMultiverseIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultiverseIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultiverseIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultiverseIds: %w", err)
+	}
+	return oldValue.MultiverseIds, nil
+}
+
+// AppendMultiverseIds adds i to the "multiverse_ids" field.
+func (m *PrintingMutation) AppendMultiverseIds(i []int) {
+	m.appendmultiverse_ids = append(m.appendmultiverse_ids, i...)
+}
+
+// AppendedMultiverseIds returns the list of values that were appended to the "multiverse_ids" field in this mutation.
+func (m *PrintingMutation) AppendedMultiverseIds() ([]int, bool) {
+	if len(m.appendmultiverse_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmultiverse_ids, true
+}
+
+// ClearMultiverseIds clears the value of the "multiverse_ids" field.
+func (m *PrintingMutation) ClearMultiverseIds() {
+	m.multiverse_ids = nil
+	m.appendmultiverse_ids = nil
+	m.clearedFields[printing.FieldMultiverseIds] = struct{}{}
+}
+
+// MultiverseIdsCleared returns if the "multiverse_ids" field was cleared in this mutation.
+func (m *PrintingMutation) MultiverseIdsCleared() bool {
+	_, ok := m.clearedFields[printing.FieldMultiverseIds]
+	return ok
+}
+
+// ResetMultiverseIds resets all changes to the "multiverse_ids" field.
+func (m *PrintingMutation) ResetMultiverseIds() {
+	m.multiverse_ids = nil
+	m.appendmultiverse_ids = nil
+	delete(m.clearedFields, printing.FieldMultiverseIds)
+}
+
+// SetMtgoID sets the "mtgo_id" field.
+func (m *PrintingMutation) SetMtgoID(i int) {
+	m.mtgo_id = &i
+	m.addmtgo_id = nil
+}
+
+// MtgoID returns the value of the "mtgo_id" field in the mutation.
+func (m *PrintingMutation) MtgoID() (r int, exists bool) {
+	v := m.mtgo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMtgoID returns the old "mtgo_id" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldMtgoID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMtgoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMtgoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMtgoID: %w", err)
+	}
+	return oldValue.MtgoID, nil
+}
+
+// AddMtgoID adds i to the "mtgo_id" field.
+func (m *PrintingMutation) AddMtgoID(i int) {
+	if m.addmtgo_id != nil {
+		*m.addmtgo_id += i
+	} else {
+		m.addmtgo_id = &i
+	}
+}
+
+// AddedMtgoID returns the value that was added to the "mtgo_id" field in this mutation.
+func (m *PrintingMutation) AddedMtgoID() (r int, exists bool) {
+	v := m.addmtgo_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMtgoID clears the value of the "mtgo_id" field.
+func (m *PrintingMutation) ClearMtgoID() {
+	m.mtgo_id = nil
+	m.addmtgo_id = nil
+	m.clearedFields[printing.FieldMtgoID] = struct{}{}
+}
+
+// MtgoIDCleared returns if the "mtgo_id" field was cleared in this mutation.
+func (m *PrintingMutation) MtgoIDCleared() bool {
+	_, ok := m.clearedFields[printing.FieldMtgoID]
+	return ok
+}
+
+// ResetMtgoID resets all changes to the "mtgo_id" field.
+func (m *PrintingMutation) ResetMtgoID() {
+	m.mtgo_id = nil
+	m.addmtgo_id = nil
+	delete(m.clearedFields, printing.FieldMtgoID)
+}
+
+// SetArenaID sets the "arena_id" field.
+func (m *PrintingMutation) SetArenaID(i int) {
+	m.arena_id = &i
+	m.addarena_id = nil
+}
+
+// ArenaID returns the value of the "arena_id" field in the mutation.
+func (m *PrintingMutation) ArenaID() (r int, exists bool) {
+	v := m.arena_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArenaID returns the old "arena_id" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldArenaID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArenaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArenaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArenaID: %w", err)
+	}
+	return oldValue.ArenaID, nil
+}
+
+// AddArenaID adds i to the "arena_id" field.
+func (m *PrintingMutation) AddArenaID(i int) {
+	if m.addarena_id != nil {
+		*m.addarena_id += i
+	} else {
+		m.addarena_id = &i
+	}
+}
+
+// AddedArenaID returns the value that was added to the "arena_id" field in this mutation.
+func (m *PrintingMutation) AddedArenaID() (r int, exists bool) {
+	v := m.addarena_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearArenaID clears the value of the "arena_id" field.
+func (m *PrintingMutation) ClearArenaID() {
+	m.arena_id = nil
+	m.addarena_id = nil
+	m.clearedFields[printing.FieldArenaID] = struct{}{}
+}
+
+// ArenaIDCleared returns if the "arena_id" field was cleared in this mutation.
+func (m *PrintingMutation) ArenaIDCleared() bool {
+	_, ok := m.clearedFields[printing.FieldArenaID]
+	return ok
+}
+
+// ResetArenaID resets all changes to the "arena_id" field.
+func (m *PrintingMutation) ResetArenaID() {
+	m.arena_id = nil
+	m.addarena_id = nil
+	delete(m.clearedFields, printing.FieldArenaID)
+}
+
+// SetTcgplayerID sets the "tcgplayer_id" field.
+func (m *PrintingMutation) SetTcgplayerID(i int) {
+	m.tcgplayer_id = &i
+	m.addtcgplayer_id = nil
+}
+
+// TcgplayerID returns the value of the "tcgplayer_id" field in the mutation.
+func (m *PrintingMutation) TcgplayerID() (r int, exists bool) {
+	v := m.tcgplayer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTcgplayerID returns the old "tcgplayer_id" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldTcgplayerID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTcgplayerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTcgplayerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTcgplayerID: %w", err)
+	}
+	return oldValue.TcgplayerID, nil
+}
+
+// AddTcgplayerID adds i to the "tcgplayer_id" field.
+func (m *PrintingMutation) AddTcgplayerID(i int) {
+	if m.addtcgplayer_id != nil {
+		*m.addtcgplayer_id += i
+	} else {
+		m.addtcgplayer_id = &i
+	}
+}
+
+// AddedTcgplayerID returns the value that was added to the "tcgplayer_id" field in this mutation.
+func (m *PrintingMutation) AddedTcgplayerID() (r int, exists bool) {
+	v := m.addtcgplayer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTcgplayerID clears the value of the "tcgplayer_id" field.
+func (m *PrintingMutation) ClearTcgplayerID() {
+	m.tcgplayer_id = nil
+	m.addtcgplayer_id = nil
+	m.clearedFields[printing.FieldTcgplayerID] = struct{}{}
+}
+
+// TcgplayerIDCleared returns if the "tcgplayer_id" field was cleared in this mutation.
+func (m *PrintingMutation) TcgplayerIDCleared() bool {
+	_, ok := m.clearedFields[printing.FieldTcgplayerID]
+	return ok
+}
+
+// ResetTcgplayerID resets all changes to the "tcgplayer_id" field.
+func (m *PrintingMutation) ResetTcgplayerID() {
+	m.tcgplayer_id = nil
+	m.addtcgplayer_id = nil
+	delete(m.clearedFields, printing.FieldTcgplayerID)
+}
+
+// SetLanguage sets the "language" field.
+func (m *PrintingMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *PrintingMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *PrintingMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetPrintedName sets the "printed_name" field.
+func (m *PrintingMutation) SetPrintedName(s string) {
+	m.printed_name = &s
+}
+
+// PrintedName returns the value of the "printed_name" field in the mutation.
+func (m *PrintingMutation) PrintedName() (r string, exists bool) {
+	v := m.printed_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrintedName returns the old "printed_name" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldPrintedName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrintedName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrintedName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrintedName: %w", err)
+	}
+	return oldValue.PrintedName, nil
+}
+
+// ClearPrintedName clears the value of the "printed_name" field.
+func (m *PrintingMutation) ClearPrintedName() {
+	m.printed_name = nil
+	m.clearedFields[printing.FieldPrintedName] = struct{}{}
+}
+
+// PrintedNameCleared returns if the "printed_name" field was cleared in this mutation.
+func (m *PrintingMutation) PrintedNameCleared() bool {
+	_, ok := m.clearedFields[printing.FieldPrintedName]
+	return ok
+}
+
+// ResetPrintedName resets all changes to the "printed_name" field.
+func (m *PrintingMutation) ResetPrintedName() {
+	m.printed_name = nil
+	delete(m.clearedFields, printing.FieldPrintedName)
+}
+
+// SetPrintedText sets the "printed_text" field.
+func (m *PrintingMutation) SetPrintedText(s string) {
+	m.printed_text = &s
+}
+
+// PrintedText returns the value of the "printed_text" field in the mutation.
+func (m *PrintingMutation) PrintedText() (r string, exists bool) {
+	v := m.printed_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrintedText returns the old "printed_text" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldPrintedText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrintedText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrintedText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrintedText: %w", err)
+	}
+	return oldValue.PrintedText, nil
+}
+
+// ClearPrintedText clears the value of the "printed_text" field.
+func (m *PrintingMutation) ClearPrintedText() {
+	m.printed_text = nil
+	m.clearedFields[printing.FieldPrintedText] = struct{}{}
+}
+
+// PrintedTextCleared returns if the "printed_text" field was cleared in this mutation.
+func (m *PrintingMutation) PrintedTextCleared() bool {
+	_, ok := m.clearedFields[printing.FieldPrintedText]
+	return ok
+}
+
+// ResetPrintedText resets all changes to the "printed_text" field.
+func (m *PrintingMutation) ResetPrintedText() {
+	m.printed_text = nil
+	delete(m.clearedFields, printing.FieldPrintedText)
+}
+
+// SetPrintedTypeLine sets the "printed_type_line" field.
+func (m *PrintingMutation) SetPrintedTypeLine(s string) {
+	m.printed_type_line = &s
+}
+
+// PrintedTypeLine returns the value of the "printed_type_line" field in the mutation.
+func (m *PrintingMutation) PrintedTypeLine() (r string, exists bool) {
+	v := m.printed_type_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrintedTypeLine returns the old "printed_type_line" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldPrintedTypeLine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrintedTypeLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrintedTypeLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrintedTypeLine: %w", err)
+	}
+	return oldValue.PrintedTypeLine, nil
+}
+
+// ClearPrintedTypeLine clears the value of the "printed_type_line" field.
+func (m *PrintingMutation) ClearPrintedTypeLine() {
+	m.printed_type_line = nil
+	m.clearedFields[printing.FieldPrintedTypeLine] = struct{}{}
+}
+
+// PrintedTypeLineCleared returns if the "printed_type_line" field was cleared in this mutation.
+func (m *PrintingMutation) PrintedTypeLineCleared() bool {
+	_, ok := m.clearedFields[printing.FieldPrintedTypeLine]
+	return ok
+}
+
+// ResetPrintedTypeLine resets all changes to the "printed_type_line" field.
+func (m *PrintingMutation) ResetPrintedTypeLine() {
+	m.printed_type_line = nil
+	delete(m.clearedFields, printing.FieldPrintedTypeLine)
+}
+
+// SetFlavorText sets the "flavor_text" field.
+func (m *PrintingMutation) SetFlavorText(s string) {
+	m.flavor_text = &s
+}
+
+// FlavorText returns the value of the "flavor_text" field in the mutation.
+func (m *PrintingMutation) FlavorText() (r string, exists bool) {
+	v := m.flavor_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlavorText returns the old "flavor_text" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldFlavorText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlavorText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlavorText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlavorText: %w", err)
+	}
+	return oldValue.FlavorText, nil
+}
+
+// ClearFlavorText clears the value of the "flavor_text" field.
+func (m *PrintingMutation) ClearFlavorText() {
+	m.flavor_text = nil
+	m.clearedFields[printing.FieldFlavorText] = struct{}{}
+}
+
+// FlavorTextCleared returns if the "flavor_text" field was cleared in this mutation.
+func (m *PrintingMutation) FlavorTextCleared() bool {
+	_, ok := m.clearedFields[printing.FieldFlavorText]
+	return ok
+}
+
+// ResetFlavorText resets all changes to the "flavor_text" field.
+func (m *PrintingMutation) ResetFlavorText() {
+	m.flavor_text = nil
+	delete(m.clearedFields, printing.FieldFlavorText)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *PrintingMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *PrintingMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Printing entity.
+// If the Printing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *PrintingMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[printing.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *PrintingMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[printing.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *PrintingMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, printing.FieldContentHash)
+}
+
+// SetArtistID sets the "artist" edge to the Artist entity by id.
+func (m *PrintingMutation) SetArtistID(id int) {
+	m.artist = &id
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (m *PrintingMutation) ClearArtist() {
+	m.clearedartist = true
+}
+
+// ArtistCleared reports if the "artist" edge to the Artist entity was cleared.
+func (m *PrintingMutation) ArtistCleared() bool {
+	return m.clearedartist
+}
+
+// ArtistID returns the "artist" edge ID in the mutation.
+func (m *PrintingMutation) ArtistID() (id int, exists bool) {
+	if m.artist != nil {
+		return *m.artist, true
+	}
+	return
+}
+
+// ArtistIDs returns the "artist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtistID instead. It exists only for internal usage by the builders.
+func (m *PrintingMutation) ArtistIDs() (ids []int) {
+	if id := m.artist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtist resets all changes to the "artist" edge.
+func (m *PrintingMutation) ResetArtist() {
+	m.artist = nil
+	m.clearedartist = false
+}
+
+// SetSetID sets the "set" edge to the Set entity by id.
+func (m *PrintingMutation) SetSetID(id int) {
+	m.set = &id
+}
+
+// ClearSet clears the "set" edge to the Set entity.
+func (m *PrintingMutation) ClearSet() {
+	m.clearedset = true
+}
+
+// SetCleared reports if the "set" edge to the Set entity was cleared.
+func (m *PrintingMutation) SetCleared() bool {
+	return m.clearedset
+}
+
+// SetID returns the "set" edge ID in the mutation.
+func (m *PrintingMutation) SetID() (id int, exists bool) {
+	if m.set != nil {
+		return *m.set, true
+	}
+	return
+}
+
+// SetIDs returns the "set" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SetID instead. It exists only for internal usage by the builders.
+func (m *PrintingMutation) SetIDs() (ids []int) {
+	if id := m.set; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSet resets all changes to the "set" edge.
+func (m *PrintingMutation) ResetSet() {
+	m.set = nil
+	m.clearedset = false
+}
+
+// SetCardFaceID sets the "card_face" edge to the CardFace entity by id.
+func (m *PrintingMutation) SetCardFaceID(id int) {
+	m.card_face = &id
+}
+
+// ClearCardFace clears the "card_face" edge to the CardFace entity.
+func (m *PrintingMutation) ClearCardFace() {
+	m.clearedcard_face = true
+}
+
+// CardFaceCleared reports if the "card_face" edge to the CardFace entity was cleared.
+func (m *PrintingMutation) CardFaceCleared() bool {
+	return m.clearedcard_face
+}
+
+// CardFaceID returns the "card_face" edge ID in the mutation.
+func (m *PrintingMutation) CardFaceID() (id int, exists bool) {
+	if m.card_face != nil {
+		return *m.card_face, true
+	}
+	return
+}
+
+// CardFaceIDs returns the "card_face" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CardFaceID instead. It exists only for internal usage by the builders.
+func (m *PrintingMutation) CardFaceIDs() (ids []int) {
+	if id := m.card_face; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCardFace resets all changes to the "card_face" edge.
+func (m *PrintingMutation) ResetCardFace() {
+	m.card_face = nil
+	m.clearedcard_face = false
+}
+
+// AddImageIDs adds the "images" edge to the PrintingImage entity by ids.
+func (m *PrintingMutation) AddImageIDs(ids ...int) {
+	if m.images == nil {
+		m.images = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.images[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImages clears the "images" edge to the PrintingImage entity.
+func (m *PrintingMutation) ClearImages() {
+	m.clearedimages = true
+}
+
+// ImagesCleared reports if the "images" edge to the PrintingImage entity was cleared.
+func (m *PrintingMutation) ImagesCleared() bool {
+	return m.clearedimages
+}
+
+// RemoveImageIDs removes the "images" edge to the PrintingImage entity by IDs.
+func (m *PrintingMutation) RemoveImageIDs(ids ...int) {
+	if m.removedimages == nil {
+		m.removedimages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.images, ids[i])
+		m.removedimages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImages returns the removed IDs of the "images" edge to the PrintingImage entity.
+func (m *PrintingMutation) RemovedImagesIDs() (ids []int) {
+	for id := range m.removedimages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImagesIDs returns the "images" edge IDs in the mutation.
+func (m *PrintingMutation) ImagesIDs() (ids []int) {
+	for id := range m.images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImages resets all changes to the "images" edge.
+func (m *PrintingMutation) ResetImages() {
+	m.images = nil
+	m.clearedimages = false
+	m.removedimages = nil
+}
+
+// Where appends a list predicates to the PrintingMutation builder.
+func (m *PrintingMutation) Where(ps ...predicate.Printing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrintingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrintingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Printing, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrintingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrintingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Printing).
+func (m *PrintingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrintingMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.rarity != nil {
+		fields = append(fields, printing.FieldRarity)
+	}
+	if m.released_at != nil {
+		fields = append(fields, printing.FieldReleasedAt)
+	}
+	if m.illustration_id != nil {
+		fields = append(fields, printing.FieldIllustrationID)
+	}
+	if m.scryfall_id != nil {
+		fields = append(fields, printing.FieldScryfallID)
+	}
+	if m.collector_number != nil {
+		fields = append(fields, printing.FieldCollectorNumber)
+	}
+	if m.multiverse_ids != nil {
+		fields = append(fields, printing.FieldMultiverseIds)
+	}
+	if m.mtgo_id != nil {
+		fields = append(fields, printing.FieldMtgoID)
+	}
+	if m.arena_id != nil {
+		fields = append(fields, printing.FieldArenaID)
+	}
+	if m.tcgplayer_id != nil {
+		fields = append(fields, printing.FieldTcgplayerID)
+	}
+	if m.language != nil {
+		fields = append(fields, printing.FieldLanguage)
+	}
+	if m.printed_name != nil {
+		fields = append(fields, printing.FieldPrintedName)
+	}
+	if m.printed_text != nil {
+		fields = append(fields, printing.FieldPrintedText)
+	}
+	if m.printed_type_line != nil {
+		fields = append(fields, printing.FieldPrintedTypeLine)
+	}
+	if m.flavor_text != nil {
+		fields = append(fields, printing.FieldFlavorText)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, printing.FieldContentHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrintingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case printing.FieldRarity:
+		return m.Rarity()
+	case printing.FieldReleasedAt:
+		return m.ReleasedAt()
+	case printing.FieldIllustrationID:
+		return m.IllustrationID()
+	case printing.FieldScryfallID:
+		return m.ScryfallID()
+	case printing.FieldCollectorNumber:
+		return m.CollectorNumber()
+	case printing.FieldMultiverseIds:
+		return m.MultiverseIds()
+	case printing.FieldMtgoID:
+		return m.MtgoID()
+	case printing.FieldArenaID:
+		return m.ArenaID()
+	case printing.FieldTcgplayerID:
+		return m.TcgplayerID()
+	case printing.FieldLanguage:
+		return m.Language()
+	case printing.FieldPrintedName:
+		return m.PrintedName()
+	case printing.FieldPrintedText:
+		return m.PrintedText()
+	case printing.FieldPrintedTypeLine:
+		return m.PrintedTypeLine()
+	case printing.FieldFlavorText:
+		return m.FlavorText()
+	case printing.FieldContentHash:
+		return m.ContentHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrintingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case printing.FieldRarity:
+		return m.OldRarity(ctx)
+	case printing.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case printing.FieldIllustrationID:
+		return m.OldIllustrationID(ctx)
+	case printing.FieldScryfallID:
+		return m.OldScryfallID(ctx)
+	case printing.FieldCollectorNumber:
+		return m.OldCollectorNumber(ctx)
+	case printing.FieldMultiverseIds:
+		return m.OldMultiverseIds(ctx)
+	case printing.FieldMtgoID:
+		return m.OldMtgoID(ctx)
+	case printing.FieldArenaID:
+		return m.OldArenaID(ctx)
+	case printing.FieldTcgplayerID:
+		return m.OldTcgplayerID(ctx)
+	case printing.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case printing.FieldPrintedName:
+		return m.OldPrintedName(ctx)
+	case printing.FieldPrintedText:
+		return m.OldPrintedText(ctx)
+	case printing.FieldPrintedTypeLine:
+		return m.OldPrintedTypeLine(ctx)
+	case printing.FieldFlavorText:
+		return m.OldFlavorText(ctx)
+	case printing.FieldContentHash:
+		return m.OldContentHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown Printing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrintingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case printing.FieldRarity:
+		v, ok := value.(printing.Rarity)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRarity(v)
+		return nil
+	case printing.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	case printing.FieldIllustrationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIllustrationID(v)
+		return nil
+	case printing.FieldScryfallID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScryfallID(v)
+		return nil
+	case printing.FieldCollectorNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectorNumber(v)
+		return nil
+	case printing.FieldMultiverseIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultiverseIds(v)
+		return nil
+	case printing.FieldMtgoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMtgoID(v)
+		return nil
+	case printing.FieldArenaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArenaID(v)
+		return nil
+	case printing.FieldTcgplayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTcgplayerID(v)
+		return nil
+	case printing.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case printing.FieldPrintedName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrintedName(v)
+		return nil
+	case printing.FieldPrintedText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrintedText(v)
+		return nil
+	case printing.FieldPrintedTypeLine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrintedTypeLine(v)
+		return nil
+	case printing.FieldFlavorText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlavorText(v)
+		return nil
+	case printing.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Printing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrintingMutation) AddedFields() []string {
+	var fields []string
+	if m.addmtgo_id != nil {
+		fields = append(fields, printing.FieldMtgoID)
+	}
+	if m.addarena_id != nil {
+		fields = append(fields, printing.FieldArenaID)
+	}
+	if m.addtcgplayer_id != nil {
+		fields = append(fields, printing.FieldTcgplayerID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrintingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case printing.FieldMtgoID:
+		return m.AddedMtgoID()
+	case printing.FieldArenaID:
+		return m.AddedArenaID()
+	case printing.FieldTcgplayerID:
+		return m.AddedTcgplayerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrintingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case printing.FieldMtgoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMtgoID(v)
+		return nil
+	case printing.FieldArenaID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddArenaID(v)
+		return nil
+	case printing.FieldTcgplayerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTcgplayerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Printing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrintingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(printing.FieldReleasedAt) {
+		fields = append(fields, printing.FieldReleasedAt)
+	}
+	if m.FieldCleared(printing.FieldIllustrationID) {
+		fields = append(fields, printing.FieldIllustrationID)
+	}
+	if m.FieldCleared(printing.FieldScryfallID) {
+		fields = append(fields, printing.FieldScryfallID)
+	}
+	if m.FieldCleared(printing.FieldCollectorNumber) {
+		fields = append(fields, printing.FieldCollectorNumber)
+	}
+	if m.FieldCleared(printing.FieldMultiverseIds) {
+		fields = append(fields, printing.FieldMultiverseIds)
+	}
+	if m.FieldCleared(printing.FieldMtgoID) {
+		fields = append(fields, printing.FieldMtgoID)
+	}
+	if m.FieldCleared(printing.FieldArenaID) {
+		fields = append(fields, printing.FieldArenaID)
+	}
+	if m.FieldCleared(printing.FieldTcgplayerID) {
+		fields = append(fields, printing.FieldTcgplayerID)
+	}
+	if m.FieldCleared(printing.FieldPrintedName) {
+		fields = append(fields, printing.FieldPrintedName)
+	}
+	if m.FieldCleared(printing.FieldPrintedText) {
+		fields = append(fields, printing.FieldPrintedText)
+	}
+	if m.FieldCleared(printing.FieldPrintedTypeLine) {
+		fields = append(fields, printing.FieldPrintedTypeLine)
+	}
+	if m.FieldCleared(printing.FieldFlavorText) {
+		fields = append(fields, printing.FieldFlavorText)
+	}
+	if m.FieldCleared(printing.FieldContentHash) {
+		fields = append(fields, printing.FieldContentHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrintingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrintingMutation) ClearField(name string) error {
+	switch name {
+	case printing.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	case printing.FieldIllustrationID:
+		m.ClearIllustrationID()
+		return nil
+	case printing.FieldScryfallID:
+		m.ClearScryfallID()
+		return nil
+	case printing.FieldCollectorNumber:
+		m.ClearCollectorNumber()
+		return nil
+	case printing.FieldMultiverseIds:
+		m.ClearMultiverseIds()
+		return nil
+	case printing.FieldMtgoID:
+		m.ClearMtgoID()
+		return nil
+	case printing.FieldArenaID:
+		m.ClearArenaID()
+		return nil
+	case printing.FieldTcgplayerID:
+		m.ClearTcgplayerID()
+		return nil
+	case printing.FieldPrintedName:
+		m.ClearPrintedName()
+		return nil
+	case printing.FieldPrintedText:
+		m.ClearPrintedText()
+		return nil
+	case printing.FieldPrintedTypeLine:
+		m.ClearPrintedTypeLine()
+		return nil
+	case printing.FieldFlavorText:
+		m.ClearFlavorText()
+		return nil
+	case printing.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Printing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrintingMutation) ResetField(name string) error {
+	switch name {
+	case printing.FieldRarity:
+		m.ResetRarity()
+		return nil
+	case printing.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	case printing.FieldIllustrationID:
+		m.ResetIllustrationID()
+		return nil
+	case printing.FieldScryfallID:
+		m.ResetScryfallID()
+		return nil
+	case printing.FieldCollectorNumber:
+		m.ResetCollectorNumber()
+		return nil
+	case printing.FieldMultiverseIds:
+		m.ResetMultiverseIds()
+		return nil
+	case printing.FieldMtgoID:
+		m.ResetMtgoID()
+		return nil
+	case printing.FieldArenaID:
+		m.ResetArenaID()
+		return nil
+	case printing.FieldTcgplayerID:
+		m.ResetTcgplayerID()
+		return nil
+	case printing.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case printing.FieldPrintedName:
+		m.ResetPrintedName()
+		return nil
+	case printing.FieldPrintedText:
+		m.ResetPrintedText()
+		return nil
+	case printing.FieldPrintedTypeLine:
+		m.ResetPrintedTypeLine()
+		return nil
+	case printing.FieldFlavorText:
+		m.ResetFlavorText()
+		return nil
+	case printing.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Printing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrintingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.artist != nil {
+		edges = append(edges, printing.EdgeArtist)
+	}
+	if m.set != nil {
+		edges = append(edges, printing.EdgeSet)
+	}
+	if m.card_face != nil {
+		edges = append(edges, printing.EdgeCardFace)
+	}
+	if m.images != nil {
+		edges = append(edges, printing.EdgeImages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrintingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case printing.EdgeArtist:
+		if id := m.artist; id != nil {
+			return []ent.Value{*id}
+		}
+	case printing.EdgeSet:
+		if id := m.set; id != nil {
+			return []ent.Value{*id}
+		}
+	case printing.EdgeCardFace:
+		if id := m.card_face; id != nil {
+			return []ent.Value{*id}
+		}
+	case printing.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.images))
+		for id := range m.images {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrintingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedimages != nil {
+		edges = append(edges, printing.EdgeImages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrintingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case printing.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.removedimages))
+		for id := range m.removedimages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrintingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedartist {
+		edges = append(edges, printing.EdgeArtist)
+	}
+	if m.clearedset {
+		edges = append(edges, printing.EdgeSet)
+	}
+	if m.clearedcard_face {
+		edges = append(edges, printing.EdgeCardFace)
+	}
+	if m.clearedimages {
+		edges = append(edges, printing.EdgeImages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrintingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case printing.EdgeArtist:
+		return m.clearedartist
+	case printing.EdgeSet:
+		return m.clearedset
+	case printing.EdgeCardFace:
+		return m.clearedcard_face
+	case printing.EdgeImages:
+		return m.clearedimages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrintingMutation) ClearEdge(name string) error {
+	switch name {
+	case printing.EdgeArtist:
+		m.ClearArtist()
+		return nil
+	case printing.EdgeSet:
+		m.ClearSet()
+		return nil
+	case printing.EdgeCardFace:
+		m.ClearCardFace()
+		return nil
+	}
+	return fmt.Errorf("unknown Printing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrintingMutation) ResetEdge(name string) error {
+	switch name {
+	case printing.EdgeArtist:
+		m.ResetArtist()
+		return nil
+	case printing.EdgeSet:
+		m.ResetSet()
+		return nil
+	case printing.EdgeCardFace:
+		m.ResetCardFace()
+		return nil
+	case printing.EdgeImages:
+		m.ResetImages()
+		return nil
+	}
+	return fmt.Errorf("unknown Printing edge %s", name)
+}
+
+// PrintingImageMutation represents an operation that mutates the PrintingImage nodes in the graph.
+type PrintingImageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	url             *string
+	image_type      *printingimage.ImageType
+	local_path      *string
+	clearedFields   map[string]struct{}
+	printing        *int
+	clearedprinting bool
+	done            bool
+	oldValue        func(context.Context) (*PrintingImage, error)
+	predicates      []predicate.PrintingImage
 }
 
-// ClearImages clears the "images" edge to the PrintingImage entity.
-func (m *PrintingMutation) ClearImages() {
-	m.clearedimages = true
+var _ ent.Mutation = (*PrintingImageMutation)(nil)
+
+// printingimageOption allows management of the mutation configuration using functional options.
+type printingimageOption func(*PrintingImageMutation)
+
+// newPrintingImageMutation creates new mutation for the PrintingImage entity.
+func newPrintingImageMutation(c config, op Op, opts ...printingimageOption) *PrintingImageMutation {
+	m := &PrintingImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrintingImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ImagesCleared reports if the "images" edge to the PrintingImage entity was cleared.
-func (m *PrintingMutation) ImagesCleared() bool {
-	return m.clearedimages
+// withPrintingImageID sets the ID field of the mutation.
+func withPrintingImageID(id int) printingimageOption {
+	return func(m *PrintingImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrintingImage
+		)
+		m.oldValue = func(ctx context.Context) (*PrintingImage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrintingImage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// RemoveImageIDs removes the "images" edge to the PrintingImage entity by IDs.
-func (m *PrintingMutation) RemoveImageIDs(ids ...int) {
-	if m.removedimages == nil {
-		m.removedimages = make(map[int]struct{})
+// withPrintingImage sets the old PrintingImage of the mutation.
+func withPrintingImage(node *PrintingImage) printingimageOption {
+	return func(m *PrintingImageMutation) {
+		m.oldValue = func(context.Context) (*PrintingImage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	for i := range ids {
-		delete(m.images, ids[i])
-		m.removedimages[ids[i]] = struct{}{}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrintingImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrintingImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemovedImages returns the removed IDs of the "images" edge to the PrintingImage entity.
-func (m *PrintingMutation) RemovedImagesIDs() (ids []int) {
-	for id := range m.removedimages {
-		ids = append(ids, id)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrintingImageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrintingImageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrintingImage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURL sets the "url" field.
+func (m *PrintingImageMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *PrintingImageMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the PrintingImage entity.
+// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingImageMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *PrintingImageMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetImageType sets the "image_type" field.
+func (m *PrintingImageMutation) SetImageType(pt printingimage.ImageType) {
+	m.image_type = &pt
+}
+
+// ImageType returns the value of the "image_type" field in the mutation.
+func (m *PrintingImageMutation) ImageType() (r printingimage.ImageType, exists bool) {
+	v := m.image_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageType returns the old "image_type" field's value of the PrintingImage entity.
+// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingImageMutation) OldImageType(ctx context.Context) (v printingimage.ImageType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageType: %w", err)
+	}
+	return oldValue.ImageType, nil
+}
+
+// ResetImageType resets all changes to the "image_type" field.
+func (m *PrintingImageMutation) ResetImageType() {
+	m.image_type = nil
+}
+
+// SetLocalPath sets the "local_path" field.
+func (m *PrintingImageMutation) SetLocalPath(s string) {
+	m.local_path = &s
+}
+
+// LocalPath returns the value of the "local_path" field in the mutation.
+func (m *PrintingImageMutation) LocalPath() (r string, exists bool) {
+	v := m.local_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocalPath returns the old "local_path" field's value of the PrintingImage entity.
+// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrintingImageMutation) OldLocalPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocalPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocalPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocalPath: %w", err)
+	}
+	return oldValue.LocalPath, nil
+}
+
+// ClearLocalPath clears the value of the "local_path" field.
+func (m *PrintingImageMutation) ClearLocalPath() {
+	m.local_path = nil
+	m.clearedFields[printingimage.FieldLocalPath] = struct{}{}
+}
+
+// LocalPathCleared returns if the "local_path" field was cleared in this mutation.
+func (m *PrintingImageMutation) LocalPathCleared() bool {
+	_, ok := m.clearedFields[printingimage.FieldLocalPath]
+	return ok
+}
+
+// ResetLocalPath resets all changes to the "local_path" field.
+func (m *PrintingImageMutation) ResetLocalPath() {
+	m.local_path = nil
+	delete(m.clearedFields, printingimage.FieldLocalPath)
+}
+
+// SetPrintingID sets the "printing" edge to the Printing entity by id.
+func (m *PrintingImageMutation) SetPrintingID(id int) {
+	m.printing = &id
+}
+
+// ClearPrinting clears the "printing" edge to the Printing entity.
+func (m *PrintingImageMutation) ClearPrinting() {
+	m.clearedprinting = true
+}
+
+// PrintingCleared reports if the "printing" edge to the Printing entity was cleared.
+func (m *PrintingImageMutation) PrintingCleared() bool {
+	return m.clearedprinting
+}
+
+// PrintingID returns the "printing" edge ID in the mutation.
+func (m *PrintingImageMutation) PrintingID() (id int, exists bool) {
+	if m.printing != nil {
+		return *m.printing, true
 	}
 	return
 }
 
-// ImagesIDs returns the "images" edge IDs in the mutation.
-func (m *PrintingMutation) ImagesIDs() (ids []int) {
-	for id := range m.images {
-		ids = append(ids, id)
+// PrintingIDs returns the "printing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PrintingID instead. It exists only for internal usage by the builders.
+func (m *PrintingImageMutation) PrintingIDs() (ids []int) {
+	if id := m.printing; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetImages resets all changes to the "images" edge.
-func (m *PrintingMutation) ResetImages() {
-	m.images = nil
-	m.clearedimages = false
-	m.removedimages = nil
+// ResetPrinting resets all changes to the "printing" edge.
+func (m *PrintingImageMutation) ResetPrinting() {
+	m.printing = nil
+	m.clearedprinting = false
 }
 
-// Where appends a list predicates to the PrintingMutation builder.
-func (m *PrintingMutation) Where(ps ...predicate.Printing) {
+// Where appends a list predicates to the PrintingImageMutation builder.
+func (m *PrintingImageMutation) Where(ps ...predicate.PrintingImage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PrintingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PrintingImageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PrintingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Printing, len(ps))
+func (m *PrintingImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrintingImage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2501,27 +5755,33 @@ func (m *PrintingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PrintingMutation) Op() Op {
+func (m *PrintingImageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PrintingMutation) SetOp(op Op) {
+func (m *PrintingImageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Printing).
-func (m *PrintingMutation) Type() string {
+// Type returns the node type of this mutation (PrintingImage).
+func (m *PrintingImageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PrintingMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.rarity != nil {
-		fields = append(fields, printing.FieldRarity)
+func (m *PrintingImageMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.url != nil {
+		fields = append(fields, printingimage.FieldURL)
+	}
+	if m.image_type != nil {
+		fields = append(fields, printingimage.FieldImageType)
+	}
+	if m.local_path != nil {
+		fields = append(fields, printingimage.FieldLocalPath)
 	}
 	return fields
 }
@@ -2529,10 +5789,14 @@ func (m *PrintingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PrintingMutation) Field(name string) (ent.Value, bool) {
+func (m *PrintingImageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case printing.FieldRarity:
-		return m.Rarity()
+	case printingimage.FieldURL:
+		return m.URL()
+	case printingimage.FieldImageType:
+		return m.ImageType()
+	case printingimage.FieldLocalPath:
+		return m.LocalPath()
 	}
 	return nil, false
 }
@@ -2540,248 +5804,217 @@ func (m *PrintingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PrintingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PrintingImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case printing.FieldRarity:
-		return m.OldRarity(ctx)
+	case printingimage.FieldURL:
+		return m.OldURL(ctx)
+	case printingimage.FieldImageType:
+		return m.OldImageType(ctx)
+	case printingimage.FieldLocalPath:
+		return m.OldLocalPath(ctx)
 	}
-	return nil, fmt.Errorf("unknown Printing field %s", name)
+	return nil, fmt.Errorf("unknown PrintingImage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrintingMutation) SetField(name string, value ent.Value) error {
+func (m *PrintingImageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case printing.FieldRarity:
-		v, ok := value.(printing.Rarity)
+	case printingimage.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRarity(v)
+		m.SetURL(v)
+		return nil
+	case printingimage.FieldImageType:
+		v, ok := value.(printingimage.ImageType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageType(v)
+		return nil
+	case printingimage.FieldLocalPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocalPath(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Printing field %s", name)
+	return fmt.Errorf("unknown PrintingImage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PrintingMutation) AddedFields() []string {
+func (m *PrintingImageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PrintingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PrintingImageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrintingMutation) AddField(name string, value ent.Value) error {
+func (m *PrintingImageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Printing numeric field %s", name)
+	return fmt.Errorf("unknown PrintingImage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PrintingMutation) ClearedFields() []string {
-	return nil
+func (m *PrintingImageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(printingimage.FieldLocalPath) {
+		fields = append(fields, printingimage.FieldLocalPath)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PrintingMutation) FieldCleared(name string) bool {
+func (m *PrintingImageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PrintingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Printing nullable field %s", name)
+func (m *PrintingImageMutation) ClearField(name string) error {
+	switch name {
+	case printingimage.FieldLocalPath:
+		m.ClearLocalPath()
+		return nil
+	}
+	return fmt.Errorf("unknown PrintingImage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PrintingMutation) ResetField(name string) error {
+func (m *PrintingImageMutation) ResetField(name string) error {
 	switch name {
-	case printing.FieldRarity:
-		m.ResetRarity()
+	case printingimage.FieldURL:
+		m.ResetURL()
+		return nil
+	case printingimage.FieldImageType:
+		m.ResetImageType()
+		return nil
+	case printingimage.FieldLocalPath:
+		m.ResetLocalPath()
 		return nil
 	}
-	return fmt.Errorf("unknown Printing field %s", name)
+	return fmt.Errorf("unknown PrintingImage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PrintingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.artist != nil {
-		edges = append(edges, printing.EdgeArtist)
-	}
-	if m.set != nil {
-		edges = append(edges, printing.EdgeSet)
-	}
-	if m.card_face != nil {
-		edges = append(edges, printing.EdgeCardFace)
-	}
-	if m.images != nil {
-		edges = append(edges, printing.EdgeImages)
+func (m *PrintingImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.printing != nil {
+		edges = append(edges, printingimage.EdgePrinting)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PrintingMutation) AddedIDs(name string) []ent.Value {
+func (m *PrintingImageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case printing.EdgeArtist:
-		if id := m.artist; id != nil {
-			return []ent.Value{*id}
-		}
-	case printing.EdgeSet:
-		if id := m.set; id != nil {
-			return []ent.Value{*id}
-		}
-	case printing.EdgeCardFace:
-		if id := m.card_face; id != nil {
+	case printingimage.EdgePrinting:
+		if id := m.printing; id != nil {
 			return []ent.Value{*id}
 		}
-	case printing.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.images))
-		for id := range m.images {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PrintingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedimages != nil {
-		edges = append(edges, printing.EdgeImages)
-	}
+func (m *PrintingImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PrintingMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case printing.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.removedimages))
-		for id := range m.removedimages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PrintingImageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PrintingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedartist {
-		edges = append(edges, printing.EdgeArtist)
-	}
-	if m.clearedset {
-		edges = append(edges, printing.EdgeSet)
-	}
-	if m.clearedcard_face {
-		edges = append(edges, printing.EdgeCardFace)
-	}
-	if m.clearedimages {
-		edges = append(edges, printing.EdgeImages)
+func (m *PrintingImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedprinting {
+		edges = append(edges, printingimage.EdgePrinting)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PrintingMutation) EdgeCleared(name string) bool {
+func (m *PrintingImageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case printing.EdgeArtist:
-		return m.clearedartist
-	case printing.EdgeSet:
-		return m.clearedset
-	case printing.EdgeCardFace:
-		return m.clearedcard_face
-	case printing.EdgeImages:
-		return m.clearedimages
+	case printingimage.EdgePrinting:
+		return m.clearedprinting
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PrintingMutation) ClearEdge(name string) error {
+func (m *PrintingImageMutation) ClearEdge(name string) error {
 	switch name {
-	case printing.EdgeArtist:
-		m.ClearArtist()
-		return nil
-	case printing.EdgeSet:
-		m.ClearSet()
-		return nil
-	case printing.EdgeCardFace:
-		m.ClearCardFace()
+	case printingimage.EdgePrinting:
+		m.ClearPrinting()
 		return nil
 	}
-	return fmt.Errorf("unknown Printing unique edge %s", name)
+	return fmt.Errorf("unknown PrintingImage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PrintingMutation) ResetEdge(name string) error {
+func (m *PrintingImageMutation) ResetEdge(name string) error {
 	switch name {
-	case printing.EdgeArtist:
-		m.ResetArtist()
-		return nil
-	case printing.EdgeSet:
-		m.ResetSet()
-		return nil
-	case printing.EdgeCardFace:
-		m.ResetCardFace()
-		return nil
-	case printing.EdgeImages:
-		m.ResetImages()
+	case printingimage.EdgePrinting:
+		m.ResetPrinting()
 		return nil
 	}
-	return fmt.Errorf("unknown Printing edge %s", name)
+	return fmt.Errorf("unknown PrintingImage edge %s", name)
 }
 
-// PrintingImageMutation represents an operation that mutates the PrintingImage nodes in the graph.
-type PrintingImageMutation struct {
+// RulingMutation represents an operation that mutates the Ruling nodes in the graph.
+type RulingMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	url             *string
-	image_type      *printingimage.ImageType
-	local_path      *string
-	clearedFields   map[string]struct{}
-	printing        *int
-	clearedprinting bool
-	done            bool
-	oldValue        func(context.Context) (*PrintingImage, error)
-	predicates      []predicate.PrintingImage
+	op            Op
+	typ           string
+	id            *int
+	text          *string
+	date          *time.Time
+	source        *ruling.Source
+	clearedFields map[string]struct{}
+	card          *int
+	clearedcard   bool
+	done          bool
+	oldValue      func(context.Context) (*Ruling, error)
+	predicates    []predicate.Ruling
 }
 
-var _ ent.Mutation = (*PrintingImageMutation)(nil)
+var _ ent.Mutation = (*RulingMutation)(nil)
 
-// printingimageOption allows management of the mutation configuration using functional options.
-type printingimageOption func(*PrintingImageMutation)
+// rulingOption allows management of the mutation configuration using functional options.
+type rulingOption func(*RulingMutation)
 
-// newPrintingImageMutation creates new mutation for the PrintingImage entity.
-func newPrintingImageMutation(c config, op Op, opts ...printingimageOption) *PrintingImageMutation {
-	m := &PrintingImageMutation{
+// newRulingMutation creates new mutation for the Ruling entity.
+func newRulingMutation(c config, op Op, opts ...rulingOption) *RulingMutation {
+	m := &RulingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePrintingImage,
+		typ:           TypeRuling,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2790,20 +6023,20 @@ func newPrintingImageMutation(c config, op Op, opts ...printingimageOption) *Pri
 	return m
 }
 
-// withPrintingImageID sets the ID field of the mutation.
-func withPrintingImageID(id int) printingimageOption {
-	return func(m *PrintingImageMutation) {
+// withRulingID sets the ID field of the mutation.
+func withRulingID(id int) rulingOption {
+	return func(m *RulingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PrintingImage
+			value *Ruling
 		)
-		m.oldValue = func(ctx context.Context) (*PrintingImage, error) {
+		m.oldValue = func(ctx context.Context) (*Ruling, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PrintingImage.Get(ctx, id)
+					value, err = m.Client().Ruling.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2812,10 +6045,10 @@ func withPrintingImageID(id int) printingimageOption {
 	}
 }
 
-// withPrintingImage sets the old PrintingImage of the mutation.
-func withPrintingImage(node *PrintingImage) printingimageOption {
-	return func(m *PrintingImageMutation) {
-		m.oldValue = func(context.Context) (*PrintingImage, error) {
+// withRuling sets the old Ruling of the mutation.
+func withRuling(node *Ruling) rulingOption {
+	return func(m *RulingMutation) {
+		m.oldValue = func(context.Context) (*Ruling, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2824,7 +6057,7 @@ func withPrintingImage(node *PrintingImage) printingimageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PrintingImageMutation) Client() *Client {
+func (m RulingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2832,7 +6065,7 @@ func (m PrintingImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PrintingImageMutation) Tx() (*Tx, error) {
+func (m RulingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
@@ -2843,7 +6076,7 @@ func (m PrintingImageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PrintingImageMutation) ID() (id int, exists bool) {
+func (m *RulingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2854,7 +6087,7 @@ func (m *PrintingImageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PrintingImageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RulingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2863,181 +6096,168 @@ func (m *PrintingImageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PrintingImage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Ruling.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetURL sets the "url" field.
-func (m *PrintingImageMutation) SetURL(s string) {
-	m.url = &s
+// SetText sets the "text" field.
+func (m *RulingMutation) SetText(s string) {
+	m.text = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *PrintingImageMutation) URL() (r string, exists bool) {
-	v := m.url
+// Text returns the value of the "text" field in the mutation.
+func (m *RulingMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the PrintingImage entity.
-// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the Ruling entity.
+// If the Ruling object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrintingImageMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *RulingMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Text, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *PrintingImageMutation) ResetURL() {
-	m.url = nil
+// ResetText resets all changes to the "text" field.
+func (m *RulingMutation) ResetText() {
+	m.text = nil
 }
 
-// SetImageType sets the "image_type" field.
-func (m *PrintingImageMutation) SetImageType(pt printingimage.ImageType) {
-	m.image_type = &pt
+// SetDate sets the "date" field.
+func (m *RulingMutation) SetDate(t time.Time) {
+	m.date = &t
 }
 
-// ImageType returns the value of the "image_type" field in the mutation.
-func (m *PrintingImageMutation) ImageType() (r printingimage.ImageType, exists bool) {
-	v := m.image_type
+// Date returns the value of the "date" field in the mutation.
+func (m *RulingMutation) Date() (r time.Time, exists bool) {
+	v := m.date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImageType returns the old "image_type" field's value of the PrintingImage entity.
-// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// OldDate returns the old "date" field's value of the Ruling entity.
+// If the Ruling object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrintingImageMutation) OldImageType(ctx context.Context) (v printingimage.ImageType, err error) {
+func (m *RulingMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageType requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.ImageType, nil
+	return oldValue.Date, nil
 }
 
-// ResetImageType resets all changes to the "image_type" field.
-func (m *PrintingImageMutation) ResetImageType() {
-	m.image_type = nil
+// ResetDate resets all changes to the "date" field.
+func (m *RulingMutation) ResetDate() {
+	m.date = nil
 }
 
-// SetLocalPath sets the "local_path" field.
-func (m *PrintingImageMutation) SetLocalPath(s string) {
-	m.local_path = &s
+// SetSource sets the "source" field.
+func (m *RulingMutation) SetSource(r ruling.Source) {
+	m.source = &r
 }
 
-// LocalPath returns the value of the "local_path" field in the mutation.
-func (m *PrintingImageMutation) LocalPath() (r string, exists bool) {
-	v := m.local_path
+// Source returns the value of the "source" field in the mutation.
+func (m *RulingMutation) Source() (r ruling.Source, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocalPath returns the old "local_path" field's value of the PrintingImage entity.
-// If the PrintingImage object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the Ruling entity.
+// If the Ruling object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrintingImageMutation) OldLocalPath(ctx context.Context) (v string, err error) {
+func (m *RulingMutation) OldSource(ctx context.Context) (v ruling.Source, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocalPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocalPath requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocalPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.LocalPath, nil
-}
-
-// ClearLocalPath clears the value of the "local_path" field.
-func (m *PrintingImageMutation) ClearLocalPath() {
-	m.local_path = nil
-	m.clearedFields[printingimage.FieldLocalPath] = struct{}{}
-}
-
-// LocalPathCleared returns if the "local_path" field was cleared in this mutation.
-func (m *PrintingImageMutation) LocalPathCleared() bool {
-	_, ok := m.clearedFields[printingimage.FieldLocalPath]
-	return ok
+	return oldValue.Source, nil
 }
 
-// ResetLocalPath resets all changes to the "local_path" field.
-func (m *PrintingImageMutation) ResetLocalPath() {
-	m.local_path = nil
-	delete(m.clearedFields, printingimage.FieldLocalPath)
+// ResetSource resets all changes to the "source" field.
+func (m *RulingMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetPrintingID sets the "printing" edge to the Printing entity by id.
-func (m *PrintingImageMutation) SetPrintingID(id int) {
-	m.printing = &id
+// SetCardID sets the "card" edge to the Card entity by id.
+func (m *RulingMutation) SetCardID(id int) {
+	m.card = &id
 }
 
-// ClearPrinting clears the "printing" edge to the Printing entity.
-func (m *PrintingImageMutation) ClearPrinting() {
-	m.clearedprinting = true
+// ClearCard clears the "card" edge to the Card entity.
+func (m *RulingMutation) ClearCard() {
+	m.clearedcard = true
 }
 
-// PrintingCleared reports if the "printing" edge to the Printing entity was cleared.
-func (m *PrintingImageMutation) PrintingCleared() bool {
-	return m.clearedprinting
+// CardCleared reports if the "card" edge to the Card entity was cleared.
+func (m *RulingMutation) CardCleared() bool {
+	return m.clearedcard
 }
 
-// PrintingID returns the "printing" edge ID in the mutation.
-func (m *PrintingImageMutation) PrintingID() (id int, exists bool) {
-	if m.printing != nil {
-		return *m.printing, true
+// CardID returns the "card" edge ID in the mutation.
+func (m *RulingMutation) CardID() (id int, exists bool) {
+	if m.card != nil {
+		return *m.card, true
 	}
 	return
 }
 
-// PrintingIDs returns the "printing" edge IDs in the mutation.
+// CardIDs returns the "card" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PrintingID instead. It exists only for internal usage by the builders.
-func (m *PrintingImageMutation) PrintingIDs() (ids []int) {
-	if id := m.printing; id != nil {
+// CardID instead. It exists only for internal usage by the builders.
+func (m *RulingMutation) CardIDs() (ids []int) {
+	if id := m.card; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPrinting resets all changes to the "printing" edge.
-func (m *PrintingImageMutation) ResetPrinting() {
-	m.printing = nil
-	m.clearedprinting = false
+// ResetCard resets all changes to the "card" edge.
+func (m *RulingMutation) ResetCard() {
+	m.card = nil
+	m.clearedcard = false
 }
 
-// Where appends a list predicates to the PrintingImageMutation builder.
-func (m *PrintingImageMutation) Where(ps ...predicate.PrintingImage) {
+// Where appends a list predicates to the RulingMutation builder.
+func (m *RulingMutation) Where(ps ...predicate.Ruling) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PrintingImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RulingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PrintingImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PrintingImage, len(ps))
+func (m *RulingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ruling, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3045,33 +6265,33 @@ func (m *PrintingImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PrintingImageMutation) Op() Op {
+func (m *RulingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PrintingImageMutation) SetOp(op Op) {
+func (m *RulingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PrintingImage).
-func (m *PrintingImageMutation) Type() string {
+// Type returns the node type of this mutation (Ruling).
+func (m *RulingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PrintingImageMutation) Fields() []string {
+func (m *RulingMutation) Fields() []string {
 	fields := make([]string, 0, 3)
-	if m.url != nil {
-		fields = append(fields, printingimage.FieldURL)
+	if m.text != nil {
+		fields = append(fields, ruling.FieldText)
 	}
-	if m.image_type != nil {
-		fields = append(fields, printingimage.FieldImageType)
+	if m.date != nil {
+		fields = append(fields, ruling.FieldDate)
 	}
-	if m.local_path != nil {
-		fields = append(fields, printingimage.FieldLocalPath)
+	if m.source != nil {
+		fields = append(fields, ruling.FieldSource)
 	}
 	return fields
 }
@@ -3079,14 +6299,14 @@ func (m *PrintingImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PrintingImageMutation) Field(name string) (ent.Value, bool) {
+func (m *RulingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case printingimage.FieldURL:
-		return m.URL()
-	case printingimage.FieldImageType:
-		return m.ImageType()
-	case printingimage.FieldLocalPath:
-		return m.LocalPath()
+	case ruling.FieldText:
+		return m.Text()
+	case ruling.FieldDate:
+		return m.Date()
+	case ruling.FieldSource:
+		return m.Source()
 	}
 	return nil, false
 }
@@ -3094,130 +6314,121 @@ func (m *PrintingImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PrintingImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RulingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case printingimage.FieldURL:
-		return m.OldURL(ctx)
-	case printingimage.FieldImageType:
-		return m.OldImageType(ctx)
-	case printingimage.FieldLocalPath:
-		return m.OldLocalPath(ctx)
+	case ruling.FieldText:
+		return m.OldText(ctx)
+	case ruling.FieldDate:
+		return m.OldDate(ctx)
+	case ruling.FieldSource:
+		return m.OldSource(ctx)
 	}
-	return nil, fmt.Errorf("unknown PrintingImage field %s", name)
+	return nil, fmt.Errorf("unknown Ruling field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrintingImageMutation) SetField(name string, value ent.Value) error {
+func (m *RulingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case printingimage.FieldURL:
+	case ruling.FieldText:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetText(v)
 		return nil
-	case printingimage.FieldImageType:
-		v, ok := value.(printingimage.ImageType)
+	case ruling.FieldDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageType(v)
+		m.SetDate(v)
 		return nil
-	case printingimage.FieldLocalPath:
-		v, ok := value.(string)
+	case ruling.FieldSource:
+		v, ok := value.(ruling.Source)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocalPath(v)
+		m.SetSource(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PrintingImage field %s", name)
+	return fmt.Errorf("unknown Ruling field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PrintingImageMutation) AddedFields() []string {
+func (m *RulingMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PrintingImageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RulingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrintingImageMutation) AddField(name string, value ent.Value) error {
+func (m *RulingMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PrintingImage numeric field %s", name)
+	return fmt.Errorf("unknown Ruling numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PrintingImageMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(printingimage.FieldLocalPath) {
-		fields = append(fields, printingimage.FieldLocalPath)
-	}
-	return fields
+func (m *RulingMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PrintingImageMutation) FieldCleared(name string) bool {
+func (m *RulingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PrintingImageMutation) ClearField(name string) error {
-	switch name {
-	case printingimage.FieldLocalPath:
-		m.ClearLocalPath()
-		return nil
-	}
-	return fmt.Errorf("unknown PrintingImage nullable field %s", name)
+func (m *RulingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Ruling nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PrintingImageMutation) ResetField(name string) error {
+func (m *RulingMutation) ResetField(name string) error {
 	switch name {
-	case printingimage.FieldURL:
-		m.ResetURL()
+	case ruling.FieldText:
+		m.ResetText()
 		return nil
-	case printingimage.FieldImageType:
-		m.ResetImageType()
+	case ruling.FieldDate:
+		m.ResetDate()
 		return nil
-	case printingimage.FieldLocalPath:
-		m.ResetLocalPath()
+	case ruling.FieldSource:
+		m.ResetSource()
 		return nil
 	}
-	return fmt.Errorf("unknown PrintingImage field %s", name)
+	return fmt.Errorf("unknown Ruling field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PrintingImageMutation) AddedEdges() []string {
+func (m *RulingMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.printing != nil {
-		edges = append(edges, printingimage.EdgePrinting)
+	if m.card != nil {
+		edges = append(edges, ruling.EdgeCard)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PrintingImageMutation) AddedIDs(name string) []ent.Value {
+func (m *RulingMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case printingimage.EdgePrinting:
-		if id := m.printing; id != nil {
+	case ruling.EdgeCard:
+		if id := m.card; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3225,85 +6436,96 @@ func (m *PrintingImageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PrintingImageMutation) RemovedEdges() []string {
+func (m *RulingMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PrintingImageMutation) RemovedIDs(name string) []ent.Value {
+func (m *RulingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PrintingImageMutation) ClearedEdges() []string {
+func (m *RulingMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedprinting {
-		edges = append(edges, printingimage.EdgePrinting)
+	if m.clearedcard {
+		edges = append(edges, ruling.EdgeCard)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PrintingImageMutation) EdgeCleared(name string) bool {
+func (m *RulingMutation) EdgeCleared(name string) bool {
 	switch name {
-	case printingimage.EdgePrinting:
-		return m.clearedprinting
+	case ruling.EdgeCard:
+		return m.clearedcard
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PrintingImageMutation) ClearEdge(name string) error {
+func (m *RulingMutation) ClearEdge(name string) error {
 	switch name {
-	case printingimage.EdgePrinting:
-		m.ClearPrinting()
+	case ruling.EdgeCard:
+		m.ClearCard()
 		return nil
 	}
-	return fmt.Errorf("unknown PrintingImage unique edge %s", name)
+	return fmt.Errorf("unknown Ruling unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PrintingImageMutation) ResetEdge(name string) error {
+func (m *RulingMutation) ResetEdge(name string) error {
 	switch name {
-	case printingimage.EdgePrinting:
-		m.ResetPrinting()
+	case ruling.EdgeCard:
+		m.ResetCard()
 		return nil
 	}
-	return fmt.Errorf("unknown PrintingImage edge %s", name)
+	return fmt.Errorf("unknown Ruling edge %s", name)
 }
 
-// RulingMutation represents an operation that mutates the Ruling nodes in the graph.
-type RulingMutation struct {
+// SetMutation represents an operation that mutates the Set nodes in the graph.
+type SetMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	text          *string
-	date          *time.Time
-	clearedFields map[string]struct{}
-	card          *int
-	clearedcard   bool
-	done          bool
-	oldValue      func(context.Context) (*Ruling, error)
-	predicates    []predicate.Ruling
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	code             *string
+	scryfall_id      *string
+	set_type         *string
+	released_at      *time.Time
+	card_count       *int
+	addcard_count    *int
+	parent_set_code  *string
+	block            *string
+	block_code       *string
+	digital          *bool
+	icon_svg_uri     *string
+	clearedFields    map[string]struct{}
+	printings        map[int]struct{}
+	removedprintings map[int]struct{}
+	clearedprintings bool
+	done             bool
+	oldValue         func(context.Context) (*Set, error)
+	predicates       []predicate.Set
 }
 
-var _ ent.Mutation = (*RulingMutation)(nil)
+var _ ent.Mutation = (*SetMutation)(nil)
 
-// rulingOption allows management of the mutation configuration using functional options.
-type rulingOption func(*RulingMutation)
+// setOption allows management of the mutation configuration using functional options.
+type setOption func(*SetMutation)
 
-// newRulingMutation creates new mutation for the Ruling entity.
-func newRulingMutation(c config, op Op, opts ...rulingOption) *RulingMutation {
-	m := &RulingMutation{
+// newSetMutation creates new mutation for the Set entity.
+func newSetMutation(c config, op Op, opts ...setOption) *SetMutation {
+	m := &SetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRuling,
+		typ:           TypeSet,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3312,20 +6534,20 @@ func newRulingMutation(c config, op Op, opts ...rulingOption) *RulingMutation {
 	return m
 }
 
-// withRulingID sets the ID field of the mutation.
-func withRulingID(id int) rulingOption {
-	return func(m *RulingMutation) {
+// withSetID sets the ID field of the mutation.
+func withSetID(id int) setOption {
+	return func(m *SetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Ruling
+			value *Set
 		)
-		m.oldValue = func(ctx context.Context) (*Ruling, error) {
+		m.oldValue = func(ctx context.Context) (*Set, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Ruling.Get(ctx, id)
+					value, err = m.Client().Set.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3334,10 +6556,10 @@ func withRulingID(id int) rulingOption {
 	}
 }
 
-// withRuling sets the old Ruling of the mutation.
-func withRuling(node *Ruling) rulingOption {
-	return func(m *RulingMutation) {
-		m.oldValue = func(context.Context) (*Ruling, error) {
+// withSet sets the old Set of the mutation.
+func withSet(node *Set) setOption {
+	return func(m *SetMutation) {
+		m.oldValue = func(context.Context) (*Set, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3346,7 +6568,7 @@ func withRuling(node *Ruling) rulingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RulingMutation) Client() *Client {
+func (m SetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3354,7 +6576,7 @@ func (m RulingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RulingMutation) Tx() (*Tx, error) {
+func (m SetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("bones: mutation is not running in a transaction")
 	}
@@ -3365,7 +6587,7 @@ func (m RulingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RulingMutation) ID() (id int, exists bool) {
+func (m *SetMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3376,7 +6598,7 @@ func (m *RulingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RulingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SetMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3385,530 +6607,517 @@ func (m *RulingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Ruling.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Set.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetText sets the "text" field.
-func (m *RulingMutation) SetText(s string) {
-	m.text = &s
+// SetName sets the "name" field.
+func (m *SetMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *RulingMutation) Text() (r string, exists bool) {
-	v := m.text
+// Name returns the value of the "name" field in the mutation.
+func (m *SetMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the Ruling entity.
-// If the Ruling object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RulingMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *SetMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Name, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *RulingMutation) ResetText() {
-	m.text = nil
+// ResetName resets all changes to the "name" field.
+func (m *SetMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDate sets the "date" field.
-func (m *RulingMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetCode sets the "code" field.
+func (m *SetMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *RulingMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// Code returns the value of the "code" field in the mutation.
+func (m *SetMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Ruling entity.
-// If the Ruling object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RulingMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *SetMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *SetMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetScryfallID sets the "scryfall_id" field.
+func (m *SetMutation) SetScryfallID(s string) {
+	m.scryfall_id = &s
+}
+
+// ScryfallID returns the value of the "scryfall_id" field in the mutation.
+func (m *SetMutation) ScryfallID() (r string, exists bool) {
+	v := m.scryfall_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScryfallID returns the old "scryfall_id" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldScryfallID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldScryfallID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldScryfallID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldScryfallID: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.ScryfallID, nil
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *RulingMutation) ResetDate() {
-	m.date = nil
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (m *SetMutation) ClearScryfallID() {
+	m.scryfall_id = nil
+	m.clearedFields[set.FieldScryfallID] = struct{}{}
 }
 
-// SetCardID sets the "card" edge to the Card entity by id.
-func (m *RulingMutation) SetCardID(id int) {
-	m.card = &id
+// ScryfallIDCleared returns if the "scryfall_id" field was cleared in this mutation.
+func (m *SetMutation) ScryfallIDCleared() bool {
+	_, ok := m.clearedFields[set.FieldScryfallID]
+	return ok
 }
 
-// ClearCard clears the "card" edge to the Card entity.
-func (m *RulingMutation) ClearCard() {
-	m.clearedcard = true
+// ResetScryfallID resets all changes to the "scryfall_id" field.
+func (m *SetMutation) ResetScryfallID() {
+	m.scryfall_id = nil
+	delete(m.clearedFields, set.FieldScryfallID)
 }
 
-// CardCleared reports if the "card" edge to the Card entity was cleared.
-func (m *RulingMutation) CardCleared() bool {
-	return m.clearedcard
+// SetSetType sets the "set_type" field.
+func (m *SetMutation) SetSetType(s string) {
+	m.set_type = &s
 }
 
-// CardID returns the "card" edge ID in the mutation.
-func (m *RulingMutation) CardID() (id int, exists bool) {
-	if m.card != nil {
-		return *m.card, true
+// SetType returns the value of the "set_type" field in the mutation.
+func (m *SetMutation) SetType() (r string, exists bool) {
+	v := m.set_type
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CardIDs returns the "card" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CardID instead. It exists only for internal usage by the builders.
-func (m *RulingMutation) CardIDs() (ids []int) {
-	if id := m.card; id != nil {
-		ids = append(ids, *id)
+// OldSetType returns the old "set_type" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldSetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSetType is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetCard resets all changes to the "card" edge.
-func (m *RulingMutation) ResetCard() {
-	m.card = nil
-	m.clearedcard = false
-}
-
-// Where appends a list predicates to the RulingMutation builder.
-func (m *RulingMutation) Where(ps ...predicate.Ruling) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the RulingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RulingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Ruling, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSetType requires an ID field in the mutation")
 	}
-	m.Where(p...)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSetType: %w", err)
+	}
+	return oldValue.SetType, nil
 }
 
-// Op returns the operation name.
-func (m *RulingMutation) Op() Op {
-	return m.op
+// ClearSetType clears the value of the "set_type" field.
+func (m *SetMutation) ClearSetType() {
+	m.set_type = nil
+	m.clearedFields[set.FieldSetType] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RulingMutation) SetOp(op Op) {
-	m.op = op
+// SetTypeCleared returns if the "set_type" field was cleared in this mutation.
+func (m *SetMutation) SetTypeCleared() bool {
+	_, ok := m.clearedFields[set.FieldSetType]
+	return ok
 }
 
-// Type returns the node type of this mutation (Ruling).
-func (m *RulingMutation) Type() string {
-	return m.typ
+// ResetSetType resets all changes to the "set_type" field.
+func (m *SetMutation) ResetSetType() {
+	m.set_type = nil
+	delete(m.clearedFields, set.FieldSetType)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RulingMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.text != nil {
-		fields = append(fields, ruling.FieldText)
-	}
-	if m.date != nil {
-		fields = append(fields, ruling.FieldDate)
-	}
-	return fields
+// SetReleasedAt sets the "released_at" field.
+func (m *SetMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RulingMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case ruling.FieldText:
-		return m.Text()
-	case ruling.FieldDate:
-		return m.Date()
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *SetMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RulingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case ruling.FieldText:
-		return m.OldText(ctx)
-	case ruling.FieldDate:
-		return m.OldDate(ctx)
+// OldReleasedAt returns the old "released_at" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Ruling field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RulingMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case ruling.FieldText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetText(v)
-		return nil
-	case ruling.FieldDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDate(v)
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Ruling field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RulingMutation) AddedFields() []string {
-	return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RulingMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *SetMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[set.FieldReleasedAt] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RulingMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Ruling numeric field %s", name)
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *SetMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[set.FieldReleasedAt]
+	return ok
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RulingMutation) ClearedFields() []string {
-	return nil
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *SetMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, set.FieldReleasedAt)
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RulingMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetCardCount sets the "card_count" field.
+func (m *SetMutation) SetCardCount(i int) {
+	m.card_count = &i
+	m.addcard_count = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RulingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Ruling nullable field %s", name)
+// CardCount returns the value of the "card_count" field in the mutation.
+func (m *SetMutation) CardCount() (r int, exists bool) {
+	v := m.card_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RulingMutation) ResetField(name string) error {
-	switch name {
-	case ruling.FieldText:
-		m.ResetText()
-		return nil
-	case ruling.FieldDate:
-		m.ResetDate()
-		return nil
+// OldCardCount returns the old "card_count" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldCardCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardCount is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Ruling field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardCount: %w", err)
+	}
+	return oldValue.CardCount, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RulingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.card != nil {
-		edges = append(edges, ruling.EdgeCard)
+// AddCardCount adds i to the "card_count" field.
+func (m *SetMutation) AddCardCount(i int) {
+	if m.addcard_count != nil {
+		*m.addcard_count += i
+	} else {
+		m.addcard_count = &i
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RulingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case ruling.EdgeCard:
-		if id := m.card; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedCardCount returns the value that was added to the "card_count" field in this mutation.
+func (m *SetMutation) AddedCardCount() (r int, exists bool) {
+	v := m.addcard_count
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RulingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetCardCount resets all changes to the "card_count" field.
+func (m *SetMutation) ResetCardCount() {
+	m.card_count = nil
+	m.addcard_count = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RulingMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetParentSetCode sets the "parent_set_code" field.
+func (m *SetMutation) SetParentSetCode(s string) {
+	m.parent_set_code = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RulingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcard {
-		edges = append(edges, ruling.EdgeCard)
+// ParentSetCode returns the value of the "parent_set_code" field in the mutation.
+func (m *SetMutation) ParentSetCode() (r string, exists bool) {
+	v := m.parent_set_code
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RulingMutation) EdgeCleared(name string) bool {
-	switch name {
-	case ruling.EdgeCard:
-		return m.clearedcard
+// OldParentSetCode returns the old "parent_set_code" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldParentSetCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentSetCode is only allowed on UpdateOne operations")
 	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RulingMutation) ClearEdge(name string) error {
-	switch name {
-	case ruling.EdgeCard:
-		m.ClearCard()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentSetCode requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Ruling unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RulingMutation) ResetEdge(name string) error {
-	switch name {
-	case ruling.EdgeCard:
-		m.ResetCard()
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentSetCode: %w", err)
 	}
-	return fmt.Errorf("unknown Ruling edge %s", name)
+	return oldValue.ParentSetCode, nil
 }
 
-// SetMutation represents an operation that mutates the Set nodes in the graph.
-type SetMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	name             *string
-	code             *string
-	clearedFields    map[string]struct{}
-	printings        map[int]struct{}
-	removedprintings map[int]struct{}
-	clearedprintings bool
-	done             bool
-	oldValue         func(context.Context) (*Set, error)
-	predicates       []predicate.Set
+// ClearParentSetCode clears the value of the "parent_set_code" field.
+func (m *SetMutation) ClearParentSetCode() {
+	m.parent_set_code = nil
+	m.clearedFields[set.FieldParentSetCode] = struct{}{}
 }
 
-var _ ent.Mutation = (*SetMutation)(nil)
+// ParentSetCodeCleared returns if the "parent_set_code" field was cleared in this mutation.
+func (m *SetMutation) ParentSetCodeCleared() bool {
+	_, ok := m.clearedFields[set.FieldParentSetCode]
+	return ok
+}
 
-// setOption allows management of the mutation configuration using functional options.
-type setOption func(*SetMutation)
+// ResetParentSetCode resets all changes to the "parent_set_code" field.
+func (m *SetMutation) ResetParentSetCode() {
+	m.parent_set_code = nil
+	delete(m.clearedFields, set.FieldParentSetCode)
+}
 
-// newSetMutation creates new mutation for the Set entity.
-func newSetMutation(c config, op Op, opts ...setOption) *SetMutation {
-	m := &SetMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSet,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetBlock sets the "block" field.
+func (m *SetMutation) SetBlock(s string) {
+	m.block = &s
 }
 
-// withSetID sets the ID field of the mutation.
-func withSetID(id int) setOption {
-	return func(m *SetMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Set
-		)
-		m.oldValue = func(ctx context.Context) (*Set, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Set.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Block returns the value of the "block" field in the mutation.
+func (m *SetMutation) Block() (r string, exists bool) {
+	v := m.block
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withSet sets the old Set of the mutation.
-func withSet(node *Set) setOption {
-	return func(m *SetMutation) {
-		m.oldValue = func(context.Context) (*Set, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldBlock returns the old "block" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldBlock(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlock requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlock: %w", err)
+	}
+	return oldValue.Block, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SetMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearBlock clears the value of the "block" field.
+func (m *SetMutation) ClearBlock() {
+	m.block = nil
+	m.clearedFields[set.FieldBlock] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SetMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("bones: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// BlockCleared returns if the "block" field was cleared in this mutation.
+func (m *SetMutation) BlockCleared() bool {
+	_, ok := m.clearedFields[set.FieldBlock]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SetMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// ResetBlock resets all changes to the "block" field.
+func (m *SetMutation) ResetBlock() {
+	m.block = nil
+	delete(m.clearedFields, set.FieldBlock)
+}
+
+// SetBlockCode sets the "block_code" field.
+func (m *SetMutation) SetBlockCode(s string) {
+	m.block_code = &s
+}
+
+// BlockCode returns the value of the "block_code" field in the mutation.
+func (m *SetMutation) BlockCode() (r string, exists bool) {
+	v := m.block_code
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SetMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Set.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldBlockCode returns the old "block_code" field's value of the Set entity.
+// If the Set object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SetMutation) OldBlockCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockCode: %w", err)
 	}
+	return oldValue.BlockCode, nil
 }
 
-// SetName sets the "name" field.
-func (m *SetMutation) SetName(s string) {
-	m.name = &s
+// ClearBlockCode clears the value of the "block_code" field.
+func (m *SetMutation) ClearBlockCode() {
+	m.block_code = nil
+	m.clearedFields[set.FieldBlockCode] = struct{}{}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SetMutation) Name() (r string, exists bool) {
-	v := m.name
+// BlockCodeCleared returns if the "block_code" field was cleared in this mutation.
+func (m *SetMutation) BlockCodeCleared() bool {
+	_, ok := m.clearedFields[set.FieldBlockCode]
+	return ok
+}
+
+// ResetBlockCode resets all changes to the "block_code" field.
+func (m *SetMutation) ResetBlockCode() {
+	m.block_code = nil
+	delete(m.clearedFields, set.FieldBlockCode)
+}
+
+// SetDigital sets the "digital" field.
+func (m *SetMutation) SetDigital(b bool) {
+	m.digital = &b
+}
+
+// Digital returns the value of the "digital" field in the mutation.
+func (m *SetMutation) Digital() (r bool, exists bool) {
+	v := m.digital
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Set entity.
+// OldDigital returns the old "digital" field's value of the Set entity.
 // If the Set object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SetMutation) OldDigital(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDigital is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDigital requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDigital: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Digital, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SetMutation) ResetName() {
-	m.name = nil
+// ResetDigital resets all changes to the "digital" field.
+func (m *SetMutation) ResetDigital() {
+	m.digital = nil
 }
 
-// SetCode sets the "code" field.
-func (m *SetMutation) SetCode(s string) {
-	m.code = &s
+// SetIconSvgURI sets the "icon_svg_uri" field.
+func (m *SetMutation) SetIconSvgURI(s string) {
+	m.icon_svg_uri = &s
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *SetMutation) Code() (r string, exists bool) {
-	v := m.code
+// IconSvgURI returns the value of the "icon_svg_uri" field in the mutation.
+func (m *SetMutation) IconSvgURI() (r string, exists bool) {
+	v := m.icon_svg_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Set entity.
+// OldIconSvgURI returns the old "icon_svg_uri" field's value of the Set entity.
 // If the Set object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SetMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *SetMutation) OldIconSvgURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldIconSvgURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldIconSvgURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldIconSvgURI: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.IconSvgURI, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *SetMutation) ResetCode() {
-	m.code = nil
+// ClearIconSvgURI clears the value of the "icon_svg_uri" field.
+func (m *SetMutation) ClearIconSvgURI() {
+	m.icon_svg_uri = nil
+	m.clearedFields[set.FieldIconSvgURI] = struct{}{}
+}
+
+// IconSvgURICleared returns if the "icon_svg_uri" field was cleared in this mutation.
+func (m *SetMutation) IconSvgURICleared() bool {
+	_, ok := m.clearedFields[set.FieldIconSvgURI]
+	return ok
+}
+
+// ResetIconSvgURI resets all changes to the "icon_svg_uri" field.
+func (m *SetMutation) ResetIconSvgURI() {
+	m.icon_svg_uri = nil
+	delete(m.clearedFields, set.FieldIconSvgURI)
 }
 
 // AddPrintingIDs adds the "printings" edge to the Printing entity by ids.
@@ -3999,13 +7208,40 @@ func (m *SetMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SetMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 11)
 	if m.name != nil {
 		fields = append(fields, set.FieldName)
 	}
 	if m.code != nil {
 		fields = append(fields, set.FieldCode)
 	}
+	if m.scryfall_id != nil {
+		fields = append(fields, set.FieldScryfallID)
+	}
+	if m.set_type != nil {
+		fields = append(fields, set.FieldSetType)
+	}
+	if m.released_at != nil {
+		fields = append(fields, set.FieldReleasedAt)
+	}
+	if m.card_count != nil {
+		fields = append(fields, set.FieldCardCount)
+	}
+	if m.parent_set_code != nil {
+		fields = append(fields, set.FieldParentSetCode)
+	}
+	if m.block != nil {
+		fields = append(fields, set.FieldBlock)
+	}
+	if m.block_code != nil {
+		fields = append(fields, set.FieldBlockCode)
+	}
+	if m.digital != nil {
+		fields = append(fields, set.FieldDigital)
+	}
+	if m.icon_svg_uri != nil {
+		fields = append(fields, set.FieldIconSvgURI)
+	}
 	return fields
 }
 
@@ -4018,6 +7254,24 @@ func (m *SetMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case set.FieldCode:
 		return m.Code()
+	case set.FieldScryfallID:
+		return m.ScryfallID()
+	case set.FieldSetType:
+		return m.SetType()
+	case set.FieldReleasedAt:
+		return m.ReleasedAt()
+	case set.FieldCardCount:
+		return m.CardCount()
+	case set.FieldParentSetCode:
+		return m.ParentSetCode()
+	case set.FieldBlock:
+		return m.Block()
+	case set.FieldBlockCode:
+		return m.BlockCode()
+	case set.FieldDigital:
+		return m.Digital()
+	case set.FieldIconSvgURI:
+		return m.IconSvgURI()
 	}
 	return nil, false
 }
@@ -4031,6 +7285,24 @@ func (m *SetMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldName(ctx)
 	case set.FieldCode:
 		return m.OldCode(ctx)
+	case set.FieldScryfallID:
+		return m.OldScryfallID(ctx)
+	case set.FieldSetType:
+		return m.OldSetType(ctx)
+	case set.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case set.FieldCardCount:
+		return m.OldCardCount(ctx)
+	case set.FieldParentSetCode:
+		return m.OldParentSetCode(ctx)
+	case set.FieldBlock:
+		return m.OldBlock(ctx)
+	case set.FieldBlockCode:
+		return m.OldBlockCode(ctx)
+	case set.FieldDigital:
+		return m.OldDigital(ctx)
+	case set.FieldIconSvgURI:
+		return m.OldIconSvgURI(ctx)
 	}
 	return nil, fmt.Errorf("unknown Set field %s", name)
 }
@@ -4054,6 +7326,69 @@ func (m *SetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCode(v)
 		return nil
+	case set.FieldScryfallID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScryfallID(v)
+		return nil
+	case set.FieldSetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSetType(v)
+		return nil
+	case set.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	case set.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardCount(v)
+		return nil
+	case set.FieldParentSetCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentSetCode(v)
+		return nil
+	case set.FieldBlock:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlock(v)
+		return nil
+	case set.FieldBlockCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockCode(v)
+		return nil
+	case set.FieldDigital:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDigital(v)
+		return nil
+	case set.FieldIconSvgURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconSvgURI(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Set field %s", name)
 }
@@ -4061,13 +7396,21 @@ func (m *SetMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *SetMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addcard_count != nil {
+		fields = append(fields, set.FieldCardCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *SetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case set.FieldCardCount:
+		return m.AddedCardCount()
+	}
 	return nil, false
 }
 
@@ -4076,6 +7419,13 @@ func (m *SetMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *SetMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case set.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCardCount(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Set numeric field %s", name)
 }
@@ -4083,7 +7433,29 @@ func (m *SetMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *SetMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(set.FieldScryfallID) {
+		fields = append(fields, set.FieldScryfallID)
+	}
+	if m.FieldCleared(set.FieldSetType) {
+		fields = append(fields, set.FieldSetType)
+	}
+	if m.FieldCleared(set.FieldReleasedAt) {
+		fields = append(fields, set.FieldReleasedAt)
+	}
+	if m.FieldCleared(set.FieldParentSetCode) {
+		fields = append(fields, set.FieldParentSetCode)
+	}
+	if m.FieldCleared(set.FieldBlock) {
+		fields = append(fields, set.FieldBlock)
+	}
+	if m.FieldCleared(set.FieldBlockCode) {
+		fields = append(fields, set.FieldBlockCode)
+	}
+	if m.FieldCleared(set.FieldIconSvgURI) {
+		fields = append(fields, set.FieldIconSvgURI)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -4096,6 +7468,29 @@ func (m *SetMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *SetMutation) ClearField(name string) error {
+	switch name {
+	case set.FieldScryfallID:
+		m.ClearScryfallID()
+		return nil
+	case set.FieldSetType:
+		m.ClearSetType()
+		return nil
+	case set.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	case set.FieldParentSetCode:
+		m.ClearParentSetCode()
+		return nil
+	case set.FieldBlock:
+		m.ClearBlock()
+		return nil
+	case set.FieldBlockCode:
+		m.ClearBlockCode()
+		return nil
+	case set.FieldIconSvgURI:
+		m.ClearIconSvgURI()
+		return nil
+	}
 	return fmt.Errorf("unknown Set nullable field %s", name)
 }
 
@@ -4109,6 +7504,33 @@ func (m *SetMutation) ResetField(name string) error {
 	case set.FieldCode:
 		m.ResetCode()
 		return nil
+	case set.FieldScryfallID:
+		m.ResetScryfallID()
+		return nil
+	case set.FieldSetType:
+		m.ResetSetType()
+		return nil
+	case set.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	case set.FieldCardCount:
+		m.ResetCardCount()
+		return nil
+	case set.FieldParentSetCode:
+		m.ResetParentSetCode()
+		return nil
+	case set.FieldBlock:
+		m.ResetBlock()
+		return nil
+	case set.FieldBlockCode:
+		m.ResetBlockCode()
+		return nil
+	case set.FieldDigital:
+		m.ResetDigital()
+		return nil
+	case set.FieldIconSvgURI:
+		m.ResetIconSvgURI()
+		return nil
 	}
 	return fmt.Errorf("unknown Set field %s", name)
 }
@@ -9,12 +9,18 @@ import (
 // Artist is the predicate function for artist builders.
 type Artist func(*sql.Selector)
 
+// BulkImport is the predicate function for bulkimport builders.
+type BulkImport func(*sql.Selector)
+
 // Card is the predicate function for card builders.
 type Card func(*sql.Selector)
 
 // CardFace is the predicate function for cardface builders.
 type CardFace func(*sql.Selector)
 
+// Legality is the predicate function for legality builders.
+type Legality func(*sql.Selector)
+
 // Printing is the predicate function for printing builders.
 type Printing func(*sql.Selector)
 
@@ -3,8 +3,10 @@
 package bones
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +23,34 @@ type Printing struct {
 	ID int `json:"id,omitempty"`
 	// Rarity holds the value of the "rarity" field.
 	Rarity printing.Rarity `json:"rarity,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// IllustrationID holds the value of the "illustration_id" field.
+	IllustrationID string `json:"illustration_id,omitempty"`
+	// ScryfallID holds the value of the "scryfall_id" field.
+	ScryfallID string `json:"scryfall_id,omitempty"`
+	// CollectorNumber holds the value of the "collector_number" field.
+	CollectorNumber string `json:"collector_number,omitempty"`
+	// MultiverseIds holds the value of the "multiverse_ids" field.
+	MultiverseIds []int `json:"multiverse_ids,omitempty"`
+	// MtgoID holds the value of the "mtgo_id" field.
+	MtgoID *int `json:"mtgo_id,omitempty"`
+	// ArenaID holds the value of the "arena_id" field.
+	ArenaID *int `json:"arena_id,omitempty"`
+	// TcgplayerID holds the value of the "tcgplayer_id" field.
+	TcgplayerID *int `json:"tcgplayer_id,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// PrintedName holds the value of the "printed_name" field.
+	PrintedName string `json:"printed_name,omitempty"`
+	// PrintedText holds the value of the "printed_text" field.
+	PrintedText string `json:"printed_text,omitempty"`
+	// PrintedTypeLine holds the value of the "printed_type_line" field.
+	PrintedTypeLine string `json:"printed_type_line,omitempty"`
+	// FlavorText holds the value of the "flavor_text" field.
+	FlavorText string `json:"flavor_text,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PrintingQuery when eager-loading is set.
 	Edges              PrintingEdges `json:"edges"`
@@ -92,10 +122,14 @@ func (*Printing) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case printing.FieldID:
+		case printing.FieldMultiverseIds:
+			values[i] = new([]byte)
+		case printing.FieldID, printing.FieldMtgoID, printing.FieldArenaID, printing.FieldTcgplayerID:
 			values[i] = new(sql.NullInt64)
-		case printing.FieldRarity:
+		case printing.FieldRarity, printing.FieldIllustrationID, printing.FieldScryfallID, printing.FieldCollectorNumber, printing.FieldLanguage, printing.FieldPrintedName, printing.FieldPrintedText, printing.FieldPrintedTypeLine, printing.FieldFlavorText, printing.FieldContentHash:
 			values[i] = new(sql.NullString)
+		case printing.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
 		case printing.ForeignKeys[0]: // printing_artist
 			values[i] = new(sql.NullInt64)
 		case printing.ForeignKeys[1]: // printing_set
@@ -129,6 +163,96 @@ func (pr *Printing) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Rarity = printing.Rarity(value.String)
 			}
+		case printing.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				pr.ReleasedAt = new(time.Time)
+				*pr.ReleasedAt = value.Time
+			}
+		case printing.FieldIllustrationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field illustration_id", values[i])
+			} else if value.Valid {
+				pr.IllustrationID = value.String
+			}
+		case printing.FieldScryfallID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scryfall_id", values[i])
+			} else if value.Valid {
+				pr.ScryfallID = value.String
+			}
+		case printing.FieldCollectorNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector_number", values[i])
+			} else if value.Valid {
+				pr.CollectorNumber = value.String
+			}
+		case printing.FieldMultiverseIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field multiverse_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.MultiverseIds); err != nil {
+					return fmt.Errorf("unmarshal field multiverse_ids: %w", err)
+				}
+			}
+		case printing.FieldMtgoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mtgo_id", values[i])
+			} else if value.Valid {
+				pr.MtgoID = new(int)
+				*pr.MtgoID = int(value.Int64)
+			}
+		case printing.FieldArenaID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field arena_id", values[i])
+			} else if value.Valid {
+				pr.ArenaID = new(int)
+				*pr.ArenaID = int(value.Int64)
+			}
+		case printing.FieldTcgplayerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tcgplayer_id", values[i])
+			} else if value.Valid {
+				pr.TcgplayerID = new(int)
+				*pr.TcgplayerID = int(value.Int64)
+			}
+		case printing.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				pr.Language = value.String
+			}
+		case printing.FieldPrintedName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field printed_name", values[i])
+			} else if value.Valid {
+				pr.PrintedName = value.String
+			}
+		case printing.FieldPrintedText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field printed_text", values[i])
+			} else if value.Valid {
+				pr.PrintedText = value.String
+			}
+		case printing.FieldPrintedTypeLine:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field printed_type_line", values[i])
+			} else if value.Valid {
+				pr.PrintedTypeLine = value.String
+			}
+		case printing.FieldFlavorText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field flavor_text", values[i])
+			} else if value.Valid {
+				pr.FlavorText = value.String
+			}
+		case printing.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				pr.ContentHash = value.String
+			}
 		case printing.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field printing_artist", value)
@@ -208,6 +332,56 @@ func (pr *Printing) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
 	builder.WriteString("rarity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Rarity))
+	builder.WriteString(", ")
+	if v := pr.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("illustration_id=")
+	builder.WriteString(pr.IllustrationID)
+	builder.WriteString(", ")
+	builder.WriteString("scryfall_id=")
+	builder.WriteString(pr.ScryfallID)
+	builder.WriteString(", ")
+	builder.WriteString("collector_number=")
+	builder.WriteString(pr.CollectorNumber)
+	builder.WriteString(", ")
+	builder.WriteString("multiverse_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pr.MultiverseIds))
+	builder.WriteString(", ")
+	if v := pr.MtgoID; v != nil {
+		builder.WriteString("mtgo_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.ArenaID; v != nil {
+		builder.WriteString("arena_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.TcgplayerID; v != nil {
+		builder.WriteString("tcgplayer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(pr.Language)
+	builder.WriteString(", ")
+	builder.WriteString("printed_name=")
+	builder.WriteString(pr.PrintedName)
+	builder.WriteString(", ")
+	builder.WriteString("printed_text=")
+	builder.WriteString(pr.PrintedText)
+	builder.WriteString(", ")
+	builder.WriteString("printed_type_line=")
+	builder.WriteString(pr.PrintedTypeLine)
+	builder.WriteString(", ")
+	builder.WriteString("flavor_text=")
+	builder.WriteString(pr.FlavorText)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(pr.ContentHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
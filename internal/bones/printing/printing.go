@@ -16,6 +16,34 @@ const (
 	FieldID = "id"
 	// FieldRarity holds the string denoting the rarity field in the database.
 	FieldRarity = "rarity"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldIllustrationID holds the string denoting the illustration_id field in the database.
+	FieldIllustrationID = "illustration_id"
+	// FieldScryfallID holds the string denoting the scryfall_id field in the database.
+	FieldScryfallID = "scryfall_id"
+	// FieldCollectorNumber holds the string denoting the collector_number field in the database.
+	FieldCollectorNumber = "collector_number"
+	// FieldMultiverseIds holds the string denoting the multiverse_ids field in the database.
+	FieldMultiverseIds = "multiverse_ids"
+	// FieldMtgoID holds the string denoting the mtgo_id field in the database.
+	FieldMtgoID = "mtgo_id"
+	// FieldArenaID holds the string denoting the arena_id field in the database.
+	FieldArenaID = "arena_id"
+	// FieldTcgplayerID holds the string denoting the tcgplayer_id field in the database.
+	FieldTcgplayerID = "tcgplayer_id"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldPrintedName holds the string denoting the printed_name field in the database.
+	FieldPrintedName = "printed_name"
+	// FieldPrintedText holds the string denoting the printed_text field in the database.
+	FieldPrintedText = "printed_text"
+	// FieldPrintedTypeLine holds the string denoting the printed_type_line field in the database.
+	FieldPrintedTypeLine = "printed_type_line"
+	// FieldFlavorText holds the string denoting the flavor_text field in the database.
+	FieldFlavorText = "flavor_text"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
 	// EdgeArtist holds the string denoting the artist edge name in mutations.
 	EdgeArtist = "artist"
 	// EdgeSet holds the string denoting the set edge name in mutations.
@@ -60,6 +88,20 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldRarity,
+	FieldReleasedAt,
+	FieldIllustrationID,
+	FieldScryfallID,
+	FieldCollectorNumber,
+	FieldMultiverseIds,
+	FieldMtgoID,
+	FieldArenaID,
+	FieldTcgplayerID,
+	FieldLanguage,
+	FieldPrintedName,
+	FieldPrintedText,
+	FieldPrintedTypeLine,
+	FieldFlavorText,
+	FieldContentHash,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "printings"
@@ -85,6 +127,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultLanguage holds the default value on creation for the "language" field.
+	DefaultLanguage string
+)
+
 // Rarity defines the type for the "rarity" enum field.
 type Rarity string
 
@@ -125,6 +172,71 @@ func ByRarity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRarity, opts...).ToFunc()
 }
 
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByIllustrationID orders the results by the illustration_id field.
+func ByIllustrationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIllustrationID, opts...).ToFunc()
+}
+
+// ByScryfallID orders the results by the scryfall_id field.
+func ByScryfallID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScryfallID, opts...).ToFunc()
+}
+
+// ByCollectorNumber orders the results by the collector_number field.
+func ByCollectorNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectorNumber, opts...).ToFunc()
+}
+
+// ByMtgoID orders the results by the mtgo_id field.
+func ByMtgoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMtgoID, opts...).ToFunc()
+}
+
+// ByArenaID orders the results by the arena_id field.
+func ByArenaID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArenaID, opts...).ToFunc()
+}
+
+// ByTcgplayerID orders the results by the tcgplayer_id field.
+func ByTcgplayerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTcgplayerID, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByPrintedName orders the results by the printed_name field.
+func ByPrintedName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrintedName, opts...).ToFunc()
+}
+
+// ByPrintedText orders the results by the printed_text field.
+func ByPrintedText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrintedText, opts...).ToFunc()
+}
+
+// ByPrintedTypeLine orders the results by the printed_type_line field.
+func ByPrintedTypeLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrintedTypeLine, opts...).ToFunc()
+}
+
+// ByFlavorText orders the results by the flavor_text field.
+func ByFlavorText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlavorText, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
 // ByArtistField orders the results by artist field.
 func ByArtistField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -3,6 +3,8 @@
 package printing
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/SethCurry/stax/internal/bones/predicate"
@@ -53,6 +55,71 @@ func IDLTE(id int) predicate.Printing {
 	return predicate.Printing(sql.FieldLTE(FieldID, id))
 }
 
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// IllustrationID applies equality check predicate on the "illustration_id" field. It's identical to IllustrationIDEQ.
+func IllustrationID(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldIllustrationID, v))
+}
+
+// ScryfallID applies equality check predicate on the "scryfall_id" field. It's identical to ScryfallIDEQ.
+func ScryfallID(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldScryfallID, v))
+}
+
+// CollectorNumber applies equality check predicate on the "collector_number" field. It's identical to CollectorNumberEQ.
+func CollectorNumber(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldCollectorNumber, v))
+}
+
+// MtgoID applies equality check predicate on the "mtgo_id" field. It's identical to MtgoIDEQ.
+func MtgoID(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldMtgoID, v))
+}
+
+// ArenaID applies equality check predicate on the "arena_id" field. It's identical to ArenaIDEQ.
+func ArenaID(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldArenaID, v))
+}
+
+// TcgplayerID applies equality check predicate on the "tcgplayer_id" field. It's identical to TcgplayerIDEQ.
+func TcgplayerID(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldTcgplayerID, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldLanguage, v))
+}
+
+// PrintedName applies equality check predicate on the "printed_name" field. It's identical to PrintedNameEQ.
+func PrintedName(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedName, v))
+}
+
+// PrintedText applies equality check predicate on the "printed_text" field. It's identical to PrintedTextEQ.
+func PrintedText(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedText, v))
+}
+
+// PrintedTypeLine applies equality check predicate on the "printed_type_line" field. It's identical to PrintedTypeLineEQ.
+func PrintedTypeLine(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedTypeLine, v))
+}
+
+// FlavorText applies equality check predicate on the "flavor_text" field. It's identical to FlavorTextEQ.
+func FlavorText(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldFlavorText, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldContentHash, v))
+}
+
 // RarityEQ applies the EQ predicate on the "rarity" field.
 func RarityEQ(v Rarity) predicate.Printing {
 	return predicate.Printing(sql.FieldEQ(FieldRarity, v))
@@ -73,6 +140,881 @@ func RarityNotIn(vs ...Rarity) predicate.Printing {
 	return predicate.Printing(sql.FieldNotIn(FieldRarity, vs...))
 }
 
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// IllustrationIDEQ applies the EQ predicate on the "illustration_id" field.
+func IllustrationIDEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldIllustrationID, v))
+}
+
+// IllustrationIDNEQ applies the NEQ predicate on the "illustration_id" field.
+func IllustrationIDNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldIllustrationID, v))
+}
+
+// IllustrationIDIn applies the In predicate on the "illustration_id" field.
+func IllustrationIDIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldIllustrationID, vs...))
+}
+
+// IllustrationIDNotIn applies the NotIn predicate on the "illustration_id" field.
+func IllustrationIDNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldIllustrationID, vs...))
+}
+
+// IllustrationIDGT applies the GT predicate on the "illustration_id" field.
+func IllustrationIDGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldIllustrationID, v))
+}
+
+// IllustrationIDGTE applies the GTE predicate on the "illustration_id" field.
+func IllustrationIDGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldIllustrationID, v))
+}
+
+// IllustrationIDLT applies the LT predicate on the "illustration_id" field.
+func IllustrationIDLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldIllustrationID, v))
+}
+
+// IllustrationIDLTE applies the LTE predicate on the "illustration_id" field.
+func IllustrationIDLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldIllustrationID, v))
+}
+
+// IllustrationIDContains applies the Contains predicate on the "illustration_id" field.
+func IllustrationIDContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldIllustrationID, v))
+}
+
+// IllustrationIDHasPrefix applies the HasPrefix predicate on the "illustration_id" field.
+func IllustrationIDHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldIllustrationID, v))
+}
+
+// IllustrationIDHasSuffix applies the HasSuffix predicate on the "illustration_id" field.
+func IllustrationIDHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldIllustrationID, v))
+}
+
+// IllustrationIDIsNil applies the IsNil predicate on the "illustration_id" field.
+func IllustrationIDIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldIllustrationID))
+}
+
+// IllustrationIDNotNil applies the NotNil predicate on the "illustration_id" field.
+func IllustrationIDNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldIllustrationID))
+}
+
+// IllustrationIDEqualFold applies the EqualFold predicate on the "illustration_id" field.
+func IllustrationIDEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldIllustrationID, v))
+}
+
+// IllustrationIDContainsFold applies the ContainsFold predicate on the "illustration_id" field.
+func IllustrationIDContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldIllustrationID, v))
+}
+
+// ScryfallIDEQ applies the EQ predicate on the "scryfall_id" field.
+func ScryfallIDEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldScryfallID, v))
+}
+
+// ScryfallIDNEQ applies the NEQ predicate on the "scryfall_id" field.
+func ScryfallIDNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldScryfallID, v))
+}
+
+// ScryfallIDIn applies the In predicate on the "scryfall_id" field.
+func ScryfallIDIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldScryfallID, vs...))
+}
+
+// ScryfallIDNotIn applies the NotIn predicate on the "scryfall_id" field.
+func ScryfallIDNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldScryfallID, vs...))
+}
+
+// ScryfallIDGT applies the GT predicate on the "scryfall_id" field.
+func ScryfallIDGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldScryfallID, v))
+}
+
+// ScryfallIDGTE applies the GTE predicate on the "scryfall_id" field.
+func ScryfallIDGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldScryfallID, v))
+}
+
+// ScryfallIDLT applies the LT predicate on the "scryfall_id" field.
+func ScryfallIDLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldScryfallID, v))
+}
+
+// ScryfallIDLTE applies the LTE predicate on the "scryfall_id" field.
+func ScryfallIDLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldScryfallID, v))
+}
+
+// ScryfallIDContains applies the Contains predicate on the "scryfall_id" field.
+func ScryfallIDContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldScryfallID, v))
+}
+
+// ScryfallIDHasPrefix applies the HasPrefix predicate on the "scryfall_id" field.
+func ScryfallIDHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldScryfallID, v))
+}
+
+// ScryfallIDHasSuffix applies the HasSuffix predicate on the "scryfall_id" field.
+func ScryfallIDHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldScryfallID, v))
+}
+
+// ScryfallIDIsNil applies the IsNil predicate on the "scryfall_id" field.
+func ScryfallIDIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldScryfallID))
+}
+
+// ScryfallIDNotNil applies the NotNil predicate on the "scryfall_id" field.
+func ScryfallIDNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldScryfallID))
+}
+
+// ScryfallIDEqualFold applies the EqualFold predicate on the "scryfall_id" field.
+func ScryfallIDEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldScryfallID, v))
+}
+
+// ScryfallIDContainsFold applies the ContainsFold predicate on the "scryfall_id" field.
+func ScryfallIDContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldScryfallID, v))
+}
+
+// CollectorNumberEQ applies the EQ predicate on the "collector_number" field.
+func CollectorNumberEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldCollectorNumber, v))
+}
+
+// CollectorNumberNEQ applies the NEQ predicate on the "collector_number" field.
+func CollectorNumberNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldCollectorNumber, v))
+}
+
+// CollectorNumberIn applies the In predicate on the "collector_number" field.
+func CollectorNumberIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldCollectorNumber, vs...))
+}
+
+// CollectorNumberNotIn applies the NotIn predicate on the "collector_number" field.
+func CollectorNumberNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldCollectorNumber, vs...))
+}
+
+// CollectorNumberGT applies the GT predicate on the "collector_number" field.
+func CollectorNumberGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldCollectorNumber, v))
+}
+
+// CollectorNumberGTE applies the GTE predicate on the "collector_number" field.
+func CollectorNumberGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldCollectorNumber, v))
+}
+
+// CollectorNumberLT applies the LT predicate on the "collector_number" field.
+func CollectorNumberLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldCollectorNumber, v))
+}
+
+// CollectorNumberLTE applies the LTE predicate on the "collector_number" field.
+func CollectorNumberLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldCollectorNumber, v))
+}
+
+// CollectorNumberContains applies the Contains predicate on the "collector_number" field.
+func CollectorNumberContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldCollectorNumber, v))
+}
+
+// CollectorNumberHasPrefix applies the HasPrefix predicate on the "collector_number" field.
+func CollectorNumberHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldCollectorNumber, v))
+}
+
+// CollectorNumberHasSuffix applies the HasSuffix predicate on the "collector_number" field.
+func CollectorNumberHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldCollectorNumber, v))
+}
+
+// CollectorNumberIsNil applies the IsNil predicate on the "collector_number" field.
+func CollectorNumberIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldCollectorNumber))
+}
+
+// CollectorNumberNotNil applies the NotNil predicate on the "collector_number" field.
+func CollectorNumberNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldCollectorNumber))
+}
+
+// CollectorNumberEqualFold applies the EqualFold predicate on the "collector_number" field.
+func CollectorNumberEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldCollectorNumber, v))
+}
+
+// CollectorNumberContainsFold applies the ContainsFold predicate on the "collector_number" field.
+func CollectorNumberContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldCollectorNumber, v))
+}
+
+// MultiverseIdsIsNil applies the IsNil predicate on the "multiverse_ids" field.
+func MultiverseIdsIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldMultiverseIds))
+}
+
+// MultiverseIdsNotNil applies the NotNil predicate on the "multiverse_ids" field.
+func MultiverseIdsNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldMultiverseIds))
+}
+
+// MtgoIDEQ applies the EQ predicate on the "mtgo_id" field.
+func MtgoIDEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldMtgoID, v))
+}
+
+// MtgoIDNEQ applies the NEQ predicate on the "mtgo_id" field.
+func MtgoIDNEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldMtgoID, v))
+}
+
+// MtgoIDIn applies the In predicate on the "mtgo_id" field.
+func MtgoIDIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldMtgoID, vs...))
+}
+
+// MtgoIDNotIn applies the NotIn predicate on the "mtgo_id" field.
+func MtgoIDNotIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldMtgoID, vs...))
+}
+
+// MtgoIDGT applies the GT predicate on the "mtgo_id" field.
+func MtgoIDGT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldMtgoID, v))
+}
+
+// MtgoIDGTE applies the GTE predicate on the "mtgo_id" field.
+func MtgoIDGTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldMtgoID, v))
+}
+
+// MtgoIDLT applies the LT predicate on the "mtgo_id" field.
+func MtgoIDLT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldMtgoID, v))
+}
+
+// MtgoIDLTE applies the LTE predicate on the "mtgo_id" field.
+func MtgoIDLTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldMtgoID, v))
+}
+
+// MtgoIDIsNil applies the IsNil predicate on the "mtgo_id" field.
+func MtgoIDIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldMtgoID))
+}
+
+// MtgoIDNotNil applies the NotNil predicate on the "mtgo_id" field.
+func MtgoIDNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldMtgoID))
+}
+
+// ArenaIDEQ applies the EQ predicate on the "arena_id" field.
+func ArenaIDEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldArenaID, v))
+}
+
+// ArenaIDNEQ applies the NEQ predicate on the "arena_id" field.
+func ArenaIDNEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldArenaID, v))
+}
+
+// ArenaIDIn applies the In predicate on the "arena_id" field.
+func ArenaIDIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldArenaID, vs...))
+}
+
+// ArenaIDNotIn applies the NotIn predicate on the "arena_id" field.
+func ArenaIDNotIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldArenaID, vs...))
+}
+
+// ArenaIDGT applies the GT predicate on the "arena_id" field.
+func ArenaIDGT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldArenaID, v))
+}
+
+// ArenaIDGTE applies the GTE predicate on the "arena_id" field.
+func ArenaIDGTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldArenaID, v))
+}
+
+// ArenaIDLT applies the LT predicate on the "arena_id" field.
+func ArenaIDLT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldArenaID, v))
+}
+
+// ArenaIDLTE applies the LTE predicate on the "arena_id" field.
+func ArenaIDLTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldArenaID, v))
+}
+
+// ArenaIDIsNil applies the IsNil predicate on the "arena_id" field.
+func ArenaIDIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldArenaID))
+}
+
+// ArenaIDNotNil applies the NotNil predicate on the "arena_id" field.
+func ArenaIDNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldArenaID))
+}
+
+// TcgplayerIDEQ applies the EQ predicate on the "tcgplayer_id" field.
+func TcgplayerIDEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDNEQ applies the NEQ predicate on the "tcgplayer_id" field.
+func TcgplayerIDNEQ(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDIn applies the In predicate on the "tcgplayer_id" field.
+func TcgplayerIDIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldTcgplayerID, vs...))
+}
+
+// TcgplayerIDNotIn applies the NotIn predicate on the "tcgplayer_id" field.
+func TcgplayerIDNotIn(vs ...int) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldTcgplayerID, vs...))
+}
+
+// TcgplayerIDGT applies the GT predicate on the "tcgplayer_id" field.
+func TcgplayerIDGT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDGTE applies the GTE predicate on the "tcgplayer_id" field.
+func TcgplayerIDGTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDLT applies the LT predicate on the "tcgplayer_id" field.
+func TcgplayerIDLT(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDLTE applies the LTE predicate on the "tcgplayer_id" field.
+func TcgplayerIDLTE(v int) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldTcgplayerID, v))
+}
+
+// TcgplayerIDIsNil applies the IsNil predicate on the "tcgplayer_id" field.
+func TcgplayerIDIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldTcgplayerID))
+}
+
+// TcgplayerIDNotNil applies the NotNil predicate on the "tcgplayer_id" field.
+func TcgplayerIDNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldTcgplayerID))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// PrintedNameEQ applies the EQ predicate on the "printed_name" field.
+func PrintedNameEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedName, v))
+}
+
+// PrintedNameNEQ applies the NEQ predicate on the "printed_name" field.
+func PrintedNameNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldPrintedName, v))
+}
+
+// PrintedNameIn applies the In predicate on the "printed_name" field.
+func PrintedNameIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldPrintedName, vs...))
+}
+
+// PrintedNameNotIn applies the NotIn predicate on the "printed_name" field.
+func PrintedNameNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldPrintedName, vs...))
+}
+
+// PrintedNameGT applies the GT predicate on the "printed_name" field.
+func PrintedNameGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldPrintedName, v))
+}
+
+// PrintedNameGTE applies the GTE predicate on the "printed_name" field.
+func PrintedNameGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldPrintedName, v))
+}
+
+// PrintedNameLT applies the LT predicate on the "printed_name" field.
+func PrintedNameLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldPrintedName, v))
+}
+
+// PrintedNameLTE applies the LTE predicate on the "printed_name" field.
+func PrintedNameLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldPrintedName, v))
+}
+
+// PrintedNameContains applies the Contains predicate on the "printed_name" field.
+func PrintedNameContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldPrintedName, v))
+}
+
+// PrintedNameHasPrefix applies the HasPrefix predicate on the "printed_name" field.
+func PrintedNameHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldPrintedName, v))
+}
+
+// PrintedNameHasSuffix applies the HasSuffix predicate on the "printed_name" field.
+func PrintedNameHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldPrintedName, v))
+}
+
+// PrintedNameIsNil applies the IsNil predicate on the "printed_name" field.
+func PrintedNameIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldPrintedName))
+}
+
+// PrintedNameNotNil applies the NotNil predicate on the "printed_name" field.
+func PrintedNameNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldPrintedName))
+}
+
+// PrintedNameEqualFold applies the EqualFold predicate on the "printed_name" field.
+func PrintedNameEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldPrintedName, v))
+}
+
+// PrintedNameContainsFold applies the ContainsFold predicate on the "printed_name" field.
+func PrintedNameContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldPrintedName, v))
+}
+
+// PrintedTextEQ applies the EQ predicate on the "printed_text" field.
+func PrintedTextEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedText, v))
+}
+
+// PrintedTextNEQ applies the NEQ predicate on the "printed_text" field.
+func PrintedTextNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldPrintedText, v))
+}
+
+// PrintedTextIn applies the In predicate on the "printed_text" field.
+func PrintedTextIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldPrintedText, vs...))
+}
+
+// PrintedTextNotIn applies the NotIn predicate on the "printed_text" field.
+func PrintedTextNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldPrintedText, vs...))
+}
+
+// PrintedTextGT applies the GT predicate on the "printed_text" field.
+func PrintedTextGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldPrintedText, v))
+}
+
+// PrintedTextGTE applies the GTE predicate on the "printed_text" field.
+func PrintedTextGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldPrintedText, v))
+}
+
+// PrintedTextLT applies the LT predicate on the "printed_text" field.
+func PrintedTextLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldPrintedText, v))
+}
+
+// PrintedTextLTE applies the LTE predicate on the "printed_text" field.
+func PrintedTextLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldPrintedText, v))
+}
+
+// PrintedTextContains applies the Contains predicate on the "printed_text" field.
+func PrintedTextContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldPrintedText, v))
+}
+
+// PrintedTextHasPrefix applies the HasPrefix predicate on the "printed_text" field.
+func PrintedTextHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldPrintedText, v))
+}
+
+// PrintedTextHasSuffix applies the HasSuffix predicate on the "printed_text" field.
+func PrintedTextHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldPrintedText, v))
+}
+
+// PrintedTextIsNil applies the IsNil predicate on the "printed_text" field.
+func PrintedTextIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldPrintedText))
+}
+
+// PrintedTextNotNil applies the NotNil predicate on the "printed_text" field.
+func PrintedTextNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldPrintedText))
+}
+
+// PrintedTextEqualFold applies the EqualFold predicate on the "printed_text" field.
+func PrintedTextEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldPrintedText, v))
+}
+
+// PrintedTextContainsFold applies the ContainsFold predicate on the "printed_text" field.
+func PrintedTextContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldPrintedText, v))
+}
+
+// PrintedTypeLineEQ applies the EQ predicate on the "printed_type_line" field.
+func PrintedTypeLineEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineNEQ applies the NEQ predicate on the "printed_type_line" field.
+func PrintedTypeLineNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineIn applies the In predicate on the "printed_type_line" field.
+func PrintedTypeLineIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldPrintedTypeLine, vs...))
+}
+
+// PrintedTypeLineNotIn applies the NotIn predicate on the "printed_type_line" field.
+func PrintedTypeLineNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldPrintedTypeLine, vs...))
+}
+
+// PrintedTypeLineGT applies the GT predicate on the "printed_type_line" field.
+func PrintedTypeLineGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineGTE applies the GTE predicate on the "printed_type_line" field.
+func PrintedTypeLineGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineLT applies the LT predicate on the "printed_type_line" field.
+func PrintedTypeLineLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineLTE applies the LTE predicate on the "printed_type_line" field.
+func PrintedTypeLineLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineContains applies the Contains predicate on the "printed_type_line" field.
+func PrintedTypeLineContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineHasPrefix applies the HasPrefix predicate on the "printed_type_line" field.
+func PrintedTypeLineHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineHasSuffix applies the HasSuffix predicate on the "printed_type_line" field.
+func PrintedTypeLineHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineIsNil applies the IsNil predicate on the "printed_type_line" field.
+func PrintedTypeLineIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldPrintedTypeLine))
+}
+
+// PrintedTypeLineNotNil applies the NotNil predicate on the "printed_type_line" field.
+func PrintedTypeLineNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldPrintedTypeLine))
+}
+
+// PrintedTypeLineEqualFold applies the EqualFold predicate on the "printed_type_line" field.
+func PrintedTypeLineEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldPrintedTypeLine, v))
+}
+
+// PrintedTypeLineContainsFold applies the ContainsFold predicate on the "printed_type_line" field.
+func PrintedTypeLineContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldPrintedTypeLine, v))
+}
+
+// FlavorTextEQ applies the EQ predicate on the "flavor_text" field.
+func FlavorTextEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldFlavorText, v))
+}
+
+// FlavorTextNEQ applies the NEQ predicate on the "flavor_text" field.
+func FlavorTextNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldFlavorText, v))
+}
+
+// FlavorTextIn applies the In predicate on the "flavor_text" field.
+func FlavorTextIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldFlavorText, vs...))
+}
+
+// FlavorTextNotIn applies the NotIn predicate on the "flavor_text" field.
+func FlavorTextNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldFlavorText, vs...))
+}
+
+// FlavorTextGT applies the GT predicate on the "flavor_text" field.
+func FlavorTextGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldFlavorText, v))
+}
+
+// FlavorTextGTE applies the GTE predicate on the "flavor_text" field.
+func FlavorTextGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldFlavorText, v))
+}
+
+// FlavorTextLT applies the LT predicate on the "flavor_text" field.
+func FlavorTextLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldFlavorText, v))
+}
+
+// FlavorTextLTE applies the LTE predicate on the "flavor_text" field.
+func FlavorTextLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldFlavorText, v))
+}
+
+// FlavorTextContains applies the Contains predicate on the "flavor_text" field.
+func FlavorTextContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldFlavorText, v))
+}
+
+// FlavorTextHasPrefix applies the HasPrefix predicate on the "flavor_text" field.
+func FlavorTextHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldFlavorText, v))
+}
+
+// FlavorTextHasSuffix applies the HasSuffix predicate on the "flavor_text" field.
+func FlavorTextHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldFlavorText, v))
+}
+
+// FlavorTextIsNil applies the IsNil predicate on the "flavor_text" field.
+func FlavorTextIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldFlavorText))
+}
+
+// FlavorTextNotNil applies the NotNil predicate on the "flavor_text" field.
+func FlavorTextNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldFlavorText))
+}
+
+// FlavorTextEqualFold applies the EqualFold predicate on the "flavor_text" field.
+func FlavorTextEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldFlavorText, v))
+}
+
+// FlavorTextContainsFold applies the ContainsFold predicate on the "flavor_text" field.
+func FlavorTextContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldFlavorText, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.Printing {
+	return predicate.Printing(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.Printing {
+	return predicate.Printing(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.Printing {
+	return predicate.Printing(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.Printing {
+	return predicate.Printing(sql.FieldContainsFold(FieldContentHash, v))
+}
+
 // HasArtist applies the HasEdge predicate on the "artist" edge.
 func HasArtist() predicate.Printing {
 	return predicate.Printing(func(s *sql.Selector) {
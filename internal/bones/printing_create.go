@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -29,6 +30,194 @@ func (pc *PrintingCreate) SetRarity(pr printing.Rarity) *PrintingCreate {
 	return pc
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (pc *PrintingCreate) SetReleasedAt(t time.Time) *PrintingCreate {
+	pc.mutation.SetReleasedAt(t)
+	return pc
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableReleasedAt(t *time.Time) *PrintingCreate {
+	if t != nil {
+		pc.SetReleasedAt(*t)
+	}
+	return pc
+}
+
+// SetIllustrationID sets the "illustration_id" field.
+func (pc *PrintingCreate) SetIllustrationID(s string) *PrintingCreate {
+	pc.mutation.SetIllustrationID(s)
+	return pc
+}
+
+// SetNillableIllustrationID sets the "illustration_id" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableIllustrationID(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetIllustrationID(*s)
+	}
+	return pc
+}
+
+// SetScryfallID sets the "scryfall_id" field.
+func (pc *PrintingCreate) SetScryfallID(s string) *PrintingCreate {
+	pc.mutation.SetScryfallID(s)
+	return pc
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableScryfallID(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetScryfallID(*s)
+	}
+	return pc
+}
+
+// SetCollectorNumber sets the "collector_number" field.
+func (pc *PrintingCreate) SetCollectorNumber(s string) *PrintingCreate {
+	pc.mutation.SetCollectorNumber(s)
+	return pc
+}
+
+// SetNillableCollectorNumber sets the "collector_number" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableCollectorNumber(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetCollectorNumber(*s)
+	}
+	return pc
+}
+
+// SetMultiverseIds sets the "multiverse_ids" field.
+func (pc *PrintingCreate) SetMultiverseIds(i []int) *PrintingCreate {
+	pc.mutation.SetMultiverseIds(i)
+	return pc
+}
+
+// SetMtgoID sets the "mtgo_id" field.
+func (pc *PrintingCreate) SetMtgoID(i int) *PrintingCreate {
+	pc.mutation.SetMtgoID(i)
+	return pc
+}
+
+// SetNillableMtgoID sets the "mtgo_id" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableMtgoID(i *int) *PrintingCreate {
+	if i != nil {
+		pc.SetMtgoID(*i)
+	}
+	return pc
+}
+
+// SetArenaID sets the "arena_id" field.
+func (pc *PrintingCreate) SetArenaID(i int) *PrintingCreate {
+	pc.mutation.SetArenaID(i)
+	return pc
+}
+
+// SetNillableArenaID sets the "arena_id" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableArenaID(i *int) *PrintingCreate {
+	if i != nil {
+		pc.SetArenaID(*i)
+	}
+	return pc
+}
+
+// SetTcgplayerID sets the "tcgplayer_id" field.
+func (pc *PrintingCreate) SetTcgplayerID(i int) *PrintingCreate {
+	pc.mutation.SetTcgplayerID(i)
+	return pc
+}
+
+// SetNillableTcgplayerID sets the "tcgplayer_id" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableTcgplayerID(i *int) *PrintingCreate {
+	if i != nil {
+		pc.SetTcgplayerID(*i)
+	}
+	return pc
+}
+
+// SetLanguage sets the "language" field.
+func (pc *PrintingCreate) SetLanguage(s string) *PrintingCreate {
+	pc.mutation.SetLanguage(s)
+	return pc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableLanguage(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetLanguage(*s)
+	}
+	return pc
+}
+
+// SetPrintedName sets the "printed_name" field.
+func (pc *PrintingCreate) SetPrintedName(s string) *PrintingCreate {
+	pc.mutation.SetPrintedName(s)
+	return pc
+}
+
+// SetNillablePrintedName sets the "printed_name" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillablePrintedName(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetPrintedName(*s)
+	}
+	return pc
+}
+
+// SetPrintedText sets the "printed_text" field.
+func (pc *PrintingCreate) SetPrintedText(s string) *PrintingCreate {
+	pc.mutation.SetPrintedText(s)
+	return pc
+}
+
+// SetNillablePrintedText sets the "printed_text" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillablePrintedText(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetPrintedText(*s)
+	}
+	return pc
+}
+
+// SetPrintedTypeLine sets the "printed_type_line" field.
+func (pc *PrintingCreate) SetPrintedTypeLine(s string) *PrintingCreate {
+	pc.mutation.SetPrintedTypeLine(s)
+	return pc
+}
+
+// SetNillablePrintedTypeLine sets the "printed_type_line" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillablePrintedTypeLine(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetPrintedTypeLine(*s)
+	}
+	return pc
+}
+
+// SetFlavorText sets the "flavor_text" field.
+func (pc *PrintingCreate) SetFlavorText(s string) *PrintingCreate {
+	pc.mutation.SetFlavorText(s)
+	return pc
+}
+
+// SetNillableFlavorText sets the "flavor_text" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableFlavorText(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetFlavorText(*s)
+	}
+	return pc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (pc *PrintingCreate) SetContentHash(s string) *PrintingCreate {
+	pc.mutation.SetContentHash(s)
+	return pc
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (pc *PrintingCreate) SetNillableContentHash(s *string) *PrintingCreate {
+	if s != nil {
+		pc.SetContentHash(*s)
+	}
+	return pc
+}
+
 // SetArtistID sets the "artist" edge to the Artist entity by ID.
 func (pc *PrintingCreate) SetArtistID(id int) *PrintingCreate {
 	pc.mutation.SetArtistID(id)
@@ -108,6 +297,7 @@ func (pc *PrintingCreate) Mutation() *PrintingMutation {
 
 // Save creates the Printing in the database.
 func (pc *PrintingCreate) Save(ctx context.Context) (*Printing, error) {
+	pc.defaults()
 	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
 }
 
@@ -133,6 +323,14 @@ func (pc *PrintingCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PrintingCreate) defaults() {
+	if _, ok := pc.mutation.Language(); !ok {
+		v := printing.DefaultLanguage
+		pc.mutation.SetLanguage(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PrintingCreate) check() error {
 	if _, ok := pc.mutation.Rarity(); !ok {
@@ -143,6 +341,9 @@ func (pc *PrintingCreate) check() error {
 			return &ValidationError{Name: "rarity", err: fmt.Errorf(`bones: validator failed for field "Printing.rarity": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`bones: missing required field "Printing.language"`)}
+	}
 	return nil
 }
 
@@ -173,6 +374,62 @@ func (pc *PrintingCreate) createSpec() (*Printing, *sqlgraph.CreateSpec) {
 		_spec.SetField(printing.FieldRarity, field.TypeEnum, value)
 		_node.Rarity = value
 	}
+	if value, ok := pc.mutation.ReleasedAt(); ok {
+		_spec.SetField(printing.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := pc.mutation.IllustrationID(); ok {
+		_spec.SetField(printing.FieldIllustrationID, field.TypeString, value)
+		_node.IllustrationID = value
+	}
+	if value, ok := pc.mutation.ScryfallID(); ok {
+		_spec.SetField(printing.FieldScryfallID, field.TypeString, value)
+		_node.ScryfallID = value
+	}
+	if value, ok := pc.mutation.CollectorNumber(); ok {
+		_spec.SetField(printing.FieldCollectorNumber, field.TypeString, value)
+		_node.CollectorNumber = value
+	}
+	if value, ok := pc.mutation.MultiverseIds(); ok {
+		_spec.SetField(printing.FieldMultiverseIds, field.TypeJSON, value)
+		_node.MultiverseIds = value
+	}
+	if value, ok := pc.mutation.MtgoID(); ok {
+		_spec.SetField(printing.FieldMtgoID, field.TypeInt, value)
+		_node.MtgoID = &value
+	}
+	if value, ok := pc.mutation.ArenaID(); ok {
+		_spec.SetField(printing.FieldArenaID, field.TypeInt, value)
+		_node.ArenaID = &value
+	}
+	if value, ok := pc.mutation.TcgplayerID(); ok {
+		_spec.SetField(printing.FieldTcgplayerID, field.TypeInt, value)
+		_node.TcgplayerID = &value
+	}
+	if value, ok := pc.mutation.Language(); ok {
+		_spec.SetField(printing.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := pc.mutation.PrintedName(); ok {
+		_spec.SetField(printing.FieldPrintedName, field.TypeString, value)
+		_node.PrintedName = value
+	}
+	if value, ok := pc.mutation.PrintedText(); ok {
+		_spec.SetField(printing.FieldPrintedText, field.TypeString, value)
+		_node.PrintedText = value
+	}
+	if value, ok := pc.mutation.PrintedTypeLine(); ok {
+		_spec.SetField(printing.FieldPrintedTypeLine, field.TypeString, value)
+		_node.PrintedTypeLine = value
+	}
+	if value, ok := pc.mutation.FlavorText(); ok {
+		_spec.SetField(printing.FieldFlavorText, field.TypeString, value)
+		_node.FlavorText = value
+	}
+	if value, ok := pc.mutation.ContentHash(); ok {
+		_spec.SetField(printing.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if nodes := pc.mutation.ArtistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -261,6 +518,7 @@ func (pcb *PrintingCreateBulk) Save(ctx context.Context) ([]*Printing, error) {
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PrintingMutation)
 				if !ok {
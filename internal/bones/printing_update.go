@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/SethCurry/stax/internal/bones/artist"
 	"github.com/SethCurry/stax/internal/bones/cardface"
@@ -45,6 +47,299 @@ func (pu *PrintingUpdate) SetNillableRarity(pr *printing.Rarity) *PrintingUpdate
 	return pu
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (pu *PrintingUpdate) SetReleasedAt(t time.Time) *PrintingUpdate {
+	pu.mutation.SetReleasedAt(t)
+	return pu
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableReleasedAt(t *time.Time) *PrintingUpdate {
+	if t != nil {
+		pu.SetReleasedAt(*t)
+	}
+	return pu
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (pu *PrintingUpdate) ClearReleasedAt() *PrintingUpdate {
+	pu.mutation.ClearReleasedAt()
+	return pu
+}
+
+// SetIllustrationID sets the "illustration_id" field.
+func (pu *PrintingUpdate) SetIllustrationID(s string) *PrintingUpdate {
+	pu.mutation.SetIllustrationID(s)
+	return pu
+}
+
+// SetNillableIllustrationID sets the "illustration_id" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableIllustrationID(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetIllustrationID(*s)
+	}
+	return pu
+}
+
+// ClearIllustrationID clears the value of the "illustration_id" field.
+func (pu *PrintingUpdate) ClearIllustrationID() *PrintingUpdate {
+	pu.mutation.ClearIllustrationID()
+	return pu
+}
+
+// SetScryfallID sets the "scryfall_id" field.
+func (pu *PrintingUpdate) SetScryfallID(s string) *PrintingUpdate {
+	pu.mutation.SetScryfallID(s)
+	return pu
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableScryfallID(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetScryfallID(*s)
+	}
+	return pu
+}
+
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (pu *PrintingUpdate) ClearScryfallID() *PrintingUpdate {
+	pu.mutation.ClearScryfallID()
+	return pu
+}
+
+// SetCollectorNumber sets the "collector_number" field.
+func (pu *PrintingUpdate) SetCollectorNumber(s string) *PrintingUpdate {
+	pu.mutation.SetCollectorNumber(s)
+	return pu
+}
+
+// SetNillableCollectorNumber sets the "collector_number" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableCollectorNumber(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetCollectorNumber(*s)
+	}
+	return pu
+}
+
+// ClearCollectorNumber clears the value of the "collector_number" field.
+func (pu *PrintingUpdate) ClearCollectorNumber() *PrintingUpdate {
+	pu.mutation.ClearCollectorNumber()
+	return pu
+}
+
+// SetMultiverseIds sets the "multiverse_ids" field.
+func (pu *PrintingUpdate) SetMultiverseIds(i []int) *PrintingUpdate {
+	pu.mutation.SetMultiverseIds(i)
+	return pu
+}
+
+// AppendMultiverseIds appends i to the "multiverse_ids" field.
+func (pu *PrintingUpdate) AppendMultiverseIds(i []int) *PrintingUpdate {
+	pu.mutation.AppendMultiverseIds(i)
+	return pu
+}
+
+// ClearMultiverseIds clears the value of the "multiverse_ids" field.
+func (pu *PrintingUpdate) ClearMultiverseIds() *PrintingUpdate {
+	pu.mutation.ClearMultiverseIds()
+	return pu
+}
+
+// SetMtgoID sets the "mtgo_id" field.
+func (pu *PrintingUpdate) SetMtgoID(i int) *PrintingUpdate {
+	pu.mutation.ResetMtgoID()
+	pu.mutation.SetMtgoID(i)
+	return pu
+}
+
+// SetNillableMtgoID sets the "mtgo_id" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableMtgoID(i *int) *PrintingUpdate {
+	if i != nil {
+		pu.SetMtgoID(*i)
+	}
+	return pu
+}
+
+// AddMtgoID adds i to the "mtgo_id" field.
+func (pu *PrintingUpdate) AddMtgoID(i int) *PrintingUpdate {
+	pu.mutation.AddMtgoID(i)
+	return pu
+}
+
+// ClearMtgoID clears the value of the "mtgo_id" field.
+func (pu *PrintingUpdate) ClearMtgoID() *PrintingUpdate {
+	pu.mutation.ClearMtgoID()
+	return pu
+}
+
+// SetArenaID sets the "arena_id" field.
+func (pu *PrintingUpdate) SetArenaID(i int) *PrintingUpdate {
+	pu.mutation.ResetArenaID()
+	pu.mutation.SetArenaID(i)
+	return pu
+}
+
+// SetNillableArenaID sets the "arena_id" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableArenaID(i *int) *PrintingUpdate {
+	if i != nil {
+		pu.SetArenaID(*i)
+	}
+	return pu
+}
+
+// AddArenaID adds i to the "arena_id" field.
+func (pu *PrintingUpdate) AddArenaID(i int) *PrintingUpdate {
+	pu.mutation.AddArenaID(i)
+	return pu
+}
+
+// ClearArenaID clears the value of the "arena_id" field.
+func (pu *PrintingUpdate) ClearArenaID() *PrintingUpdate {
+	pu.mutation.ClearArenaID()
+	return pu
+}
+
+// SetTcgplayerID sets the "tcgplayer_id" field.
+func (pu *PrintingUpdate) SetTcgplayerID(i int) *PrintingUpdate {
+	pu.mutation.ResetTcgplayerID()
+	pu.mutation.SetTcgplayerID(i)
+	return pu
+}
+
+// SetNillableTcgplayerID sets the "tcgplayer_id" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableTcgplayerID(i *int) *PrintingUpdate {
+	if i != nil {
+		pu.SetTcgplayerID(*i)
+	}
+	return pu
+}
+
+// AddTcgplayerID adds i to the "tcgplayer_id" field.
+func (pu *PrintingUpdate) AddTcgplayerID(i int) *PrintingUpdate {
+	pu.mutation.AddTcgplayerID(i)
+	return pu
+}
+
+// ClearTcgplayerID clears the value of the "tcgplayer_id" field.
+func (pu *PrintingUpdate) ClearTcgplayerID() *PrintingUpdate {
+	pu.mutation.ClearTcgplayerID()
+	return pu
+}
+
+// SetLanguage sets the "language" field.
+func (pu *PrintingUpdate) SetLanguage(s string) *PrintingUpdate {
+	pu.mutation.SetLanguage(s)
+	return pu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableLanguage(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetLanguage(*s)
+	}
+	return pu
+}
+
+// SetPrintedName sets the "printed_name" field.
+func (pu *PrintingUpdate) SetPrintedName(s string) *PrintingUpdate {
+	pu.mutation.SetPrintedName(s)
+	return pu
+}
+
+// SetNillablePrintedName sets the "printed_name" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillablePrintedName(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetPrintedName(*s)
+	}
+	return pu
+}
+
+// ClearPrintedName clears the value of the "printed_name" field.
+func (pu *PrintingUpdate) ClearPrintedName() *PrintingUpdate {
+	pu.mutation.ClearPrintedName()
+	return pu
+}
+
+// SetPrintedText sets the "printed_text" field.
+func (pu *PrintingUpdate) SetPrintedText(s string) *PrintingUpdate {
+	pu.mutation.SetPrintedText(s)
+	return pu
+}
+
+// SetNillablePrintedText sets the "printed_text" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillablePrintedText(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetPrintedText(*s)
+	}
+	return pu
+}
+
+// ClearPrintedText clears the value of the "printed_text" field.
+func (pu *PrintingUpdate) ClearPrintedText() *PrintingUpdate {
+	pu.mutation.ClearPrintedText()
+	return pu
+}
+
+// SetPrintedTypeLine sets the "printed_type_line" field.
+func (pu *PrintingUpdate) SetPrintedTypeLine(s string) *PrintingUpdate {
+	pu.mutation.SetPrintedTypeLine(s)
+	return pu
+}
+
+// SetNillablePrintedTypeLine sets the "printed_type_line" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillablePrintedTypeLine(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetPrintedTypeLine(*s)
+	}
+	return pu
+}
+
+// ClearPrintedTypeLine clears the value of the "printed_type_line" field.
+func (pu *PrintingUpdate) ClearPrintedTypeLine() *PrintingUpdate {
+	pu.mutation.ClearPrintedTypeLine()
+	return pu
+}
+
+// SetFlavorText sets the "flavor_text" field.
+func (pu *PrintingUpdate) SetFlavorText(s string) *PrintingUpdate {
+	pu.mutation.SetFlavorText(s)
+	return pu
+}
+
+// SetNillableFlavorText sets the "flavor_text" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableFlavorText(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetFlavorText(*s)
+	}
+	return pu
+}
+
+// ClearFlavorText clears the value of the "flavor_text" field.
+func (pu *PrintingUpdate) ClearFlavorText() *PrintingUpdate {
+	pu.mutation.ClearFlavorText()
+	return pu
+}
+
+// SetContentHash sets the "content_hash" field.
+func (pu *PrintingUpdate) SetContentHash(s string) *PrintingUpdate {
+	pu.mutation.SetContentHash(s)
+	return pu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (pu *PrintingUpdate) SetNillableContentHash(s *string) *PrintingUpdate {
+	if s != nil {
+		pu.SetContentHash(*s)
+	}
+	return pu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (pu *PrintingUpdate) ClearContentHash() *PrintingUpdate {
+	pu.mutation.ClearContentHash()
+	return pu
+}
+
 // SetArtistID sets the "artist" edge to the Artist entity by ID.
 func (pu *PrintingUpdate) SetArtistID(id int) *PrintingUpdate {
 	pu.mutation.SetArtistID(id)
@@ -213,6 +508,101 @@ func (pu *PrintingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Rarity(); ok {
 		_spec.SetField(printing.FieldRarity, field.TypeEnum, value)
 	}
+	if value, ok := pu.mutation.ReleasedAt(); ok {
+		_spec.SetField(printing.FieldReleasedAt, field.TypeTime, value)
+	}
+	if pu.mutation.ReleasedAtCleared() {
+		_spec.ClearField(printing.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.IllustrationID(); ok {
+		_spec.SetField(printing.FieldIllustrationID, field.TypeString, value)
+	}
+	if pu.mutation.IllustrationIDCleared() {
+		_spec.ClearField(printing.FieldIllustrationID, field.TypeString)
+	}
+	if value, ok := pu.mutation.ScryfallID(); ok {
+		_spec.SetField(printing.FieldScryfallID, field.TypeString, value)
+	}
+	if pu.mutation.ScryfallIDCleared() {
+		_spec.ClearField(printing.FieldScryfallID, field.TypeString)
+	}
+	if value, ok := pu.mutation.CollectorNumber(); ok {
+		_spec.SetField(printing.FieldCollectorNumber, field.TypeString, value)
+	}
+	if pu.mutation.CollectorNumberCleared() {
+		_spec.ClearField(printing.FieldCollectorNumber, field.TypeString)
+	}
+	if value, ok := pu.mutation.MultiverseIds(); ok {
+		_spec.SetField(printing.FieldMultiverseIds, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedMultiverseIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, printing.FieldMultiverseIds, value)
+		})
+	}
+	if pu.mutation.MultiverseIdsCleared() {
+		_spec.ClearField(printing.FieldMultiverseIds, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.MtgoID(); ok {
+		_spec.SetField(printing.FieldMtgoID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedMtgoID(); ok {
+		_spec.AddField(printing.FieldMtgoID, field.TypeInt, value)
+	}
+	if pu.mutation.MtgoIDCleared() {
+		_spec.ClearField(printing.FieldMtgoID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.ArenaID(); ok {
+		_spec.SetField(printing.FieldArenaID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedArenaID(); ok {
+		_spec.AddField(printing.FieldArenaID, field.TypeInt, value)
+	}
+	if pu.mutation.ArenaIDCleared() {
+		_spec.ClearField(printing.FieldArenaID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.TcgplayerID(); ok {
+		_spec.SetField(printing.FieldTcgplayerID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedTcgplayerID(); ok {
+		_spec.AddField(printing.FieldTcgplayerID, field.TypeInt, value)
+	}
+	if pu.mutation.TcgplayerIDCleared() {
+		_spec.ClearField(printing.FieldTcgplayerID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.Language(); ok {
+		_spec.SetField(printing.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.PrintedName(); ok {
+		_spec.SetField(printing.FieldPrintedName, field.TypeString, value)
+	}
+	if pu.mutation.PrintedNameCleared() {
+		_spec.ClearField(printing.FieldPrintedName, field.TypeString)
+	}
+	if value, ok := pu.mutation.PrintedText(); ok {
+		_spec.SetField(printing.FieldPrintedText, field.TypeString, value)
+	}
+	if pu.mutation.PrintedTextCleared() {
+		_spec.ClearField(printing.FieldPrintedText, field.TypeString)
+	}
+	if value, ok := pu.mutation.PrintedTypeLine(); ok {
+		_spec.SetField(printing.FieldPrintedTypeLine, field.TypeString, value)
+	}
+	if pu.mutation.PrintedTypeLineCleared() {
+		_spec.ClearField(printing.FieldPrintedTypeLine, field.TypeString)
+	}
+	if value, ok := pu.mutation.FlavorText(); ok {
+		_spec.SetField(printing.FieldFlavorText, field.TypeString, value)
+	}
+	if pu.mutation.FlavorTextCleared() {
+		_spec.ClearField(printing.FieldFlavorText, field.TypeString)
+	}
+	if value, ok := pu.mutation.ContentHash(); ok {
+		_spec.SetField(printing.FieldContentHash, field.TypeString, value)
+	}
+	if pu.mutation.ContentHashCleared() {
+		_spec.ClearField(printing.FieldContentHash, field.TypeString)
+	}
 	if pu.mutation.ArtistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -379,6 +769,299 @@ func (puo *PrintingUpdateOne) SetNillableRarity(pr *printing.Rarity) *PrintingUp
 	return puo
 }
 
+// SetReleasedAt sets the "released_at" field.
+func (puo *PrintingUpdateOne) SetReleasedAt(t time.Time) *PrintingUpdateOne {
+	puo.mutation.SetReleasedAt(t)
+	return puo
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableReleasedAt(t *time.Time) *PrintingUpdateOne {
+	if t != nil {
+		puo.SetReleasedAt(*t)
+	}
+	return puo
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (puo *PrintingUpdateOne) ClearReleasedAt() *PrintingUpdateOne {
+	puo.mutation.ClearReleasedAt()
+	return puo
+}
+
+// SetIllustrationID sets the "illustration_id" field.
+func (puo *PrintingUpdateOne) SetIllustrationID(s string) *PrintingUpdateOne {
+	puo.mutation.SetIllustrationID(s)
+	return puo
+}
+
+// SetNillableIllustrationID sets the "illustration_id" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableIllustrationID(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetIllustrationID(*s)
+	}
+	return puo
+}
+
+// ClearIllustrationID clears the value of the "illustration_id" field.
+func (puo *PrintingUpdateOne) ClearIllustrationID() *PrintingUpdateOne {
+	puo.mutation.ClearIllustrationID()
+	return puo
+}
+
+// SetScryfallID sets the "scryfall_id" field.
+func (puo *PrintingUpdateOne) SetScryfallID(s string) *PrintingUpdateOne {
+	puo.mutation.SetScryfallID(s)
+	return puo
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableScryfallID(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetScryfallID(*s)
+	}
+	return puo
+}
+
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (puo *PrintingUpdateOne) ClearScryfallID() *PrintingUpdateOne {
+	puo.mutation.ClearScryfallID()
+	return puo
+}
+
+// SetCollectorNumber sets the "collector_number" field.
+func (puo *PrintingUpdateOne) SetCollectorNumber(s string) *PrintingUpdateOne {
+	puo.mutation.SetCollectorNumber(s)
+	return puo
+}
+
+// SetNillableCollectorNumber sets the "collector_number" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableCollectorNumber(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetCollectorNumber(*s)
+	}
+	return puo
+}
+
+// ClearCollectorNumber clears the value of the "collector_number" field.
+func (puo *PrintingUpdateOne) ClearCollectorNumber() *PrintingUpdateOne {
+	puo.mutation.ClearCollectorNumber()
+	return puo
+}
+
+// SetMultiverseIds sets the "multiverse_ids" field.
+func (puo *PrintingUpdateOne) SetMultiverseIds(i []int) *PrintingUpdateOne {
+	puo.mutation.SetMultiverseIds(i)
+	return puo
+}
+
+// AppendMultiverseIds appends i to the "multiverse_ids" field.
+func (puo *PrintingUpdateOne) AppendMultiverseIds(i []int) *PrintingUpdateOne {
+	puo.mutation.AppendMultiverseIds(i)
+	return puo
+}
+
+// ClearMultiverseIds clears the value of the "multiverse_ids" field.
+func (puo *PrintingUpdateOne) ClearMultiverseIds() *PrintingUpdateOne {
+	puo.mutation.ClearMultiverseIds()
+	return puo
+}
+
+// SetMtgoID sets the "mtgo_id" field.
+func (puo *PrintingUpdateOne) SetMtgoID(i int) *PrintingUpdateOne {
+	puo.mutation.ResetMtgoID()
+	puo.mutation.SetMtgoID(i)
+	return puo
+}
+
+// SetNillableMtgoID sets the "mtgo_id" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableMtgoID(i *int) *PrintingUpdateOne {
+	if i != nil {
+		puo.SetMtgoID(*i)
+	}
+	return puo
+}
+
+// AddMtgoID adds i to the "mtgo_id" field.
+func (puo *PrintingUpdateOne) AddMtgoID(i int) *PrintingUpdateOne {
+	puo.mutation.AddMtgoID(i)
+	return puo
+}
+
+// ClearMtgoID clears the value of the "mtgo_id" field.
+func (puo *PrintingUpdateOne) ClearMtgoID() *PrintingUpdateOne {
+	puo.mutation.ClearMtgoID()
+	return puo
+}
+
+// SetArenaID sets the "arena_id" field.
+func (puo *PrintingUpdateOne) SetArenaID(i int) *PrintingUpdateOne {
+	puo.mutation.ResetArenaID()
+	puo.mutation.SetArenaID(i)
+	return puo
+}
+
+// SetNillableArenaID sets the "arena_id" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableArenaID(i *int) *PrintingUpdateOne {
+	if i != nil {
+		puo.SetArenaID(*i)
+	}
+	return puo
+}
+
+// AddArenaID adds i to the "arena_id" field.
+func (puo *PrintingUpdateOne) AddArenaID(i int) *PrintingUpdateOne {
+	puo.mutation.AddArenaID(i)
+	return puo
+}
+
+// ClearArenaID clears the value of the "arena_id" field.
+func (puo *PrintingUpdateOne) ClearArenaID() *PrintingUpdateOne {
+	puo.mutation.ClearArenaID()
+	return puo
+}
+
+// SetTcgplayerID sets the "tcgplayer_id" field.
+func (puo *PrintingUpdateOne) SetTcgplayerID(i int) *PrintingUpdateOne {
+	puo.mutation.ResetTcgplayerID()
+	puo.mutation.SetTcgplayerID(i)
+	return puo
+}
+
+// SetNillableTcgplayerID sets the "tcgplayer_id" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableTcgplayerID(i *int) *PrintingUpdateOne {
+	if i != nil {
+		puo.SetTcgplayerID(*i)
+	}
+	return puo
+}
+
+// AddTcgplayerID adds i to the "tcgplayer_id" field.
+func (puo *PrintingUpdateOne) AddTcgplayerID(i int) *PrintingUpdateOne {
+	puo.mutation.AddTcgplayerID(i)
+	return puo
+}
+
+// ClearTcgplayerID clears the value of the "tcgplayer_id" field.
+func (puo *PrintingUpdateOne) ClearTcgplayerID() *PrintingUpdateOne {
+	puo.mutation.ClearTcgplayerID()
+	return puo
+}
+
+// SetLanguage sets the "language" field.
+func (puo *PrintingUpdateOne) SetLanguage(s string) *PrintingUpdateOne {
+	puo.mutation.SetLanguage(s)
+	return puo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableLanguage(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetLanguage(*s)
+	}
+	return puo
+}
+
+// SetPrintedName sets the "printed_name" field.
+func (puo *PrintingUpdateOne) SetPrintedName(s string) *PrintingUpdateOne {
+	puo.mutation.SetPrintedName(s)
+	return puo
+}
+
+// SetNillablePrintedName sets the "printed_name" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillablePrintedName(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetPrintedName(*s)
+	}
+	return puo
+}
+
+// ClearPrintedName clears the value of the "printed_name" field.
+func (puo *PrintingUpdateOne) ClearPrintedName() *PrintingUpdateOne {
+	puo.mutation.ClearPrintedName()
+	return puo
+}
+
+// SetPrintedText sets the "printed_text" field.
+func (puo *PrintingUpdateOne) SetPrintedText(s string) *PrintingUpdateOne {
+	puo.mutation.SetPrintedText(s)
+	return puo
+}
+
+// SetNillablePrintedText sets the "printed_text" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillablePrintedText(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetPrintedText(*s)
+	}
+	return puo
+}
+
+// ClearPrintedText clears the value of the "printed_text" field.
+func (puo *PrintingUpdateOne) ClearPrintedText() *PrintingUpdateOne {
+	puo.mutation.ClearPrintedText()
+	return puo
+}
+
+// SetPrintedTypeLine sets the "printed_type_line" field.
+func (puo *PrintingUpdateOne) SetPrintedTypeLine(s string) *PrintingUpdateOne {
+	puo.mutation.SetPrintedTypeLine(s)
+	return puo
+}
+
+// SetNillablePrintedTypeLine sets the "printed_type_line" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillablePrintedTypeLine(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetPrintedTypeLine(*s)
+	}
+	return puo
+}
+
+// ClearPrintedTypeLine clears the value of the "printed_type_line" field.
+func (puo *PrintingUpdateOne) ClearPrintedTypeLine() *PrintingUpdateOne {
+	puo.mutation.ClearPrintedTypeLine()
+	return puo
+}
+
+// SetFlavorText sets the "flavor_text" field.
+func (puo *PrintingUpdateOne) SetFlavorText(s string) *PrintingUpdateOne {
+	puo.mutation.SetFlavorText(s)
+	return puo
+}
+
+// SetNillableFlavorText sets the "flavor_text" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableFlavorText(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetFlavorText(*s)
+	}
+	return puo
+}
+
+// ClearFlavorText clears the value of the "flavor_text" field.
+func (puo *PrintingUpdateOne) ClearFlavorText() *PrintingUpdateOne {
+	puo.mutation.ClearFlavorText()
+	return puo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (puo *PrintingUpdateOne) SetContentHash(s string) *PrintingUpdateOne {
+	puo.mutation.SetContentHash(s)
+	return puo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (puo *PrintingUpdateOne) SetNillableContentHash(s *string) *PrintingUpdateOne {
+	if s != nil {
+		puo.SetContentHash(*s)
+	}
+	return puo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (puo *PrintingUpdateOne) ClearContentHash() *PrintingUpdateOne {
+	puo.mutation.ClearContentHash()
+	return puo
+}
+
 // SetArtistID sets the "artist" edge to the Artist entity by ID.
 func (puo *PrintingUpdateOne) SetArtistID(id int) *PrintingUpdateOne {
 	puo.mutation.SetArtistID(id)
@@ -577,6 +1260,101 @@ func (puo *PrintingUpdateOne) sqlSave(ctx context.Context) (_node *Printing, err
 	if value, ok := puo.mutation.Rarity(); ok {
 		_spec.SetField(printing.FieldRarity, field.TypeEnum, value)
 	}
+	if value, ok := puo.mutation.ReleasedAt(); ok {
+		_spec.SetField(printing.FieldReleasedAt, field.TypeTime, value)
+	}
+	if puo.mutation.ReleasedAtCleared() {
+		_spec.ClearField(printing.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.IllustrationID(); ok {
+		_spec.SetField(printing.FieldIllustrationID, field.TypeString, value)
+	}
+	if puo.mutation.IllustrationIDCleared() {
+		_spec.ClearField(printing.FieldIllustrationID, field.TypeString)
+	}
+	if value, ok := puo.mutation.ScryfallID(); ok {
+		_spec.SetField(printing.FieldScryfallID, field.TypeString, value)
+	}
+	if puo.mutation.ScryfallIDCleared() {
+		_spec.ClearField(printing.FieldScryfallID, field.TypeString)
+	}
+	if value, ok := puo.mutation.CollectorNumber(); ok {
+		_spec.SetField(printing.FieldCollectorNumber, field.TypeString, value)
+	}
+	if puo.mutation.CollectorNumberCleared() {
+		_spec.ClearField(printing.FieldCollectorNumber, field.TypeString)
+	}
+	if value, ok := puo.mutation.MultiverseIds(); ok {
+		_spec.SetField(printing.FieldMultiverseIds, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedMultiverseIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, printing.FieldMultiverseIds, value)
+		})
+	}
+	if puo.mutation.MultiverseIdsCleared() {
+		_spec.ClearField(printing.FieldMultiverseIds, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.MtgoID(); ok {
+		_spec.SetField(printing.FieldMtgoID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedMtgoID(); ok {
+		_spec.AddField(printing.FieldMtgoID, field.TypeInt, value)
+	}
+	if puo.mutation.MtgoIDCleared() {
+		_spec.ClearField(printing.FieldMtgoID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.ArenaID(); ok {
+		_spec.SetField(printing.FieldArenaID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedArenaID(); ok {
+		_spec.AddField(printing.FieldArenaID, field.TypeInt, value)
+	}
+	if puo.mutation.ArenaIDCleared() {
+		_spec.ClearField(printing.FieldArenaID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.TcgplayerID(); ok {
+		_spec.SetField(printing.FieldTcgplayerID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedTcgplayerID(); ok {
+		_spec.AddField(printing.FieldTcgplayerID, field.TypeInt, value)
+	}
+	if puo.mutation.TcgplayerIDCleared() {
+		_spec.ClearField(printing.FieldTcgplayerID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.Language(); ok {
+		_spec.SetField(printing.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.PrintedName(); ok {
+		_spec.SetField(printing.FieldPrintedName, field.TypeString, value)
+	}
+	if puo.mutation.PrintedNameCleared() {
+		_spec.ClearField(printing.FieldPrintedName, field.TypeString)
+	}
+	if value, ok := puo.mutation.PrintedText(); ok {
+		_spec.SetField(printing.FieldPrintedText, field.TypeString, value)
+	}
+	if puo.mutation.PrintedTextCleared() {
+		_spec.ClearField(printing.FieldPrintedText, field.TypeString)
+	}
+	if value, ok := puo.mutation.PrintedTypeLine(); ok {
+		_spec.SetField(printing.FieldPrintedTypeLine, field.TypeString, value)
+	}
+	if puo.mutation.PrintedTypeLineCleared() {
+		_spec.ClearField(printing.FieldPrintedTypeLine, field.TypeString)
+	}
+	if value, ok := puo.mutation.FlavorText(); ok {
+		_spec.SetField(printing.FieldFlavorText, field.TypeString, value)
+	}
+	if puo.mutation.FlavorTextCleared() {
+		_spec.ClearField(printing.FieldFlavorText, field.TypeString)
+	}
+	if value, ok := puo.mutation.ContentHash(); ok {
+		_spec.SetField(printing.FieldContentHash, field.TypeString, value)
+	}
+	if puo.mutation.ContentHashCleared() {
+		_spec.ClearField(printing.FieldContentHash, field.TypeString)
+	}
 	if puo.mutation.ArtistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -22,6 +22,8 @@ type Ruling struct {
 	Text string `json:"text,omitempty"`
 	// Date holds the value of the "date" field.
 	Date time.Time `json:"date,omitempty"`
+	// Source holds the value of the "source" field.
+	Source ruling.Source `json:"source,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RulingQuery when eager-loading is set.
 	Edges        RulingEdges `json:"edges"`
@@ -56,7 +58,7 @@ func (*Ruling) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case ruling.FieldID:
 			values[i] = new(sql.NullInt64)
-		case ruling.FieldText:
+		case ruling.FieldText, ruling.FieldSource:
 			values[i] = new(sql.NullString)
 		case ruling.FieldDate:
 			values[i] = new(sql.NullTime)
@@ -95,6 +97,12 @@ func (r *Ruling) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.Date = value.Time
 			}
+		case ruling.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				r.Source = ruling.Source(value.String)
+			}
 		case ruling.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field ruling_card", value)
@@ -148,6 +156,9 @@ func (r *Ruling) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(r.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", r.Source))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package ruling
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,6 +18,8 @@ const (
 	FieldText = "text"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
 	// EdgeCard holds the string denoting the card edge name in mutations.
 	EdgeCard = "card"
 	// Table holds the table name of the ruling in the database.
@@ -34,6 +38,7 @@ var Columns = []string{
 	FieldID,
 	FieldText,
 	FieldDate,
+	FieldSource,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "rulings"
@@ -62,6 +67,32 @@ var (
 	TextValidator func(string) error
 )
 
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceWotc is the default value of the Source enum.
+const DefaultSource = SourceWotc
+
+// Source values.
+const (
+	SourceWotc     Source = "wotc"
+	SourceScryfall Source = "scryfall"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceWotc, SourceScryfall:
+		return nil
+	default:
+		return fmt.Errorf("ruling: invalid enum value for source field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Ruling queries.
 type OrderOption func(*sql.Selector)
 
@@ -80,6 +111,11 @@ func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
 // ByCardField orders the results by card field.
 func ByCardField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
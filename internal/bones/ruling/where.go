@@ -170,6 +170,26 @@ func DateLTE(v time.Time) predicate.Ruling {
 	return predicate.Ruling(sql.FieldLTE(FieldDate, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Ruling {
+	return predicate.Ruling(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Ruling {
+	return predicate.Ruling(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Ruling {
+	return predicate.Ruling(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Ruling {
+	return predicate.Ruling(sql.FieldNotIn(FieldSource, vs...))
+}
+
 // HasCard applies the HasEdge predicate on the "card" edge.
 func HasCard() predicate.Ruling {
 	return predicate.Ruling(func(s *sql.Selector) {
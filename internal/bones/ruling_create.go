@@ -33,6 +33,20 @@ func (rc *RulingCreate) SetDate(t time.Time) *RulingCreate {
 	return rc
 }
 
+// SetSource sets the "source" field.
+func (rc *RulingCreate) SetSource(r ruling.Source) *RulingCreate {
+	rc.mutation.SetSource(r)
+	return rc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (rc *RulingCreate) SetNillableSource(r *ruling.Source) *RulingCreate {
+	if r != nil {
+		rc.SetSource(*r)
+	}
+	return rc
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (rc *RulingCreate) SetCardID(id int) *RulingCreate {
 	rc.mutation.SetCardID(id)
@@ -59,6 +73,7 @@ func (rc *RulingCreate) Mutation() *RulingMutation {
 
 // Save creates the Ruling in the database.
 func (rc *RulingCreate) Save(ctx context.Context) (*Ruling, error) {
+	rc.defaults()
 	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
 }
 
@@ -84,6 +99,14 @@ func (rc *RulingCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (rc *RulingCreate) defaults() {
+	if _, ok := rc.mutation.Source(); !ok {
+		v := ruling.DefaultSource
+		rc.mutation.SetSource(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (rc *RulingCreate) check() error {
 	if _, ok := rc.mutation.Text(); !ok {
@@ -97,6 +120,14 @@ func (rc *RulingCreate) check() error {
 	if _, ok := rc.mutation.Date(); !ok {
 		return &ValidationError{Name: "date", err: errors.New(`bones: missing required field "Ruling.date"`)}
 	}
+	if _, ok := rc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`bones: missing required field "Ruling.source"`)}
+	}
+	if v, ok := rc.mutation.Source(); ok {
+		if err := ruling.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`bones: validator failed for field "Ruling.source": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -131,6 +162,10 @@ func (rc *RulingCreate) createSpec() (*Ruling, *sqlgraph.CreateSpec) {
 		_spec.SetField(ruling.FieldDate, field.TypeTime, value)
 		_node.Date = value
 	}
+	if value, ok := rc.mutation.Source(); ok {
+		_spec.SetField(ruling.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
 	if nodes := rc.mutation.CardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -169,6 +204,7 @@ func (rcb *RulingCreateBulk) Save(ctx context.Context) ([]*Ruling, error) {
 	for i := range rcb.builders {
 		func(i int, root context.Context) {
 			builder := rcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*RulingMutation)
 				if !ok {
@@ -57,6 +57,20 @@ func (ru *RulingUpdate) SetNillableDate(t *time.Time) *RulingUpdate {
 	return ru
 }
 
+// SetSource sets the "source" field.
+func (ru *RulingUpdate) SetSource(r ruling.Source) *RulingUpdate {
+	ru.mutation.SetSource(r)
+	return ru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ru *RulingUpdate) SetNillableSource(r *ruling.Source) *RulingUpdate {
+	if r != nil {
+		ru.SetSource(*r)
+	}
+	return ru
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (ru *RulingUpdate) SetCardID(id int) *RulingUpdate {
 	ru.mutation.SetCardID(id)
@@ -121,6 +135,11 @@ func (ru *RulingUpdate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`bones: validator failed for field "Ruling.text": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.Source(); ok {
+		if err := ruling.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`bones: validator failed for field "Ruling.source": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -142,6 +161,9 @@ func (ru *RulingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.Date(); ok {
 		_spec.SetField(ruling.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := ru.mutation.Source(); ok {
+		_spec.SetField(ruling.FieldSource, field.TypeEnum, value)
+	}
 	if ru.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -219,6 +241,20 @@ func (ruo *RulingUpdateOne) SetNillableDate(t *time.Time) *RulingUpdateOne {
 	return ruo
 }
 
+// SetSource sets the "source" field.
+func (ruo *RulingUpdateOne) SetSource(r ruling.Source) *RulingUpdateOne {
+	ruo.mutation.SetSource(r)
+	return ruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (ruo *RulingUpdateOne) SetNillableSource(r *ruling.Source) *RulingUpdateOne {
+	if r != nil {
+		ruo.SetSource(*r)
+	}
+	return ruo
+}
+
 // SetCardID sets the "card" edge to the Card entity by ID.
 func (ruo *RulingUpdateOne) SetCardID(id int) *RulingUpdateOne {
 	ruo.mutation.SetCardID(id)
@@ -296,6 +332,11 @@ func (ruo *RulingUpdateOne) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`bones: validator failed for field "Ruling.text": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.Source(); ok {
+		if err := ruling.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`bones: validator failed for field "Ruling.source": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -334,6 +375,9 @@ func (ruo *RulingUpdateOne) sqlSave(ctx context.Context) (_node *Ruling, err err
 	if value, ok := ruo.mutation.Date(); ok {
 		_spec.SetField(ruling.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := ruo.mutation.Source(); ok {
+		_spec.SetField(ruling.FieldSource, field.TypeEnum, value)
+	}
 	if ruo.mutation.CardCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -4,8 +4,11 @@ package bones
 
 import (
 	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
+	"github.com/SethCurry/stax/internal/bones/printing"
 	"github.com/SethCurry/stax/internal/bones/printingimage"
 	"github.com/SethCurry/stax/internal/bones/ruling"
 	"github.com/SethCurry/stax/internal/bones/schema"
@@ -37,6 +40,12 @@ func init() {
 			return nil
 		}
 	}()
+	bulkimportFields := schema.BulkImport{}.Fields()
+	_ = bulkimportFields
+	// bulkimportDescType is the schema descriptor for type field.
+	bulkimportDescType := bulkimportFields[0].Descriptor()
+	// bulkimport.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	bulkimport.TypeValidator = bulkimportDescType.Validators[0].(func(string) error)
 	cardFields := schema.Card{}.Fields()
 	_ = cardFields
 	// cardDescName is the schema descriptor for name field.
@@ -62,6 +71,10 @@ func init() {
 	cardDescOracleID := cardFields[1].Descriptor()
 	// card.OracleIDValidator is a validator for the "oracle_id" field. It is called by the builders before save.
 	card.OracleIDValidator = cardDescOracleID.Validators[0].(func(string) error)
+	// cardDescLayout is the schema descriptor for layout field.
+	cardDescLayout := cardFields[3].Descriptor()
+	// card.DefaultLayout holds the default value on creation for the layout field.
+	card.DefaultLayout = cardDescLayout.Default.(string)
 	cardfaceFields := schema.CardFace{}.Fields()
 	_ = cardfaceFields
 	// cardfaceDescName is the schema descriptor for name field.
@@ -91,6 +104,22 @@ func init() {
 	cardfaceDescOracleText := cardfaceFields[2].Descriptor()
 	// cardface.OracleTextValidator is a validator for the "oracle_text" field. It is called by the builders before save.
 	cardface.OracleTextValidator = cardfaceDescOracleText.Validators[0].(func(string) error)
+	// cardfaceDescColorField is the schema descriptor for color_field field.
+	cardfaceDescColorField := cardfaceFields[14].Descriptor()
+	// cardface.DefaultColorField holds the default value on creation for the color_field field.
+	cardface.DefaultColorField = cardfaceDescColorField.Default.(uint8)
+	legalityFields := schema.Legality{}.Fields()
+	_ = legalityFields
+	// legalityDescFormat is the schema descriptor for format field.
+	legalityDescFormat := legalityFields[0].Descriptor()
+	// legality.FormatValidator is a validator for the "format" field. It is called by the builders before save.
+	legality.FormatValidator = legalityDescFormat.Validators[0].(func(string) error)
+	printingFields := schema.Printing{}.Fields()
+	_ = printingFields
+	// printingDescLanguage is the schema descriptor for language field.
+	printingDescLanguage := printingFields[9].Descriptor()
+	// printing.DefaultLanguage holds the default value on creation for the language field.
+	printing.DefaultLanguage = printingDescLanguage.Default.(string)
 	printingimageFields := schema.PrintingImage{}.Fields()
 	_ = printingimageFields
 	// printingimageDescURL is the schema descriptor for url field.
@@ -143,4 +172,12 @@ func init() {
 			return nil
 		}
 	}()
+	// setDescCardCount is the schema descriptor for card_count field.
+	setDescCardCount := setFields[5].Descriptor()
+	// set.DefaultCardCount holds the default value on creation for the card_count field.
+	set.DefaultCardCount = setDescCardCount.Default.(int)
+	// setDescDigital is the schema descriptor for digital field.
+	setDescDigital := setFields[9].Descriptor()
+	// set.DefaultDigital holds the default value on creation for the digital field.
+	set.DefaultDigital = setDescDigital.Default.(bool)
 }
@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// BulkImport records the last time a type of Scryfall bulk data file was
+// loaded, so that it isn't downloaded again until Scryfall updates it.
+type BulkImport struct {
+	ent.Schema
+}
+
+func (BulkImport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").NotEmpty().Unique(),
+		field.Time("updated_at"),
+		field.Time("imported_at"),
+	}
+}
@@ -20,6 +20,9 @@ func (Card) Fields() []ent.Field {
 		field.String("name").NotEmpty().MinLen(CardNameMinLen).MaxLen(CardNameMaxLen),
 		field.String("oracle_id").NotEmpty(),
 		field.Uint8("color_identity"),
+		field.String("layout").Default("normal"),
+		// a hash of everything loaded from Scryfall, to find which rows have changed
+		field.String("content_hash").Optional(),
 	}
 }
 
@@ -27,5 +30,6 @@ func (Card) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("faces", CardFace.Type).Ref("card"),
 		edge.From("rulings", Ruling.Type).Ref("card"),
+		edge.From("legalities", Legality.Type).Ref("card"),
 	}
 }
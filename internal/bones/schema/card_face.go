@@ -25,9 +25,15 @@ func (CardFace) Fields() []ent.Field {
 		field.String("power"),
 		field.String("toughness"),
 		field.String("loyalty"),
+		field.Float32("power_value").Optional().Nillable(),
+		field.Float32("toughness_value").Optional().Nillable(),
+		field.Float32("loyalty_value").Optional().Nillable(),
 		field.String("mana_cost"),
 		field.String("type_line"),
 		field.String("colors"),
+		field.Uint8("color_field").Default(0),
+		// a hash of everything loaded from Scryfall, to find which rows have changed
+		field.String("content_hash").Optional(),
 	}
 }
 
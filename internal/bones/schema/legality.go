@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Legality struct {
+	ent.Schema
+}
+
+func (Legality) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("format").NotEmpty(),
+		field.Enum("legality").Values("legal", "not_legal", "restricted", "banned"),
+	}
+}
+
+func (Legality) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("card", Card.Type).Unique(),
+	}
+}
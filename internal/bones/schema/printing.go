@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Printing struct {
@@ -13,6 +14,23 @@ type Printing struct {
 func (Printing) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("rarity").Values("common", "uncommon", "rare", "mythic", "special", "bonus"),
+		field.Time("released_at").Optional().Nillable(),
+		field.String("illustration_id").Optional(),
+		field.String("scryfall_id").Optional(),
+		field.String("collector_number").Optional(),
+		field.Ints("multiverse_ids").Optional(),
+		field.Int("mtgo_id").Optional().Nillable(),
+		field.Int("arena_id").Optional().Nillable(),
+		field.Int("tcgplayer_id").Optional().Nillable(),
+		// the language the printing is in, and its text as printed in that language,
+		// which is only set for printings that aren't in English
+		field.String("language").Default("en"),
+		field.String("printed_name").Optional(),
+		field.String("printed_text").Optional(),
+		field.String("printed_type_line").Optional(),
+		field.String("flavor_text").Optional(),
+		// a hash of everything loaded from Scryfall, to find which rows have changed
+		field.String("content_hash").Optional(),
 	}
 }
 
@@ -24,3 +42,12 @@ func (Printing) Edges() []ent.Edge {
 		edge.From("images", PrintingImage.Type).Ref("printing"),
 	}
 }
+
+func (Printing) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scryfall_id"),
+		index.Fields("illustration_id"),
+		index.Fields("collector_number").Edges("set"),
+		index.Fields("language"),
+	}
+}
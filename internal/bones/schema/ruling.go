@@ -14,6 +14,7 @@ func (Ruling) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("text").NotEmpty(),
 		field.Time("date"),
+		field.Enum("source").Values("wotc", "scryfall").Default("wotc"),
 	}
 }
 
@@ -21,6 +21,15 @@ func (Set) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty().MinLen(SetNameMinLen).MaxLen(SetNameMaxLen),
 		field.String("code").NotEmpty().MinLen(SetCodeMinLen).MaxLen(SetCodeMaxLen),
+		field.String("scryfall_id").Optional(),
+		field.String("set_type").Optional(),
+		field.Time("released_at").Optional().Nillable(),
+		field.Int("card_count").Default(0),
+		field.String("parent_set_code").Optional(),
+		field.String("block").Optional(),
+		field.String("block_code").Optional(),
+		field.Bool("digital").Default(false),
+		field.String("icon_svg_uri").Optional(),
 	}
 }
 
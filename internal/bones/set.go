@@ -5,6 +5,7 @@ package bones
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,6 +21,24 @@ type Set struct {
 	Name string `json:"name,omitempty"`
 	// Code holds the value of the "code" field.
 	Code string `json:"code,omitempty"`
+	// ScryfallID holds the value of the "scryfall_id" field.
+	ScryfallID string `json:"scryfall_id,omitempty"`
+	// SetType holds the value of the "set_type" field.
+	SetType string `json:"set_type,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// CardCount holds the value of the "card_count" field.
+	CardCount int `json:"card_count,omitempty"`
+	// ParentSetCode holds the value of the "parent_set_code" field.
+	ParentSetCode string `json:"parent_set_code,omitempty"`
+	// Block holds the value of the "block" field.
+	Block string `json:"block,omitempty"`
+	// BlockCode holds the value of the "block_code" field.
+	BlockCode string `json:"block_code,omitempty"`
+	// Digital holds the value of the "digital" field.
+	Digital bool `json:"digital,omitempty"`
+	// IconSvgURI holds the value of the "icon_svg_uri" field.
+	IconSvgURI string `json:"icon_svg_uri,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SetQuery when eager-loading is set.
 	Edges        SetEdges `json:"edges"`
@@ -49,10 +68,14 @@ func (*Set) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case set.FieldID:
+		case set.FieldDigital:
+			values[i] = new(sql.NullBool)
+		case set.FieldID, set.FieldCardCount:
 			values[i] = new(sql.NullInt64)
-		case set.FieldName, set.FieldCode:
+		case set.FieldName, set.FieldCode, set.FieldScryfallID, set.FieldSetType, set.FieldParentSetCode, set.FieldBlock, set.FieldBlockCode, set.FieldIconSvgURI:
 			values[i] = new(sql.NullString)
+		case set.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -86,6 +109,61 @@ func (s *Set) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.Code = value.String
 			}
+		case set.FieldScryfallID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scryfall_id", values[i])
+			} else if value.Valid {
+				s.ScryfallID = value.String
+			}
+		case set.FieldSetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field set_type", values[i])
+			} else if value.Valid {
+				s.SetType = value.String
+			}
+		case set.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				s.ReleasedAt = new(time.Time)
+				*s.ReleasedAt = value.Time
+			}
+		case set.FieldCardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card_count", values[i])
+			} else if value.Valid {
+				s.CardCount = int(value.Int64)
+			}
+		case set.FieldParentSetCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_set_code", values[i])
+			} else if value.Valid {
+				s.ParentSetCode = value.String
+			}
+		case set.FieldBlock:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block", values[i])
+			} else if value.Valid {
+				s.Block = value.String
+			}
+		case set.FieldBlockCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_code", values[i])
+			} else if value.Valid {
+				s.BlockCode = value.String
+			}
+		case set.FieldDigital:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field digital", values[i])
+			} else if value.Valid {
+				s.Digital = value.Bool
+			}
+		case set.FieldIconSvgURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_svg_uri", values[i])
+			} else if value.Valid {
+				s.IconSvgURI = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -132,6 +210,35 @@ func (s *Set) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("code=")
 	builder.WriteString(s.Code)
+	builder.WriteString(", ")
+	builder.WriteString("scryfall_id=")
+	builder.WriteString(s.ScryfallID)
+	builder.WriteString(", ")
+	builder.WriteString("set_type=")
+	builder.WriteString(s.SetType)
+	builder.WriteString(", ")
+	if v := s.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("card_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.CardCount))
+	builder.WriteString(", ")
+	builder.WriteString("parent_set_code=")
+	builder.WriteString(s.ParentSetCode)
+	builder.WriteString(", ")
+	builder.WriteString("block=")
+	builder.WriteString(s.Block)
+	builder.WriteString(", ")
+	builder.WriteString("block_code=")
+	builder.WriteString(s.BlockCode)
+	builder.WriteString(", ")
+	builder.WriteString("digital=")
+	builder.WriteString(fmt.Sprintf("%v", s.Digital))
+	builder.WriteString(", ")
+	builder.WriteString("icon_svg_uri=")
+	builder.WriteString(s.IconSvgURI)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,6 +16,24 @@ const (
 	FieldName = "name"
 	// FieldCode holds the string denoting the code field in the database.
 	FieldCode = "code"
+	// FieldScryfallID holds the string denoting the scryfall_id field in the database.
+	FieldScryfallID = "scryfall_id"
+	// FieldSetType holds the string denoting the set_type field in the database.
+	FieldSetType = "set_type"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldCardCount holds the string denoting the card_count field in the database.
+	FieldCardCount = "card_count"
+	// FieldParentSetCode holds the string denoting the parent_set_code field in the database.
+	FieldParentSetCode = "parent_set_code"
+	// FieldBlock holds the string denoting the block field in the database.
+	FieldBlock = "block"
+	// FieldBlockCode holds the string denoting the block_code field in the database.
+	FieldBlockCode = "block_code"
+	// FieldDigital holds the string denoting the digital field in the database.
+	FieldDigital = "digital"
+	// FieldIconSvgURI holds the string denoting the icon_svg_uri field in the database.
+	FieldIconSvgURI = "icon_svg_uri"
 	// EdgePrintings holds the string denoting the printings edge name in mutations.
 	EdgePrintings = "printings"
 	// Table holds the table name of the set in the database.
@@ -34,6 +52,15 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldCode,
+	FieldScryfallID,
+	FieldSetType,
+	FieldReleasedAt,
+	FieldCardCount,
+	FieldParentSetCode,
+	FieldBlock,
+	FieldBlockCode,
+	FieldDigital,
+	FieldIconSvgURI,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -51,6 +78,10 @@ var (
 	NameValidator func(string) error
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	CodeValidator func(string) error
+	// DefaultCardCount holds the default value on creation for the "card_count" field.
+	DefaultCardCount int
+	// DefaultDigital holds the default value on creation for the "digital" field.
+	DefaultDigital bool
 )
 
 // OrderOption defines the ordering options for the Set queries.
@@ -71,6 +102,51 @@ func ByCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCode, opts...).ToFunc()
 }
 
+// ByScryfallID orders the results by the scryfall_id field.
+func ByScryfallID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScryfallID, opts...).ToFunc()
+}
+
+// BySetType orders the results by the set_type field.
+func BySetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSetType, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByCardCount orders the results by the card_count field.
+func ByCardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardCount, opts...).ToFunc()
+}
+
+// ByParentSetCode orders the results by the parent_set_code field.
+func ByParentSetCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentSetCode, opts...).ToFunc()
+}
+
+// ByBlock orders the results by the block field.
+func ByBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlock, opts...).ToFunc()
+}
+
+// ByBlockCode orders the results by the block_code field.
+func ByBlockCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockCode, opts...).ToFunc()
+}
+
+// ByDigital orders the results by the digital field.
+func ByDigital(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigital, opts...).ToFunc()
+}
+
+// ByIconSvgURI orders the results by the icon_svg_uri field.
+func ByIconSvgURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconSvgURI, opts...).ToFunc()
+}
+
 // ByPrintingsCount orders the results by printings count.
 func ByPrintingsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
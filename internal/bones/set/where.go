@@ -3,6 +3,8 @@
 package set
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/SethCurry/stax/internal/bones/predicate"
@@ -63,6 +65,51 @@ func Code(v string) predicate.Set {
 	return predicate.Set(sql.FieldEQ(FieldCode, v))
 }
 
+// ScryfallID applies equality check predicate on the "scryfall_id" field. It's identical to ScryfallIDEQ.
+func ScryfallID(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldScryfallID, v))
+}
+
+// SetType applies equality check predicate on the "set_type" field. It's identical to SetTypeEQ.
+func SetType(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldSetType, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CardCount applies equality check predicate on the "card_count" field. It's identical to CardCountEQ.
+func CardCount(v int) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldCardCount, v))
+}
+
+// ParentSetCode applies equality check predicate on the "parent_set_code" field. It's identical to ParentSetCodeEQ.
+func ParentSetCode(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldParentSetCode, v))
+}
+
+// Block applies equality check predicate on the "block" field. It's identical to BlockEQ.
+func Block(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldBlock, v))
+}
+
+// BlockCode applies equality check predicate on the "block_code" field. It's identical to BlockCodeEQ.
+func BlockCode(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldBlockCode, v))
+}
+
+// Digital applies equality check predicate on the "digital" field. It's identical to DigitalEQ.
+func Digital(v bool) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldDigital, v))
+}
+
+// IconSvgURI applies equality check predicate on the "icon_svg_uri" field. It's identical to IconSvgURIEQ.
+func IconSvgURI(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldIconSvgURI, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Set {
 	return predicate.Set(sql.FieldEQ(FieldName, v))
@@ -193,6 +240,556 @@ func CodeContainsFold(v string) predicate.Set {
 	return predicate.Set(sql.FieldContainsFold(FieldCode, v))
 }
 
+// ScryfallIDEQ applies the EQ predicate on the "scryfall_id" field.
+func ScryfallIDEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldScryfallID, v))
+}
+
+// ScryfallIDNEQ applies the NEQ predicate on the "scryfall_id" field.
+func ScryfallIDNEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldScryfallID, v))
+}
+
+// ScryfallIDIn applies the In predicate on the "scryfall_id" field.
+func ScryfallIDIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldScryfallID, vs...))
+}
+
+// ScryfallIDNotIn applies the NotIn predicate on the "scryfall_id" field.
+func ScryfallIDNotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldScryfallID, vs...))
+}
+
+// ScryfallIDGT applies the GT predicate on the "scryfall_id" field.
+func ScryfallIDGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldScryfallID, v))
+}
+
+// ScryfallIDGTE applies the GTE predicate on the "scryfall_id" field.
+func ScryfallIDGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldScryfallID, v))
+}
+
+// ScryfallIDLT applies the LT predicate on the "scryfall_id" field.
+func ScryfallIDLT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldScryfallID, v))
+}
+
+// ScryfallIDLTE applies the LTE predicate on the "scryfall_id" field.
+func ScryfallIDLTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldScryfallID, v))
+}
+
+// ScryfallIDContains applies the Contains predicate on the "scryfall_id" field.
+func ScryfallIDContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldScryfallID, v))
+}
+
+// ScryfallIDHasPrefix applies the HasPrefix predicate on the "scryfall_id" field.
+func ScryfallIDHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldScryfallID, v))
+}
+
+// ScryfallIDHasSuffix applies the HasSuffix predicate on the "scryfall_id" field.
+func ScryfallIDHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldScryfallID, v))
+}
+
+// ScryfallIDIsNil applies the IsNil predicate on the "scryfall_id" field.
+func ScryfallIDIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldScryfallID))
+}
+
+// ScryfallIDNotNil applies the NotNil predicate on the "scryfall_id" field.
+func ScryfallIDNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldScryfallID))
+}
+
+// ScryfallIDEqualFold applies the EqualFold predicate on the "scryfall_id" field.
+func ScryfallIDEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldScryfallID, v))
+}
+
+// ScryfallIDContainsFold applies the ContainsFold predicate on the "scryfall_id" field.
+func ScryfallIDContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldScryfallID, v))
+}
+
+// SetTypeEQ applies the EQ predicate on the "set_type" field.
+func SetTypeEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldSetType, v))
+}
+
+// SetTypeNEQ applies the NEQ predicate on the "set_type" field.
+func SetTypeNEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldSetType, v))
+}
+
+// SetTypeIn applies the In predicate on the "set_type" field.
+func SetTypeIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldSetType, vs...))
+}
+
+// SetTypeNotIn applies the NotIn predicate on the "set_type" field.
+func SetTypeNotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldSetType, vs...))
+}
+
+// SetTypeGT applies the GT predicate on the "set_type" field.
+func SetTypeGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldSetType, v))
+}
+
+// SetTypeGTE applies the GTE predicate on the "set_type" field.
+func SetTypeGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldSetType, v))
+}
+
+// SetTypeLT applies the LT predicate on the "set_type" field.
+func SetTypeLT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldSetType, v))
+}
+
+// SetTypeLTE applies the LTE predicate on the "set_type" field.
+func SetTypeLTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldSetType, v))
+}
+
+// SetTypeContains applies the Contains predicate on the "set_type" field.
+func SetTypeContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldSetType, v))
+}
+
+// SetTypeHasPrefix applies the HasPrefix predicate on the "set_type" field.
+func SetTypeHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldSetType, v))
+}
+
+// SetTypeHasSuffix applies the HasSuffix predicate on the "set_type" field.
+func SetTypeHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldSetType, v))
+}
+
+// SetTypeIsNil applies the IsNil predicate on the "set_type" field.
+func SetTypeIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldSetType))
+}
+
+// SetTypeNotNil applies the NotNil predicate on the "set_type" field.
+func SetTypeNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldSetType))
+}
+
+// SetTypeEqualFold applies the EqualFold predicate on the "set_type" field.
+func SetTypeEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldSetType, v))
+}
+
+// SetTypeContainsFold applies the ContainsFold predicate on the "set_type" field.
+func SetTypeContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldSetType, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// CardCountEQ applies the EQ predicate on the "card_count" field.
+func CardCountEQ(v int) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldCardCount, v))
+}
+
+// CardCountNEQ applies the NEQ predicate on the "card_count" field.
+func CardCountNEQ(v int) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldCardCount, v))
+}
+
+// CardCountIn applies the In predicate on the "card_count" field.
+func CardCountIn(vs ...int) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldCardCount, vs...))
+}
+
+// CardCountNotIn applies the NotIn predicate on the "card_count" field.
+func CardCountNotIn(vs ...int) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldCardCount, vs...))
+}
+
+// CardCountGT applies the GT predicate on the "card_count" field.
+func CardCountGT(v int) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldCardCount, v))
+}
+
+// CardCountGTE applies the GTE predicate on the "card_count" field.
+func CardCountGTE(v int) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldCardCount, v))
+}
+
+// CardCountLT applies the LT predicate on the "card_count" field.
+func CardCountLT(v int) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldCardCount, v))
+}
+
+// CardCountLTE applies the LTE predicate on the "card_count" field.
+func CardCountLTE(v int) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldCardCount, v))
+}
+
+// ParentSetCodeEQ applies the EQ predicate on the "parent_set_code" field.
+func ParentSetCodeEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldParentSetCode, v))
+}
+
+// ParentSetCodeNEQ applies the NEQ predicate on the "parent_set_code" field.
+func ParentSetCodeNEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldParentSetCode, v))
+}
+
+// ParentSetCodeIn applies the In predicate on the "parent_set_code" field.
+func ParentSetCodeIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldParentSetCode, vs...))
+}
+
+// ParentSetCodeNotIn applies the NotIn predicate on the "parent_set_code" field.
+func ParentSetCodeNotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldParentSetCode, vs...))
+}
+
+// ParentSetCodeGT applies the GT predicate on the "parent_set_code" field.
+func ParentSetCodeGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldParentSetCode, v))
+}
+
+// ParentSetCodeGTE applies the GTE predicate on the "parent_set_code" field.
+func ParentSetCodeGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldParentSetCode, v))
+}
+
+// ParentSetCodeLT applies the LT predicate on the "parent_set_code" field.
+func ParentSetCodeLT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldParentSetCode, v))
+}
+
+// ParentSetCodeLTE applies the LTE predicate on the "parent_set_code" field.
+func ParentSetCodeLTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldParentSetCode, v))
+}
+
+// ParentSetCodeContains applies the Contains predicate on the "parent_set_code" field.
+func ParentSetCodeContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldParentSetCode, v))
+}
+
+// ParentSetCodeHasPrefix applies the HasPrefix predicate on the "parent_set_code" field.
+func ParentSetCodeHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldParentSetCode, v))
+}
+
+// ParentSetCodeHasSuffix applies the HasSuffix predicate on the "parent_set_code" field.
+func ParentSetCodeHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldParentSetCode, v))
+}
+
+// ParentSetCodeIsNil applies the IsNil predicate on the "parent_set_code" field.
+func ParentSetCodeIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldParentSetCode))
+}
+
+// ParentSetCodeNotNil applies the NotNil predicate on the "parent_set_code" field.
+func ParentSetCodeNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldParentSetCode))
+}
+
+// ParentSetCodeEqualFold applies the EqualFold predicate on the "parent_set_code" field.
+func ParentSetCodeEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldParentSetCode, v))
+}
+
+// ParentSetCodeContainsFold applies the ContainsFold predicate on the "parent_set_code" field.
+func ParentSetCodeContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldParentSetCode, v))
+}
+
+// BlockEQ applies the EQ predicate on the "block" field.
+func BlockEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldBlock, v))
+}
+
+// BlockNEQ applies the NEQ predicate on the "block" field.
+func BlockNEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldBlock, v))
+}
+
+// BlockIn applies the In predicate on the "block" field.
+func BlockIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldBlock, vs...))
+}
+
+// BlockNotIn applies the NotIn predicate on the "block" field.
+func BlockNotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldBlock, vs...))
+}
+
+// BlockGT applies the GT predicate on the "block" field.
+func BlockGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldBlock, v))
+}
+
+// BlockGTE applies the GTE predicate on the "block" field.
+func BlockGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldBlock, v))
+}
+
+// BlockLT applies the LT predicate on the "block" field.
+func BlockLT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldBlock, v))
+}
+
+// BlockLTE applies the LTE predicate on the "block" field.
+func BlockLTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldBlock, v))
+}
+
+// BlockContains applies the Contains predicate on the "block" field.
+func BlockContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldBlock, v))
+}
+
+// BlockHasPrefix applies the HasPrefix predicate on the "block" field.
+func BlockHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldBlock, v))
+}
+
+// BlockHasSuffix applies the HasSuffix predicate on the "block" field.
+func BlockHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldBlock, v))
+}
+
+// BlockIsNil applies the IsNil predicate on the "block" field.
+func BlockIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldBlock))
+}
+
+// BlockNotNil applies the NotNil predicate on the "block" field.
+func BlockNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldBlock))
+}
+
+// BlockEqualFold applies the EqualFold predicate on the "block" field.
+func BlockEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldBlock, v))
+}
+
+// BlockContainsFold applies the ContainsFold predicate on the "block" field.
+func BlockContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldBlock, v))
+}
+
+// BlockCodeEQ applies the EQ predicate on the "block_code" field.
+func BlockCodeEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldBlockCode, v))
+}
+
+// BlockCodeNEQ applies the NEQ predicate on the "block_code" field.
+func BlockCodeNEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldBlockCode, v))
+}
+
+// BlockCodeIn applies the In predicate on the "block_code" field.
+func BlockCodeIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldBlockCode, vs...))
+}
+
+// BlockCodeNotIn applies the NotIn predicate on the "block_code" field.
+func BlockCodeNotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldBlockCode, vs...))
+}
+
+// BlockCodeGT applies the GT predicate on the "block_code" field.
+func BlockCodeGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldBlockCode, v))
+}
+
+// BlockCodeGTE applies the GTE predicate on the "block_code" field.
+func BlockCodeGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldBlockCode, v))
+}
+
+// BlockCodeLT applies the LT predicate on the "block_code" field.
+func BlockCodeLT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldBlockCode, v))
+}
+
+// BlockCodeLTE applies the LTE predicate on the "block_code" field.
+func BlockCodeLTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldBlockCode, v))
+}
+
+// BlockCodeContains applies the Contains predicate on the "block_code" field.
+func BlockCodeContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldBlockCode, v))
+}
+
+// BlockCodeHasPrefix applies the HasPrefix predicate on the "block_code" field.
+func BlockCodeHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldBlockCode, v))
+}
+
+// BlockCodeHasSuffix applies the HasSuffix predicate on the "block_code" field.
+func BlockCodeHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldBlockCode, v))
+}
+
+// BlockCodeIsNil applies the IsNil predicate on the "block_code" field.
+func BlockCodeIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldBlockCode))
+}
+
+// BlockCodeNotNil applies the NotNil predicate on the "block_code" field.
+func BlockCodeNotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldBlockCode))
+}
+
+// BlockCodeEqualFold applies the EqualFold predicate on the "block_code" field.
+func BlockCodeEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldBlockCode, v))
+}
+
+// BlockCodeContainsFold applies the ContainsFold predicate on the "block_code" field.
+func BlockCodeContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldBlockCode, v))
+}
+
+// DigitalEQ applies the EQ predicate on the "digital" field.
+func DigitalEQ(v bool) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldDigital, v))
+}
+
+// DigitalNEQ applies the NEQ predicate on the "digital" field.
+func DigitalNEQ(v bool) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldDigital, v))
+}
+
+// IconSvgURIEQ applies the EQ predicate on the "icon_svg_uri" field.
+func IconSvgURIEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldEQ(FieldIconSvgURI, v))
+}
+
+// IconSvgURINEQ applies the NEQ predicate on the "icon_svg_uri" field.
+func IconSvgURINEQ(v string) predicate.Set {
+	return predicate.Set(sql.FieldNEQ(FieldIconSvgURI, v))
+}
+
+// IconSvgURIIn applies the In predicate on the "icon_svg_uri" field.
+func IconSvgURIIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldIn(FieldIconSvgURI, vs...))
+}
+
+// IconSvgURINotIn applies the NotIn predicate on the "icon_svg_uri" field.
+func IconSvgURINotIn(vs ...string) predicate.Set {
+	return predicate.Set(sql.FieldNotIn(FieldIconSvgURI, vs...))
+}
+
+// IconSvgURIGT applies the GT predicate on the "icon_svg_uri" field.
+func IconSvgURIGT(v string) predicate.Set {
+	return predicate.Set(sql.FieldGT(FieldIconSvgURI, v))
+}
+
+// IconSvgURIGTE applies the GTE predicate on the "icon_svg_uri" field.
+func IconSvgURIGTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldGTE(FieldIconSvgURI, v))
+}
+
+// IconSvgURILT applies the LT predicate on the "icon_svg_uri" field.
+func IconSvgURILT(v string) predicate.Set {
+	return predicate.Set(sql.FieldLT(FieldIconSvgURI, v))
+}
+
+// IconSvgURILTE applies the LTE predicate on the "icon_svg_uri" field.
+func IconSvgURILTE(v string) predicate.Set {
+	return predicate.Set(sql.FieldLTE(FieldIconSvgURI, v))
+}
+
+// IconSvgURIContains applies the Contains predicate on the "icon_svg_uri" field.
+func IconSvgURIContains(v string) predicate.Set {
+	return predicate.Set(sql.FieldContains(FieldIconSvgURI, v))
+}
+
+// IconSvgURIHasPrefix applies the HasPrefix predicate on the "icon_svg_uri" field.
+func IconSvgURIHasPrefix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasPrefix(FieldIconSvgURI, v))
+}
+
+// IconSvgURIHasSuffix applies the HasSuffix predicate on the "icon_svg_uri" field.
+func IconSvgURIHasSuffix(v string) predicate.Set {
+	return predicate.Set(sql.FieldHasSuffix(FieldIconSvgURI, v))
+}
+
+// IconSvgURIIsNil applies the IsNil predicate on the "icon_svg_uri" field.
+func IconSvgURIIsNil() predicate.Set {
+	return predicate.Set(sql.FieldIsNull(FieldIconSvgURI))
+}
+
+// IconSvgURINotNil applies the NotNil predicate on the "icon_svg_uri" field.
+func IconSvgURINotNil() predicate.Set {
+	return predicate.Set(sql.FieldNotNull(FieldIconSvgURI))
+}
+
+// IconSvgURIEqualFold applies the EqualFold predicate on the "icon_svg_uri" field.
+func IconSvgURIEqualFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldEqualFold(FieldIconSvgURI, v))
+}
+
+// IconSvgURIContainsFold applies the ContainsFold predicate on the "icon_svg_uri" field.
+func IconSvgURIContainsFold(v string) predicate.Set {
+	return predicate.Set(sql.FieldContainsFold(FieldIconSvgURI, v))
+}
+
 // HasPrintings applies the HasEdge predicate on the "printings" edge.
 func HasPrintings() predicate.Set {
 	return predicate.Set(func(s *sql.Selector) {
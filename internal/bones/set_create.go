@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -32,6 +33,132 @@ func (sc *SetCreate) SetCode(s string) *SetCreate {
 	return sc
 }
 
+// SetScryfallID sets the "scryfall_id" field.
+func (sc *SetCreate) SetScryfallID(s string) *SetCreate {
+	sc.mutation.SetScryfallID(s)
+	return sc
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (sc *SetCreate) SetNillableScryfallID(s *string) *SetCreate {
+	if s != nil {
+		sc.SetScryfallID(*s)
+	}
+	return sc
+}
+
+// SetSetType sets the "set_type" field.
+func (sc *SetCreate) SetSetType(s string) *SetCreate {
+	sc.mutation.SetSetType(s)
+	return sc
+}
+
+// SetNillableSetType sets the "set_type" field if the given value is not nil.
+func (sc *SetCreate) SetNillableSetType(s *string) *SetCreate {
+	if s != nil {
+		sc.SetSetType(*s)
+	}
+	return sc
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (sc *SetCreate) SetReleasedAt(t time.Time) *SetCreate {
+	sc.mutation.SetReleasedAt(t)
+	return sc
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (sc *SetCreate) SetNillableReleasedAt(t *time.Time) *SetCreate {
+	if t != nil {
+		sc.SetReleasedAt(*t)
+	}
+	return sc
+}
+
+// SetCardCount sets the "card_count" field.
+func (sc *SetCreate) SetCardCount(i int) *SetCreate {
+	sc.mutation.SetCardCount(i)
+	return sc
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (sc *SetCreate) SetNillableCardCount(i *int) *SetCreate {
+	if i != nil {
+		sc.SetCardCount(*i)
+	}
+	return sc
+}
+
+// SetParentSetCode sets the "parent_set_code" field.
+func (sc *SetCreate) SetParentSetCode(s string) *SetCreate {
+	sc.mutation.SetParentSetCode(s)
+	return sc
+}
+
+// SetNillableParentSetCode sets the "parent_set_code" field if the given value is not nil.
+func (sc *SetCreate) SetNillableParentSetCode(s *string) *SetCreate {
+	if s != nil {
+		sc.SetParentSetCode(*s)
+	}
+	return sc
+}
+
+// SetBlock sets the "block" field.
+func (sc *SetCreate) SetBlock(s string) *SetCreate {
+	sc.mutation.SetBlock(s)
+	return sc
+}
+
+// SetNillableBlock sets the "block" field if the given value is not nil.
+func (sc *SetCreate) SetNillableBlock(s *string) *SetCreate {
+	if s != nil {
+		sc.SetBlock(*s)
+	}
+	return sc
+}
+
+// SetBlockCode sets the "block_code" field.
+func (sc *SetCreate) SetBlockCode(s string) *SetCreate {
+	sc.mutation.SetBlockCode(s)
+	return sc
+}
+
+// SetNillableBlockCode sets the "block_code" field if the given value is not nil.
+func (sc *SetCreate) SetNillableBlockCode(s *string) *SetCreate {
+	if s != nil {
+		sc.SetBlockCode(*s)
+	}
+	return sc
+}
+
+// SetDigital sets the "digital" field.
+func (sc *SetCreate) SetDigital(b bool) *SetCreate {
+	sc.mutation.SetDigital(b)
+	return sc
+}
+
+// SetNillableDigital sets the "digital" field if the given value is not nil.
+func (sc *SetCreate) SetNillableDigital(b *bool) *SetCreate {
+	if b != nil {
+		sc.SetDigital(*b)
+	}
+	return sc
+}
+
+// SetIconSvgURI sets the "icon_svg_uri" field.
+func (sc *SetCreate) SetIconSvgURI(s string) *SetCreate {
+	sc.mutation.SetIconSvgURI(s)
+	return sc
+}
+
+// SetNillableIconSvgURI sets the "icon_svg_uri" field if the given value is not nil.
+func (sc *SetCreate) SetNillableIconSvgURI(s *string) *SetCreate {
+	if s != nil {
+		sc.SetIconSvgURI(*s)
+	}
+	return sc
+}
+
 // AddPrintingIDs adds the "printings" edge to the Printing entity by IDs.
 func (sc *SetCreate) AddPrintingIDs(ids ...int) *SetCreate {
 	sc.mutation.AddPrintingIDs(ids...)
@@ -54,6 +181,7 @@ func (sc *SetCreate) Mutation() *SetMutation {
 
 // Save creates the Set in the database.
 func (sc *SetCreate) Save(ctx context.Context) (*Set, error) {
+	sc.defaults()
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -79,6 +207,18 @@ func (sc *SetCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (sc *SetCreate) defaults() {
+	if _, ok := sc.mutation.CardCount(); !ok {
+		v := set.DefaultCardCount
+		sc.mutation.SetCardCount(v)
+	}
+	if _, ok := sc.mutation.Digital(); !ok {
+		v := set.DefaultDigital
+		sc.mutation.SetDigital(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (sc *SetCreate) check() error {
 	if _, ok := sc.mutation.Name(); !ok {
@@ -97,6 +237,12 @@ func (sc *SetCreate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`bones: validator failed for field "Set.code": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.CardCount(); !ok {
+		return &ValidationError{Name: "card_count", err: errors.New(`bones: missing required field "Set.card_count"`)}
+	}
+	if _, ok := sc.mutation.Digital(); !ok {
+		return &ValidationError{Name: "digital", err: errors.New(`bones: missing required field "Set.digital"`)}
+	}
 	return nil
 }
 
@@ -131,6 +277,42 @@ func (sc *SetCreate) createSpec() (*Set, *sqlgraph.CreateSpec) {
 		_spec.SetField(set.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
+	if value, ok := sc.mutation.ScryfallID(); ok {
+		_spec.SetField(set.FieldScryfallID, field.TypeString, value)
+		_node.ScryfallID = value
+	}
+	if value, ok := sc.mutation.SetType(); ok {
+		_spec.SetField(set.FieldSetType, field.TypeString, value)
+		_node.SetType = value
+	}
+	if value, ok := sc.mutation.ReleasedAt(); ok {
+		_spec.SetField(set.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := sc.mutation.CardCount(); ok {
+		_spec.SetField(set.FieldCardCount, field.TypeInt, value)
+		_node.CardCount = value
+	}
+	if value, ok := sc.mutation.ParentSetCode(); ok {
+		_spec.SetField(set.FieldParentSetCode, field.TypeString, value)
+		_node.ParentSetCode = value
+	}
+	if value, ok := sc.mutation.Block(); ok {
+		_spec.SetField(set.FieldBlock, field.TypeString, value)
+		_node.Block = value
+	}
+	if value, ok := sc.mutation.BlockCode(); ok {
+		_spec.SetField(set.FieldBlockCode, field.TypeString, value)
+		_node.BlockCode = value
+	}
+	if value, ok := sc.mutation.Digital(); ok {
+		_spec.SetField(set.FieldDigital, field.TypeBool, value)
+		_node.Digital = value
+	}
+	if value, ok := sc.mutation.IconSvgURI(); ok {
+		_spec.SetField(set.FieldIconSvgURI, field.TypeString, value)
+		_node.IconSvgURI = value
+	}
 	if nodes := sc.mutation.PrintingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -168,6 +350,7 @@ func (scb *SetCreateBulk) Save(ctx context.Context) ([]*Set, error) {
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*SetMutation)
 				if !ok {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -56,6 +57,181 @@ func (su *SetUpdate) SetNillableCode(s *string) *SetUpdate {
 	return su
 }
 
+// SetScryfallID sets the "scryfall_id" field.
+func (su *SetUpdate) SetScryfallID(s string) *SetUpdate {
+	su.mutation.SetScryfallID(s)
+	return su
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (su *SetUpdate) SetNillableScryfallID(s *string) *SetUpdate {
+	if s != nil {
+		su.SetScryfallID(*s)
+	}
+	return su
+}
+
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (su *SetUpdate) ClearScryfallID() *SetUpdate {
+	su.mutation.ClearScryfallID()
+	return su
+}
+
+// SetSetType sets the "set_type" field.
+func (su *SetUpdate) SetSetType(s string) *SetUpdate {
+	su.mutation.SetSetType(s)
+	return su
+}
+
+// SetNillableSetType sets the "set_type" field if the given value is not nil.
+func (su *SetUpdate) SetNillableSetType(s *string) *SetUpdate {
+	if s != nil {
+		su.SetSetType(*s)
+	}
+	return su
+}
+
+// ClearSetType clears the value of the "set_type" field.
+func (su *SetUpdate) ClearSetType() *SetUpdate {
+	su.mutation.ClearSetType()
+	return su
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (su *SetUpdate) SetReleasedAt(t time.Time) *SetUpdate {
+	su.mutation.SetReleasedAt(t)
+	return su
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (su *SetUpdate) SetNillableReleasedAt(t *time.Time) *SetUpdate {
+	if t != nil {
+		su.SetReleasedAt(*t)
+	}
+	return su
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (su *SetUpdate) ClearReleasedAt() *SetUpdate {
+	su.mutation.ClearReleasedAt()
+	return su
+}
+
+// SetCardCount sets the "card_count" field.
+func (su *SetUpdate) SetCardCount(i int) *SetUpdate {
+	su.mutation.ResetCardCount()
+	su.mutation.SetCardCount(i)
+	return su
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (su *SetUpdate) SetNillableCardCount(i *int) *SetUpdate {
+	if i != nil {
+		su.SetCardCount(*i)
+	}
+	return su
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (su *SetUpdate) AddCardCount(i int) *SetUpdate {
+	su.mutation.AddCardCount(i)
+	return su
+}
+
+// SetParentSetCode sets the "parent_set_code" field.
+func (su *SetUpdate) SetParentSetCode(s string) *SetUpdate {
+	su.mutation.SetParentSetCode(s)
+	return su
+}
+
+// SetNillableParentSetCode sets the "parent_set_code" field if the given value is not nil.
+func (su *SetUpdate) SetNillableParentSetCode(s *string) *SetUpdate {
+	if s != nil {
+		su.SetParentSetCode(*s)
+	}
+	return su
+}
+
+// ClearParentSetCode clears the value of the "parent_set_code" field.
+func (su *SetUpdate) ClearParentSetCode() *SetUpdate {
+	su.mutation.ClearParentSetCode()
+	return su
+}
+
+// SetBlock sets the "block" field.
+func (su *SetUpdate) SetBlock(s string) *SetUpdate {
+	su.mutation.SetBlock(s)
+	return su
+}
+
+// SetNillableBlock sets the "block" field if the given value is not nil.
+func (su *SetUpdate) SetNillableBlock(s *string) *SetUpdate {
+	if s != nil {
+		su.SetBlock(*s)
+	}
+	return su
+}
+
+// ClearBlock clears the value of the "block" field.
+func (su *SetUpdate) ClearBlock() *SetUpdate {
+	su.mutation.ClearBlock()
+	return su
+}
+
+// SetBlockCode sets the "block_code" field.
+func (su *SetUpdate) SetBlockCode(s string) *SetUpdate {
+	su.mutation.SetBlockCode(s)
+	return su
+}
+
+// SetNillableBlockCode sets the "block_code" field if the given value is not nil.
+func (su *SetUpdate) SetNillableBlockCode(s *string) *SetUpdate {
+	if s != nil {
+		su.SetBlockCode(*s)
+	}
+	return su
+}
+
+// ClearBlockCode clears the value of the "block_code" field.
+func (su *SetUpdate) ClearBlockCode() *SetUpdate {
+	su.mutation.ClearBlockCode()
+	return su
+}
+
+// SetDigital sets the "digital" field.
+func (su *SetUpdate) SetDigital(b bool) *SetUpdate {
+	su.mutation.SetDigital(b)
+	return su
+}
+
+// SetNillableDigital sets the "digital" field if the given value is not nil.
+func (su *SetUpdate) SetNillableDigital(b *bool) *SetUpdate {
+	if b != nil {
+		su.SetDigital(*b)
+	}
+	return su
+}
+
+// SetIconSvgURI sets the "icon_svg_uri" field.
+func (su *SetUpdate) SetIconSvgURI(s string) *SetUpdate {
+	su.mutation.SetIconSvgURI(s)
+	return su
+}
+
+// SetNillableIconSvgURI sets the "icon_svg_uri" field if the given value is not nil.
+func (su *SetUpdate) SetNillableIconSvgURI(s *string) *SetUpdate {
+	if s != nil {
+		su.SetIconSvgURI(*s)
+	}
+	return su
+}
+
+// ClearIconSvgURI clears the value of the "icon_svg_uri" field.
+func (su *SetUpdate) ClearIconSvgURI() *SetUpdate {
+	su.mutation.ClearIconSvgURI()
+	return su
+}
+
 // AddPrintingIDs adds the "printings" edge to the Printing entity by IDs.
 func (su *SetUpdate) AddPrintingIDs(ids ...int) *SetUpdate {
 	su.mutation.AddPrintingIDs(ids...)
@@ -157,6 +333,57 @@ func (su *SetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.Code(); ok {
 		_spec.SetField(set.FieldCode, field.TypeString, value)
 	}
+	if value, ok := su.mutation.ScryfallID(); ok {
+		_spec.SetField(set.FieldScryfallID, field.TypeString, value)
+	}
+	if su.mutation.ScryfallIDCleared() {
+		_spec.ClearField(set.FieldScryfallID, field.TypeString)
+	}
+	if value, ok := su.mutation.SetType(); ok {
+		_spec.SetField(set.FieldSetType, field.TypeString, value)
+	}
+	if su.mutation.SetTypeCleared() {
+		_spec.ClearField(set.FieldSetType, field.TypeString)
+	}
+	if value, ok := su.mutation.ReleasedAt(); ok {
+		_spec.SetField(set.FieldReleasedAt, field.TypeTime, value)
+	}
+	if su.mutation.ReleasedAtCleared() {
+		_spec.ClearField(set.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.CardCount(); ok {
+		_spec.SetField(set.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedCardCount(); ok {
+		_spec.AddField(set.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.ParentSetCode(); ok {
+		_spec.SetField(set.FieldParentSetCode, field.TypeString, value)
+	}
+	if su.mutation.ParentSetCodeCleared() {
+		_spec.ClearField(set.FieldParentSetCode, field.TypeString)
+	}
+	if value, ok := su.mutation.Block(); ok {
+		_spec.SetField(set.FieldBlock, field.TypeString, value)
+	}
+	if su.mutation.BlockCleared() {
+		_spec.ClearField(set.FieldBlock, field.TypeString)
+	}
+	if value, ok := su.mutation.BlockCode(); ok {
+		_spec.SetField(set.FieldBlockCode, field.TypeString, value)
+	}
+	if su.mutation.BlockCodeCleared() {
+		_spec.ClearField(set.FieldBlockCode, field.TypeString)
+	}
+	if value, ok := su.mutation.Digital(); ok {
+		_spec.SetField(set.FieldDigital, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.IconSvgURI(); ok {
+		_spec.SetField(set.FieldIconSvgURI, field.TypeString, value)
+	}
+	if su.mutation.IconSvgURICleared() {
+		_spec.ClearField(set.FieldIconSvgURI, field.TypeString)
+	}
 	if su.mutation.PrintingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -250,6 +477,181 @@ func (suo *SetUpdateOne) SetNillableCode(s *string) *SetUpdateOne {
 	return suo
 }
 
+// SetScryfallID sets the "scryfall_id" field.
+func (suo *SetUpdateOne) SetScryfallID(s string) *SetUpdateOne {
+	suo.mutation.SetScryfallID(s)
+	return suo
+}
+
+// SetNillableScryfallID sets the "scryfall_id" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableScryfallID(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetScryfallID(*s)
+	}
+	return suo
+}
+
+// ClearScryfallID clears the value of the "scryfall_id" field.
+func (suo *SetUpdateOne) ClearScryfallID() *SetUpdateOne {
+	suo.mutation.ClearScryfallID()
+	return suo
+}
+
+// SetSetType sets the "set_type" field.
+func (suo *SetUpdateOne) SetSetType(s string) *SetUpdateOne {
+	suo.mutation.SetSetType(s)
+	return suo
+}
+
+// SetNillableSetType sets the "set_type" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableSetType(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetSetType(*s)
+	}
+	return suo
+}
+
+// ClearSetType clears the value of the "set_type" field.
+func (suo *SetUpdateOne) ClearSetType() *SetUpdateOne {
+	suo.mutation.ClearSetType()
+	return suo
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (suo *SetUpdateOne) SetReleasedAt(t time.Time) *SetUpdateOne {
+	suo.mutation.SetReleasedAt(t)
+	return suo
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableReleasedAt(t *time.Time) *SetUpdateOne {
+	if t != nil {
+		suo.SetReleasedAt(*t)
+	}
+	return suo
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (suo *SetUpdateOne) ClearReleasedAt() *SetUpdateOne {
+	suo.mutation.ClearReleasedAt()
+	return suo
+}
+
+// SetCardCount sets the "card_count" field.
+func (suo *SetUpdateOne) SetCardCount(i int) *SetUpdateOne {
+	suo.mutation.ResetCardCount()
+	suo.mutation.SetCardCount(i)
+	return suo
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableCardCount(i *int) *SetUpdateOne {
+	if i != nil {
+		suo.SetCardCount(*i)
+	}
+	return suo
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (suo *SetUpdateOne) AddCardCount(i int) *SetUpdateOne {
+	suo.mutation.AddCardCount(i)
+	return suo
+}
+
+// SetParentSetCode sets the "parent_set_code" field.
+func (suo *SetUpdateOne) SetParentSetCode(s string) *SetUpdateOne {
+	suo.mutation.SetParentSetCode(s)
+	return suo
+}
+
+// SetNillableParentSetCode sets the "parent_set_code" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableParentSetCode(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetParentSetCode(*s)
+	}
+	return suo
+}
+
+// ClearParentSetCode clears the value of the "parent_set_code" field.
+func (suo *SetUpdateOne) ClearParentSetCode() *SetUpdateOne {
+	suo.mutation.ClearParentSetCode()
+	return suo
+}
+
+// SetBlock sets the "block" field.
+func (suo *SetUpdateOne) SetBlock(s string) *SetUpdateOne {
+	suo.mutation.SetBlock(s)
+	return suo
+}
+
+// SetNillableBlock sets the "block" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableBlock(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetBlock(*s)
+	}
+	return suo
+}
+
+// ClearBlock clears the value of the "block" field.
+func (suo *SetUpdateOne) ClearBlock() *SetUpdateOne {
+	suo.mutation.ClearBlock()
+	return suo
+}
+
+// SetBlockCode sets the "block_code" field.
+func (suo *SetUpdateOne) SetBlockCode(s string) *SetUpdateOne {
+	suo.mutation.SetBlockCode(s)
+	return suo
+}
+
+// SetNillableBlockCode sets the "block_code" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableBlockCode(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetBlockCode(*s)
+	}
+	return suo
+}
+
+// ClearBlockCode clears the value of the "block_code" field.
+func (suo *SetUpdateOne) ClearBlockCode() *SetUpdateOne {
+	suo.mutation.ClearBlockCode()
+	return suo
+}
+
+// SetDigital sets the "digital" field.
+func (suo *SetUpdateOne) SetDigital(b bool) *SetUpdateOne {
+	suo.mutation.SetDigital(b)
+	return suo
+}
+
+// SetNillableDigital sets the "digital" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableDigital(b *bool) *SetUpdateOne {
+	if b != nil {
+		suo.SetDigital(*b)
+	}
+	return suo
+}
+
+// SetIconSvgURI sets the "icon_svg_uri" field.
+func (suo *SetUpdateOne) SetIconSvgURI(s string) *SetUpdateOne {
+	suo.mutation.SetIconSvgURI(s)
+	return suo
+}
+
+// SetNillableIconSvgURI sets the "icon_svg_uri" field if the given value is not nil.
+func (suo *SetUpdateOne) SetNillableIconSvgURI(s *string) *SetUpdateOne {
+	if s != nil {
+		suo.SetIconSvgURI(*s)
+	}
+	return suo
+}
+
+// ClearIconSvgURI clears the value of the "icon_svg_uri" field.
+func (suo *SetUpdateOne) ClearIconSvgURI() *SetUpdateOne {
+	suo.mutation.ClearIconSvgURI()
+	return suo
+}
+
 // AddPrintingIDs adds the "printings" edge to the Printing entity by IDs.
 func (suo *SetUpdateOne) AddPrintingIDs(ids ...int) *SetUpdateOne {
 	suo.mutation.AddPrintingIDs(ids...)
@@ -381,6 +783,57 @@ func (suo *SetUpdateOne) sqlSave(ctx context.Context) (_node *Set, err error) {
 	if value, ok := suo.mutation.Code(); ok {
 		_spec.SetField(set.FieldCode, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.ScryfallID(); ok {
+		_spec.SetField(set.FieldScryfallID, field.TypeString, value)
+	}
+	if suo.mutation.ScryfallIDCleared() {
+		_spec.ClearField(set.FieldScryfallID, field.TypeString)
+	}
+	if value, ok := suo.mutation.SetType(); ok {
+		_spec.SetField(set.FieldSetType, field.TypeString, value)
+	}
+	if suo.mutation.SetTypeCleared() {
+		_spec.ClearField(set.FieldSetType, field.TypeString)
+	}
+	if value, ok := suo.mutation.ReleasedAt(); ok {
+		_spec.SetField(set.FieldReleasedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ReleasedAtCleared() {
+		_spec.ClearField(set.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CardCount(); ok {
+		_spec.SetField(set.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedCardCount(); ok {
+		_spec.AddField(set.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.ParentSetCode(); ok {
+		_spec.SetField(set.FieldParentSetCode, field.TypeString, value)
+	}
+	if suo.mutation.ParentSetCodeCleared() {
+		_spec.ClearField(set.FieldParentSetCode, field.TypeString)
+	}
+	if value, ok := suo.mutation.Block(); ok {
+		_spec.SetField(set.FieldBlock, field.TypeString, value)
+	}
+	if suo.mutation.BlockCleared() {
+		_spec.ClearField(set.FieldBlock, field.TypeString)
+	}
+	if value, ok := suo.mutation.BlockCode(); ok {
+		_spec.SetField(set.FieldBlockCode, field.TypeString, value)
+	}
+	if suo.mutation.BlockCodeCleared() {
+		_spec.ClearField(set.FieldBlockCode, field.TypeString)
+	}
+	if value, ok := suo.mutation.Digital(); ok {
+		_spec.SetField(set.FieldDigital, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.IconSvgURI(); ok {
+		_spec.SetField(set.FieldIconSvgURI, field.TypeString, value)
+	}
+	if suo.mutation.IconSvgURICleared() {
+		_spec.ClearField(set.FieldIconSvgURI, field.TypeString)
+	}
 	if suo.mutation.PrintingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
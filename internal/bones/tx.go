@@ -14,10 +14,14 @@ type Tx struct {
 	config
 	// Artist is the client for interacting with the Artist builders.
 	Artist *ArtistClient
+	// BulkImport is the client for interacting with the BulkImport builders.
+	BulkImport *BulkImportClient
 	// Card is the client for interacting with the Card builders.
 	Card *CardClient
 	// CardFace is the client for interacting with the CardFace builders.
 	CardFace *CardFaceClient
+	// Legality is the client for interacting with the Legality builders.
+	Legality *LegalityClient
 	// Printing is the client for interacting with the Printing builders.
 	Printing *PrintingClient
 	// PrintingImage is the client for interacting with the PrintingImage builders.
@@ -158,8 +162,10 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Artist = NewArtistClient(tx.config)
+	tx.BulkImport = NewBulkImportClient(tx.config)
 	tx.Card = NewCardClient(tx.config)
 	tx.CardFace = NewCardFaceClient(tx.config)
+	tx.Legality = NewLegalityClient(tx.config)
 	tx.Printing = NewPrintingClient(tx.config)
 	tx.PrintingImage = NewPrintingImageClient(tx.config)
 	tx.Ruling = NewRulingClient(tx.config)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/SethCurry/stax/internal/api/endpoints"
 	"github.com/SethCurry/stax/internal/api/squid"
+	"github.com/SethCurry/stax/internal/autocomplete"
 	_ "github.com/mattn/go-sqlite3"
 	"go.uber.org/zap"
 )
@@ -19,10 +20,16 @@ func (a *APICmd) Run(ctx *Context) error {
 		ctx.Logger.Fatal("failed to open connection to DB", zap.Error(err))
 	}
 
+	nameIndex, err := autocomplete.LoadIndex(context.Background(), dbConn)
+	if err != nil {
+		ctx.Logger.Fatal("failed to build autocomplete index", zap.Error(err))
+	}
+
+	ctx.Logger.Info("built autocomplete index", zap.Int("names", nameIndex.Len()))
+
 	srv := squid.NewServer(dbConn, ctx.Logger)
 
-	srv.Get("/cards/named", endpoints.CardByName)
-	srv.Get("/cards", endpoints.CardSearch)
+	endpoints.Register(srv, nameIndex)
 
 	err = srv.Serve(a.Listen)
 	if err != nil {
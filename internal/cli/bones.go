@@ -2,10 +2,12 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/http"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"entgo.io/ent/dialect/sql"
 
@@ -15,6 +17,7 @@ import (
 
 	"github.com/SethCurry/stax/internal/bones"
 	"github.com/SethCurry/stax/internal/etl"
+	"github.com/SethCurry/stax/internal/ql"
 	"github.com/SethCurry/stax/pkg/scryfall"
 )
 
@@ -66,8 +69,9 @@ func connectToDatabase(ctx context.Context, logger *zap.Logger, disableJournal b
 
 // BonesCmd is a command group for interacting with the bones database of MTG cards.
 type BonesCmd struct {
-	Load  BonesLoadCmd  `cmd:"" help:"Load cards from the Scryfall API."`
-	Reset BonesResetCmd `cmd:"" help:"Reset the database."`
+	Load   BonesLoadCmd   `cmd:"" help:"Load cards from the Scryfall API."`
+	Reset  BonesResetCmd  `cmd:"" help:"Reset the database."`
+	Search BonesSearchCmd `cmd:"" help:"Search the database for cards."`
 }
 
 type BonesResetCmd struct{}
@@ -93,8 +97,64 @@ func (r *BonesResetCmd) Run(ctx *Context) error {
 }
 
 type BonesLoadCmd struct {
-	DataFile string `arg:"" optional:"" help:"The path to the Scryfall bulk data file."`
-	HTTP     bool   `name:"http" help:"Use the HTTP client instead of the default client."`
+	DataFile    string `arg:"" optional:"" help:"The path to the Scryfall bulk data file."`
+	RulingsFile string `name:"rulings" help:"The path to the Scryfall rulings bulk data file."`
+	HTTP        bool   `name:"http" help:"Use the HTTP client instead of the default client."`
+	NoSets      bool   `name:"no-sets" help:"Don't download set metadata from the Scryfall API, e.g. when loading a file offline."`
+	BatchSize   int    `name:"batch-size" default:"1000" help:"The number of cards to write to the database at once."`
+	Workers     int    `name:"workers" help:"The number of goroutines transforming cards.  Defaults to the number of CPUs."`
+	Sync        bool   `name:"sync" help:"Update cards that have changed and delete printings Scryfall no longer has, instead of only adding new ones."`
+	Force       bool   `name:"force" help:"Download the bulk data with --http even if it hasn't changed since it was last loaded."`
+	Source      string `name:"source" enum:"default_cards,oracle_cards,unique_artwork,all_cards" default:"default_cards" help:"The Scryfall bulk data file of cards to download with --http, one of: ${enum}."`
+}
+
+// openBulkFile opens a bulk data file, downloading source into the cache in the data
+// directory if it isn't nil, or opening the file at path otherwise.
+func openBulkFile(ctx context.Context, source *scryfall.BulkDataSource, path string) (io.ReadCloser, error) {
+	if source != nil {
+		dataDir, err := dataDirectory()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get data directory: %w", err)
+		}
+
+		downloader := scryfall.NewBulkDownloader(
+			filepath.Join(dataDir, "bulk"),
+			scryfall.WithDownloadProgress(downloadProgress(source.Name)))
+
+		return downloader.Open(ctx, source)
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return fd, nil
+}
+
+// downloadProgress prints the progress of a download to stderr each time another
+// percent of it has been received.
+func downloadProgress(name string) scryfall.ProgressFunc {
+	lastPercent := int64(-1)
+
+	return func(received, total int64) {
+		if total <= 0 {
+			return
+		}
+
+		percent := received * 100 / total
+		if percent == lastPercent {
+			return
+		}
+
+		lastPercent = percent
+
+		fmt.Fprintf(os.Stderr, "\rDownloading %s: %d%%", name, percent)
+
+		if received >= total {
+			fmt.Fprintln(os.Stderr)
+		}
+	}
 }
 
 func (r *BonesLoadCmd) Run(ctx *Context) error {
@@ -102,51 +162,232 @@ func (r *BonesLoadCmd) Run(ctx *Context) error {
 
 	sfall := scryfall.NewClient(nil)
 
-	var reader *scryfall.BulkReader[scryfall.Card]
+	dbClient, err := connectToDatabase(ctx.Context, logger, true)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
 
-	if r.HTTP {
-		currentBulkFiles, err := sfall.BulkData.ListSources(ctx.Context)
-		if err != nil {
-			return fmt.Errorf("failed to get current bulk files from Scryfall: %w", err)
+	defer dbClient.Close()
+
+	if !r.HTTP {
+		if err = r.loadCards(ctx, sfall, dbClient, nil); err != nil {
+			return err
 		}
 
-		resp, err := http.Get(currentBulkFiles.DefaultCards.DownloadURI)
-		if err != nil {
-			return fmt.Errorf("failed to download default cards file: %w", err)
+		if r.RulingsFile == "" {
+			return nil
 		}
 
-		defer resp.Body.Close()
+		return r.loadRulings(ctx, dbClient, nil)
+	}
 
-		reader, err = scryfall.NewBulkReader[scryfall.Card](resp.Body)
-		if err != nil {
-			return fmt.Errorf("failed to create bulk reader: %w", err)
+	currentBulkFiles, err := sfall.BulkData.ListSources(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get current bulk files from Scryfall: %w", err)
+	}
+
+	cardsSource, err := etl.CardSource(currentBulkFiles, r.Source)
+	if err != nil {
+		return err
+	}
+
+	cardsSource, err = r.changedSource(ctx, dbClient, cardsSource)
+	if err != nil {
+		return err
+	}
+
+	rulingsSource, err := r.changedSource(ctx, dbClient, currentBulkFiles.Rulings)
+	if err != nil {
+		return err
+	}
+
+	if cardsSource != nil {
+		if err = r.loadCards(ctx, sfall, dbClient, cardsSource); err != nil {
+			return err
 		}
-	} else {
-		fd, err := os.Open(r.DataFile)
-		if err != nil {
-			return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	if rulingsSource != nil {
+		if err = r.loadRulings(ctx, dbClient, rulingsSource); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// changedSource returns source if Scryfall has updated it since it was last loaded,
+// or nil if it hasn't changed and doesn't need downloading.
+func (r *BonesLoadCmd) changedSource(
+	ctx *Context,
+	dbClient *bones.Client,
+	source *scryfall.BulkDataSource,
+) (*scryfall.BulkDataSource, error) {
+	if source == nil || r.Force {
+		return source, nil
+	}
+
+	changed, err := etl.BulkSourceChanged(ctx.Context, dbClient, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check whether %s has changed: %w", source.Name, err)
+	}
+
+	if !changed {
+		fmt.Printf("%s hasn't changed since it was last loaded, skipping it.\n", source.Name)
+		return nil, nil
+	}
+
+	return source, nil
+}
+
+// loadCards loads cards from source, or from the data file if source is nil.
+func (r *BonesLoadCmd) loadCards(
+	ctx *Context,
+	sfall *scryfall.Client,
+	dbClient *bones.Client,
+	source *scryfall.BulkDataSource,
+) error {
+	logger := ctx.Logger
+
+	cardsFile, err := openBulkFile(ctx.Context, source, r.DataFile)
+	if err != nil {
+		return err
+	}
 
-		defer fd.Close()
+	defer cardsFile.Close()
 
-		reader, err = scryfall.NewBulkReader[scryfall.Card](fd)
+	reader, err := scryfall.NewBulkReader[scryfall.Card](cardsFile)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk reader: %w", err)
+	}
+
+	if !r.NoSets {
+		sets, err := sfall.Set.List(ctx.Context)
 		if err != nil {
-			return fmt.Errorf("failed to create bulk reader: %w", err)
+			// the cards can still be loaded with the few set fields they have
+			logger.Warn("failed to download sets from Scryfall, only loading set metadata from cards", zap.Error(err))
+		} else if err = etl.ScryfallSets(ctx.Context, logger, dbClient, sets); err != nil {
+			return fmt.Errorf("failed to load sets from Scryfall: %w", err)
 		}
 	}
 
-	dbClient, err := connectToDatabase(ctx.Context, logger, true)
+	fmt.Println("Starting ingest; this may take a minute.")
+
+	err = etl.ScryfallCards(ctx.Context, logger, dbClient, reader, etl.LoadOptions{
+		BatchSize: r.BatchSize,
+		Workers:   r.Workers,
+		Sync:      r.Sync,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load cards from Scryfall: %w", err)
+	}
+
+	if source == nil {
+		return nil
+	}
+
+	return etl.RecordBulkImport(ctx.Context, dbClient, source)
+}
+
+// loadRulings loads rulings from source, or from the rulings file if source is nil.
+func (r *BonesLoadCmd) loadRulings(ctx *Context, dbClient *bones.Client, source *scryfall.BulkDataSource) error {
+	rulingsFile, err := openBulkFile(ctx.Context, source, r.RulingsFile)
+	if err != nil {
+		return err
+	}
+
+	defer rulingsFile.Close()
+
+	rulingsReader, err := scryfall.NewBulkReader[scryfall.Ruling](rulingsFile)
+	if err != nil {
+		return fmt.Errorf("failed to create rulings bulk reader: %w", err)
+	}
+
+	err = etl.ScryfallRulings(ctx.Context, ctx.Logger, dbClient, rulingsReader)
+	if err != nil {
+		return fmt.Errorf("failed to load rulings from Scryfall: %w", err)
+	}
+
+	if source == nil {
+		return nil
+	}
+
+	return etl.RecordBulkImport(ctx.Context, dbClient, source)
+}
+
+type BonesSearchCmd struct {
+	Query  []string `arg:"" help:"The query to search for, e.g. 'c=R cmc<3 order:cmc'."`
+	Unique string   `name:"unique" enum:"cards,art,prints" default:"cards" help:"Whether to list each card, each illustration or each printing once, one of: ${enum}."`
+}
+
+func (r *BonesSearchCmd) Run(ctx *Context) error {
+	parsed, err := ql.ParseQuery(strings.Join(r.Query, " "))
+	if err != nil {
+		var queryErr *ql.QueryError
+		if errors.As(err, &queryErr) {
+			fmt.Fprintln(os.Stderr, queryErr.Diagram())
+		}
+
+		return fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	dbClient, err := connectToDatabase(ctx.Context, ctx.Logger, false)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
 	defer dbClient.Close()
 
-	fmt.Println("Starting ingest; this will take several minutes.")
+	opts := ql.DefaultSearchOptions
 
-	err = etl.ScryfallCards(ctx.Context, logger, dbClient, reader)
+	opts.Unique, err = ql.ParseUniqueMode(r.Unique)
 	if err != nil {
-		return fmt.Errorf("failed to load cards from Scryfall: %w", err)
+		return err
+	}
+
+	// options in the query, like "order:cmc", work the same as in the API
+	opts = ql.ApplySearchOptions(parsed, opts)
+
+	if opts.Unique == ql.UniqueCards {
+		cards, err := dbClient.Card.Query().
+			Where(parsed.Predicate()).
+			Order(opts.OrderCards()).
+			All(ctx.Context)
+		if err != nil {
+			return fmt.Errorf("failed to query cards: %w", err)
+		}
+
+		for _, crd := range cards {
+			fmt.Println(crd.Name)
+		}
+
+		return nil
+	}
+
+	printings, err := dbClient.Printing.Query().
+		Where(opts.FilterPrintings(parsed.PrintingPredicate())).
+		Order(opts.OrderPrintings()).
+		WithSet().
+		WithCardFace(func(q *bones.CardFaceQuery) {
+			q.WithCard()
+		}).
+		All(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to query printings: %w", err)
+	}
+
+	for _, prt := range printings {
+		name, setCode := "", ""
+
+		if prt.Edges.CardFace != nil && prt.Edges.CardFace.Edges.Card != nil {
+			name = prt.Edges.CardFace.Edges.Card.Name
+		}
+
+		if prt.Edges.Set != nil {
+			setCode = strings.ToUpper(prt.Edges.Set.Code)
+		}
+
+		fmt.Printf("%s (%s) %s\n", name, setCode, prt.CollectorNumber)
 	}
 
 	return nil
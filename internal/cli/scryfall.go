@@ -7,6 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SethCurry/stax/internal/autocomplete"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/ruling"
 	"github.com/SethCurry/stax/internal/console"
 	"github.com/SethCurry/stax/pkg/scryfall"
 	"go.uber.org/zap"
@@ -18,8 +21,20 @@ type ScryfallCmd struct {
 	Rulings ScryfallRulingsCmd `cmd:"" help:"Get rulings for a card"`
 }
 
+// ScryfallClientFlags are the flags shared by every command that talks to Scryfall.
+type ScryfallClientFlags struct {
+	BaseURL string `name:"base-url" env:"STAX_SCRYFALL_URL" help:"The base URL of the Scryfall API, e.g. to use a local 'stax api'." default:"https://api.scryfall.com"`
+}
+
+// newClient creates a Scryfall client using the flags.
+func (f ScryfallClientFlags) newClient() *scryfall.Client {
+	return scryfall.NewClient(nil, scryfall.WithBaseURL(f.BaseURL))
+}
+
 // ScryfallSearchCmd is the implementation of "stax scryfall search".
 type ScryfallSearchCmd struct {
+	ScryfallClientFlags `embed:""`
+
 	Query  []string `arg:"" help:"The search query."`
 	Format string   `name:"format" short:"f" help:"The output format." enum:"table,json" default:"table"`
 }
@@ -31,7 +46,7 @@ func (s *ScryfallSearchCmd) Run(ctx *Context) error {
 
 	logger.Debug("searching for cards", zap.String("query", query))
 
-	client := scryfall.NewClient(nil)
+	client := s.newClient()
 
 	pager, err := client.Card.Search(context.Background(), query, scryfall.CardSearchOptions{})
 	if err != nil {
@@ -84,15 +99,22 @@ func (s *ScryfallSearchCmd) Run(ctx *Context) error {
 }
 
 type ScryfallRulingsCmd struct {
+	ScryfallClientFlags `embed:""`
+
 	CardName []string `arg:"" help:"The name of the card"`
+	Local    bool     `name:"local" help:"Look up the rulings in the local database loaded by 'stax bones load --rulings', instead of the Scryfall API."`
 }
 
 func (s *ScryfallRulingsCmd) Run(ctx *Context) error {
-	logger := ctx.Logger
+	cardName := strings.Join(s.CardName, " ")
 
-	client := scryfall.NewClient(nil)
+	if s.Local {
+		return s.runLocal(ctx, cardName)
+	}
 
-	cardName := strings.Join(s.CardName, " ")
+	logger := ctx.Logger
+
+	client := s.newClient()
 
 	foundCard, err := client.Card.Named(context.Background(), cardName)
 	if err != nil {
@@ -112,3 +134,46 @@ func (s *ScryfallRulingsCmd) Run(ctx *Context) error {
 
 	return nil
 }
+
+// runLocal prints the rulings for a card from the local database, matching
+// the card's name the same way as Scryfall's fuzzy name search.
+func (s *ScryfallRulingsCmd) runLocal(ctx *Context, cardName string) error {
+	dbClient, err := connectToDatabase(ctx.Context, ctx.Logger, false)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer dbClient.Close()
+
+	nameIndex, err := autocomplete.LoadIndex(ctx.Context, dbClient)
+	if err != nil {
+		return err
+	}
+
+	names := nameIndex.Fuzzy(cardName)
+
+	switch len(names) {
+	case 0:
+		return fmt.Errorf("no cards found matching %q", cardName)
+	case 1:
+	default:
+		return fmt.Errorf("too many cards match ambiguous name %q: %s", cardName, strings.Join(names, ", "))
+	}
+
+	ctx.Logger.Debug("found card", zap.String("name", names[0]))
+
+	rulings, err := dbClient.Card.Query().
+		Where(card.NameEQ(names[0])).
+		QueryRulings().
+		Order(ruling.ByDate(), ruling.ByID()).
+		All(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to query rulings: %w", err)
+	}
+
+	for _, r := range rulings {
+		fmt.Printf("%s (%s)\n%s\n\n", r.Date.Format(time.DateOnly), r.Source, r.Text)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/bulkimport"
+	"github.com/SethCurry/stax/pkg/scryfall"
+)
+
+// ErrNotCardBulkType is returned by CardSource for bulk data types that don't hold cards.
+var ErrNotCardBulkType = errors.New("bulk data type is not a card type")
+
+// CardBulkTypes are the types of bulk data files that ScryfallCards can load.
+var CardBulkTypes = []string{
+	scryfall.BulkTypeDefaultCards,
+	scryfall.BulkTypeOracleCards,
+	scryfall.BulkTypeUniqueArtwork,
+	scryfall.BulkTypeAllCards,
+}
+
+// CardSource returns the bulk data file of cards with the given type, which
+// must be one of CardBulkTypes.
+func CardSource(sources *scryfall.BulkDataSources, bulkType string) (*scryfall.BulkDataSource, error) {
+	if !slices.Contains(CardBulkTypes, bulkType) {
+		return nil, fmt.Errorf("%w: %q", ErrNotCardBulkType, bulkType)
+	}
+
+	source := sources.ByType(bulkType)
+	if source == nil {
+		return nil, fmt.Errorf("%w: Scryfall didn't list %q", scryfall.ErrUnrecognizedBulkDataType, bulkType)
+	}
+
+	return source, nil
+}
+
+// bulkSourceUpdatedAt parses the time Scryfall last updated a bulk data file.
+func bulkSourceUpdatedAt(source *scryfall.BulkDataSource) (time.Time, error) {
+	updatedAt, err := time.Parse(time.RFC3339, source.UpdatedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse updated_at of %s bulk data: %w", source.Type, err)
+	}
+
+	return updatedAt, nil
+}
+
+// BulkSourceChanged returns true if Scryfall has updated the bulk data file since
+// it was recorded by RecordBulkImport, or if it has never been recorded.
+func BulkSourceChanged(ctx context.Context, db *bones.Client, source *scryfall.BulkDataSource) (bool, error) {
+	updatedAt, err := bulkSourceUpdatedAt(source)
+	if err != nil {
+		return false, err
+	}
+
+	last, err := db.BulkImport.Query().Where(bulkimport.TypeEQ(source.Type)).Only(ctx)
+	if err != nil {
+		if bones.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, fmt.Errorf("failed to query last import of %s bulk data: %w", source.Type, err)
+	}
+
+	return updatedAt.After(last.UpdatedAt), nil
+}
+
+// RecordBulkImport records that a bulk data file has been loaded, along with the
+// time Scryfall last updated it.
+func RecordBulkImport(ctx context.Context, db *bones.Client, source *scryfall.BulkDataSource) error {
+	updatedAt, err := bulkSourceUpdatedAt(source)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	updated, err := db.BulkImport.Update().
+		Where(bulkimport.TypeEQ(source.Type)).
+		SetUpdatedAt(updatedAt).
+		SetImportedAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update import of %s bulk data: %w", source.Type, err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	err = db.BulkImport.Create().
+		SetType(source.Type).
+		SetUpdatedAt(updatedAt).
+		SetImportedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record import of %s bulk data: %w", source.Type, err)
+	}
+
+	return nil
+}
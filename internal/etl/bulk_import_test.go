@@ -0,0 +1,65 @@
+package etl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardSource(t *testing.T) {
+	sources := &scryfall.BulkDataSources{
+		AllCards: &scryfall.BulkDataSource{Type: scryfall.BulkTypeAllCards},
+		Rulings:  &scryfall.BulkDataSource{Type: scryfall.BulkTypeRulings},
+	}
+
+	source, err := CardSource(sources, scryfall.BulkTypeAllCards)
+	require.NoError(t, err)
+	assert.Equal(t, sources.AllCards, source)
+
+	_, err = CardSource(sources, scryfall.BulkTypeRulings)
+	require.ErrorIs(t, err, ErrNotCardBulkType)
+
+	_, err = CardSource(sources, scryfall.BulkTypeOracleCards)
+	require.ErrorIs(t, err, scryfall.ErrUnrecognizedBulkDataType)
+}
+
+func TestBulkSourceChanged(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	source := &scryfall.BulkDataSource{
+		Type:      "default_cards",
+		Name:      "Default Cards",
+		UpdatedAt: "2024-08-07T21:04:55.435+00:00",
+	}
+
+	changed, err := BulkSourceChanged(ctx, db, source)
+	require.NoError(t, err)
+	assert.True(t, changed, "sources that have never been loaded have changed")
+
+	require.NoError(t, RecordBulkImport(ctx, db, source))
+
+	changed, err = BulkSourceChanged(ctx, db, source)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	source.UpdatedAt = "2024-08-08T09:10:11.123+00:00"
+
+	changed, err = BulkSourceChanged(ctx, db, source)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	require.NoError(t, RecordBulkImport(ctx, db, source))
+	assert.Equal(t, 1, db.BulkImport.Query().CountX(ctx))
+
+	source.UpdatedAt = "yesterday"
+
+	_, err = BulkSourceChanged(ctx, db, source)
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/ruling"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"go.uber.org/zap"
+)
+
+// ScryfallRulings reads all of the rulings from the provided scryfall.BulkReader and
+// links each of them to the card with the same oracle ID.
+//
+// Rulings don't have IDs of their own, so all existing rulings are replaced.  Cards
+// should be loaded first, as rulings for cards that aren't in the database are skipped.
+func ScryfallRulings(ctx context.Context, logger *zap.Logger, db *bones.Client, reader *scryfall.BulkReader[scryfall.Ruling]) error {
+	txn, err := db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	err = ingestRulings(ctx, logger, txn, reader)
+	if err != nil {
+		_ = txn.Rollback()
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func ingestRulings(ctx context.Context, logger *zap.Logger, db *bones.Tx, reader *scryfall.BulkReader[scryfall.Ruling]) error {
+	cardCache, err := oracleIDCache(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := db.Ruling.Delete().Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing rulings: %w", err)
+	}
+
+	logger.Debug("deleted existing rulings", zap.Int("count", deleted))
+
+	created := 0
+	skipped := 0
+
+	for {
+		row, err := reader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return fmt.Errorf("failed to read ruling: %w", err)
+		}
+
+		cardID, ok := cardCache[row.OracleID]
+		if !ok {
+			logger.Debug("ignoring ruling for unknown card", zap.String("oracle_id", row.OracleID))
+			skipped++
+
+			continue
+		}
+
+		err = db.Ruling.Create().
+			SetText(row.Comment).
+			SetDate(time.Time(row.PublishedAt)).
+			SetSource(ruling.Source(row.Source)).
+			SetCardID(cardID).
+			Exec(ctx)
+		if err != nil {
+			logger.Error("failed to create ruling", zap.String("oracle_id", row.OracleID), zap.Error(err))
+			return fmt.Errorf("failed to create ruling: %w", err)
+		}
+
+		created++
+	}
+
+	logger.Info("loaded rulings", zap.Int("created", created), zap.Int("skipped", skipped))
+
+	return nil
+}
+
+// oracleIDCache maps the oracle ID of every card in the database to its ID.
+func oracleIDCache(ctx context.Context, db *bones.Tx) (map[string]int, error) {
+	var cards []struct {
+		ID       int    `json:"id"`
+		OracleID string `json:"oracle_id"`
+	}
+
+	err := db.Card.Query().Select(card.FieldID, card.FieldOracleID).Scan(ctx, &cards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query card oracle IDs: %w", err)
+	}
+
+	ret := make(map[string]int, len(cards))
+
+	for _, c := range cards {
+		ret[c.OracleID] = c.ID
+	}
+
+	return ret, nil
+}
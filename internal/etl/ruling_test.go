@@ -0,0 +1,55 @@
+package etl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/ruling"
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testRulingsJSON = `[
+{"object":"ruling","oracle_id":"rulingsOracleID","source":"wotc","published_at":"2004-10-04","comment":"The first ruling."},
+{"object":"ruling","oracle_id":"missingOracleID","source":"wotc","published_at":"2004-10-04","comment":"A ruling for a card that isn't loaded."},
+{"object":"ruling","oracle_id":"rulingsOracleID","source":"scryfall","published_at":"2021-03-19","comment":"The second ruling."}
+]`
+
+func TestScryfallRulings(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	db.Card.Create().SetName("Rulings Card").SetOracleID("rulingsOracleID").SetColorIdentity(0).SaveX(ctx)
+
+	// loading twice shouldn't duplicate any rulings
+	for i := 0; i < 2; i++ {
+		reader, err := scryfall.NewBulkReader[scryfall.Ruling](strings.NewReader(testRulingsJSON))
+		require.NoError(t, err)
+
+		err = ScryfallRulings(ctx, zap.NewNop(), db, reader)
+		require.NoError(t, err)
+	}
+
+	rulings, err := db.Card.Query().
+		Where(card.OracleIDEQ("rulingsOracleID")).
+		QueryRulings().
+		Order(ruling.ByDate()).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, rulings, 2)
+
+	assert.Equal(t, "The first ruling.", rulings[0].Text)
+	assert.Equal(t, ruling.SourceWotc, rulings[0].Source)
+	assert.Equal(t, "The second ruling.", rulings[1].Text)
+	assert.Equal(t, ruling.SourceScryfall, rulings[1].Source)
+	assert.Equal(t, 2021, rulings[1].Date.Year())
+
+	assert.Equal(t, 2, db.Ruling.Query().CountX(ctx))
+}
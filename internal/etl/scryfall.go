@@ -2,449 +2,493 @@ package etl
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
-	"strings"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/SethCurry/stax/internal/bones"
-	"github.com/SethCurry/stax/internal/bones/artist"
-	"github.com/SethCurry/stax/internal/bones/card"
-	"github.com/SethCurry/stax/internal/bones/cardface"
-	"github.com/SethCurry/stax/internal/bones/predicate"
-	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/printingimage"
-	"github.com/SethCurry/stax/internal/bones/set"
 	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/SethCurry/stax/pkg/stax"
 	"go.uber.org/zap"
 )
 
-// ScryfallCards reads all of the cards from the provided scryfall.BulkReader and creates
-// all of the SQL records implied by that object (set, artists, etc).
-func ScryfallCards(ctx context.Context, logger *zap.Logger, db *bones.Client, reader *scryfall.BulkReader[scryfall.Card]) error {
-	txn, err := db.Tx(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to create initial transaction")
-	}
+// LoadOptions control how ScryfallCards loads a bulk data file.
+type LoadOptions struct {
+	// BatchSize is the number of cards written to the database at once.
+	BatchSize int
+	// Workers is the number of goroutines transforming cards while they
+	// are decoded and written.
+	Workers int
+	// Sync updates cards, faces and printings that have changed since they
+	// were loaded, and deletes any printings that aren't in the file, along
+	// with cards that no longer have any.  The file must include every printing
+	// that should be kept, such as Scryfall's default or all cards, since syncing
+	// oracle cards or unique artwork deletes the printings they leave out.
+	Sync bool
+}
 
-	artistCache := make(map[string]int)
-	setCache := make(map[string]int)
-	cardCache := make(map[string]int)
-	cardFaceCache := make(map[string][]cachedCardFace)
+// DefaultLoadOptions are the options used for any option that is zero.
+var DefaultLoadOptions = LoadOptions{
+	BatchSize: 1000,
+	Workers:   runtime.NumCPU(),
+}
 
-	numCards, err := txn.Card.Query().Count(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to query for current card count: %w", err)
+// withDefaults fills in any options that are zero from DefaultLoadOptions.
+func (o LoadOptions) withDefaults() LoadOptions {
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultLoadOptions.BatchSize
 	}
 
-	isFresh := numCards == 0
-
-	for {
-		card, err := reader.Next()
-		if err != nil {
-			if err == io.EOF {
-				err = txn.Commit()
-				if err != nil {
-					logger.Error("failed to perform last commit", zap.Error(err))
-				}
-				logger.Debug("got EOF")
-				return nil
-			}
-
-			return err
-		}
+	if o.Workers <= 0 {
+		o.Workers = DefaultLoadOptions.Workers
+	}
 
-		if card == nil {
-			logger.Debug("card is nil, breaking")
-			break
-		}
+	return o
+}
 
-		err = scryfallCardIngestor(
-			ctx,
-			logger.With(zap.String("card_name", card.Name)),
-			txn,
-			card,
-			artistCache,
-			setCache,
-			cardCache,
-			cardFaceCache,
-			isFresh)
-		if err != nil {
-			return err
-		}
-	}
-	return txn.Commit()
+// sequencedCard is a card read from a bulk data file, along with its position
+// in the file so that the cards can be written in the same order.
+type sequencedCard struct {
+	seq int
+	row *scryfall.Card
 }
 
-type cachedCardFace struct {
-	Name string
-	ID   int
+// ScryfallCards reads all of the cards from the provided scryfall.BulkReader and creates
+// all of the SQL records implied by that object (set, artists, etc).
+//
+// Cards are decoded on one goroutine, transformed by a pool of workers and then
+// written in batches, in the order they appear in the file, inside of a single
+// transaction.  Loading the same file again doesn't create any duplicates, and
+// only changes existing rows if opts.Sync is set.
+func ScryfallCards(
+	ctx context.Context,
+	logger *zap.Logger,
+	db *bones.Client,
+	reader *scryfall.BulkReader[scryfall.Card],
+	opts LoadOptions,
+) error {
+	return loadScryfallCards(ctx, logger, db, reader.Next, opts)
 }
 
-func scryfallCardIngestor(
+// loadScryfallCards loads cards returned by next until it returns io.EOF.
+func loadScryfallCards(
 	ctx context.Context,
 	logger *zap.Logger,
-	db *bones.Tx,
-	row *scryfall.Card,
-	artistCache map[string]int,
-	setCache map[string]int,
-	cardCache map[string]int,
-	cardFacesCache map[string][]cachedCardFace,
-	isFresh bool,
+	db *bones.Client,
+	next func() (*scryfall.Card, error),
+	opts LoadOptions,
 ) error {
-	if row.OracleID == "" {
-		logger.Debug("ignoring card because it is missing an oracle ID")
-		return nil
+	opts = opts.withDefaults()
+
+	txn, err := db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create initial transaction: %w", err)
 	}
 
-	cardID := 0
+	writer, err := newCardWriter(ctx, logger, txn, opts)
+	if err != nil {
+		_ = txn.Rollback()
+		return err
+	}
 
-	if gotCardID, ok := cardCache[row.OracleID]; ok {
-		cardID = gotCardID
-	} else {
-		gotCard, err := getOrCreateCard(ctx, logger, db, row, isFresh)
-		if err != nil {
-			return fmt.Errorf("failed to get or create card: %w", err)
-		}
+	pipelineCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-		cardCache[row.OracleID] = gotCard.ID
-		cardID = gotCard.ID
+	var (
+		failOnce    sync.Once
+		pipelineErr error
+	)
+
+	// fail records the first error from any stage and stops the others
+	fail := func(err error) {
+		failOnce.Do(func() {
+			pipelineErr = err
+			cancel()
+		})
 	}
 
-	artistID := 0
+	rows := make(chan sequencedCard, opts.BatchSize)
+	records := make(chan *cardRecord, opts.BatchSize)
 
-	if row.Artist != "" {
-		if foundArtistID, ok := artistCache[row.Artist]; ok {
-			artistID = foundArtistID
-		} else {
-			cardArtist, err := getOrCreateCardArtist(ctx, logger, db, row.Artist, isFresh)
+	go func() {
+		defer close(rows)
+
+		for seq := 0; ; seq++ {
+			row, err := next()
 			if err != nil {
-				return fmt.Errorf("failed to get or create card artist: %w", err)
+				if !errors.Is(err, io.EOF) {
+					fail(err)
+				}
+
+				return
 			}
-			artistCache[row.Artist] = cardArtist.ID
-			artistID = cardArtist.ID
-		}
-	}
 
-	setID := 0
+			if row == nil {
+				logger.Debug("card is nil, stopping")
+				return
+			}
 
-	if gotSetID, ok := setCache[row.SetCode]; ok {
-		setID = gotSetID
-	} else {
-		cardSet, err := getOrCreateSet(ctx, logger, db, row.SetName, row.SetCode, isFresh)
-		if err != nil {
-			return fmt.Errorf("failed to get or create card set: %w", err)
+			select {
+			case rows <- sequencedCard{seq: seq, row: row}:
+			case <-pipelineCtx.Done():
+				return
+			}
 		}
+	}()
 
-		setCache[row.SetCode] = cardSet.ID
-		setID = cardSet.ID
-	}
+	var workers sync.WaitGroup
 
-	cardFaceID := 0
-	foundCardFace := false
+	for i := 0; i < opts.Workers; i++ {
+		workers.Add(1)
 
-	facesForID, ok := cardFacesCache[row.OracleID]
-	if ok {
-		for _, v := range facesForID {
-			if v.Name == row.Name {
-				foundCardFace = true
-				cardFaceID = v.ID
+		go func() {
+			defer workers.Done()
+
+			for row := range rows {
+				record, err := transformCard(row.seq, row.row)
+				if err != nil {
+					fail(err)
+					return
+				}
+
+				select {
+				case records <- record:
+				case <-pipelineCtx.Done():
+					return
+				}
 			}
-		}
+		}()
 	}
 
-	if !foundCardFace {
-		cardFace, err := getOrCreateCardFace(ctx, logger, db, row, cardID, isFresh)
-		if err != nil {
-			return fmt.Errorf("failed to get or create card face: %w", err)
+	go func() {
+		workers.Wait()
+		close(records)
+	}()
+
+	// workers finish out of order, so records wait here until every record before them is written
+	pending := make(map[int]*cardRecord)
+	written := 0
+
+	for record := range records {
+		if pipelineCtx.Err() != nil {
+			continue
 		}
 
-		cardFaceID = cardFace.ID
+		pending[record.seq] = record
 
-		cardFacesCache[row.OracleID] = append(facesForID, cachedCardFace{
-			Name: cardFace.Name,
-			ID:   cardFace.ID,
-		})
+		for {
+			ready, ok := pending[written]
+			if !ok {
+				break
+			}
+
+			delete(pending, written)
+			written++
+
+			if err := writer.add(pipelineCtx, ready); err != nil {
+				fail(err)
+				break
+			}
+		}
 	}
 
-	cardPrinting, err := getOrCreatePrinting(ctx, logger, db, printing.Rarity(row.Rarity), artistID, setID, cardFaceID, isFresh)
-	if err != nil {
-		return fmt.Errorf("failed to get or create card printing: %w", err)
+	if pipelineErr == nil {
+		if err := ctx.Err(); err != nil {
+			pipelineErr = err
+		} else {
+			pipelineErr = writer.finish(ctx)
+		}
 	}
 
-	err = createPrintingImagesIfNotExist(ctx, logger, db, row, cardPrinting, isFresh)
-	if err != nil {
-		return fmt.Errorf("failed to create printing images: %w", err)
+	if pipelineErr != nil {
+		_ = txn.Rollback()
+		return pipelineErr
 	}
 
-	return nil
+	logger.Debug("loaded cards", zap.Int("count", written))
+
+	return txn.Commit()
 }
 
-// createPrintingImagesIfNotExist creates all printing images for a card if they do not already exist.
-// It will ignore any images that are not present in the row.
-func createPrintingImagesIfNotExist(
-	ctx context.Context,
-	logger *zap.Logger,
-	db *bones.Tx,
-	row *scryfall.Card,
-	cardPrinting *bones.Printing,
-	isFresh bool,
-) error {
-	imageURIs := []struct {
-		uri   string
-		type_ printingimage.ImageType
-	}{
-		{row.ImageURIs.Small, printingimage.ImageTypeSmall},
-		{row.ImageURIs.Normal, printingimage.ImageTypeNormal},
-		{row.ImageURIs.Large, printingimage.ImageTypeLarge},
-		{row.ImageURIs.PNG, printingimage.ImageTypePng},
-		{row.ImageURIs.ArtCrop, printingimage.ImageTypeArtCrop},
-		{row.ImageURIs.BorderCrop, printingimage.ImageTypeBorderCrop},
-	}
+// cardRecord is a card from a bulk data file that has been transformed
+// into the values that are written to the database.
+type cardRecord struct {
+	seq           int
+	row           *scryfall.Card
+	colorIdentity uint8
+	legalities    []legalityRecord
+	faces         []faceRecord
+	hash          string
+}
 
-	for _, imageURI := range imageURIs {
-		if imageURI.uri != "" {
-			_ = createSinglePrintingImage(
-				ctx,
-				logger,
-				db,
-				imageURI.uri,
-				imageURI.type_,
-				cardPrinting,
-				isFresh,
-			)
-		}
-	}
+type legalityRecord struct {
+	format   string
+	legality legality.Legality
+}
 
-	return nil
+type faceRecord struct {
+	scryfallFace
+	colorField     uint8
+	powerValue     *float32
+	toughnessValue *float32
+	loyaltyValue   *float32
+	images         []imageRecord
+	hash           string
+	printingHash   string
 }
 
-// createSinglePrintingImage creates a single printing image in the database
-// if there is not an existing record with the same image type, URL, and printing.
-func createSinglePrintingImage(
-	ctx context.Context,
-	logger *zap.Logger,
-	db *bones.Tx,
-	imageURL string,
-	imageType printingimage.ImageType,
-	cardPrinting *bones.Printing,
-	isFresh bool,
-) error {
-	logger = logger.With(
-		zap.String("image_type", string(imageType)),
-		zap.String("image_url", imageURL))
-
-	if !isFresh {
-		_, err := db.PrintingImage.Query().
-			Where(
-				printingimage.ImageTypeEQ(imageType),
-				printingimage.URLEQ(imageURL),
-				printingimage.HasPrintingWith(printing.IDEQ(cardPrinting.ID))).
-			Only(ctx)
-		if err == nil {
-			logger.Debug("printing image already exists")
-			return nil
-		}
+type imageRecord struct {
+	url       string
+	imageType printingimage.ImageType
+}
 
-		if !bones.IsNotFound(err) {
-			logger.Error("failed to query for existing printing image", zap.Error(err))
-			return fmt.Errorf("failed to query for existing printing image: %w", err)
-		}
+// transformCard does all of the work for a card that doesn't need the database,
+// so that it can be done concurrently.  Cards without an oracle ID are passed
+// through untransformed, so that the writer can skip them in order.
+func transformCard(seq int, row *scryfall.Card) (*cardRecord, error) {
+	record := &cardRecord{seq: seq, row: row}
+
+	if row.OracleID == "" {
+		return record, nil
 	}
 
-	_, err := db.PrintingImage.Create().
-		SetURL(imageURL).
-		SetImageType(imageType).
-		SetPrinting(cardPrinting).
-		Save(ctx)
+	colorIdentity, err := stax.NewColorField(row.ColorIdentity)
 	if err != nil {
-		return fmt.Errorf("failed to create new printing image: %w", err)
+		return nil, fmt.Errorf("failed to parse color identity of %q: %w", row.Name, err)
 	}
 
-	return nil
-}
+	record.colorIdentity = uint8(colorIdentity)
 
-// getOrCreatePrinting checks if a printing matching the provided parameters already exists.
-// If a matching printing is found, the matching printing is returned.  If no matching
-// printing could be found, one will be created and returned.
-func getOrCreatePrinting(
-	ctx context.Context,
-	logger *zap.Logger, // Zap logger for logging purposes
-	db *bones.Tx, // OracleDB client to interact with the database
-	rarity printing.Rarity, // The rarity of the card face
-	gotArtistID int, // Pointer to an artist entity (optional)
-	gotSetID int, // Set associated with the card face
-	gotCardFace int, // The card face we are dealing with
-	isFresh bool,
-) (*bones.Printing, error) {
-	// Logger is updated with additional contextual information about the printing
-	logger = logger.With(
-		zap.String("rarity", string(rarity)),
-		zap.Int("set_id", gotSetID),
-		zap.Bool("has_artist", gotArtistID != 0), // Checks if artist is present or not
-	)
+	byFormat := row.Legality.ByFormat()
 
-	// Predicate for the artist based on whether it exists or not
-	var artistPred predicate.Printing
-	if gotArtistID != 0 {
-		artistPred = printing.HasArtistWith(artist.IDEQ(gotArtistID))
-	} else {
-		artistPred = printing.Not(printing.HasArtist())
+	for _, format := range scryfall.AllFormats() {
+		if formatLegality := byFormat[format]; formatLegality != "" {
+			record.legalities = append(record.legalities, legalityRecord{
+				format:   format,
+				legality: legality.Legality(formatLegality),
+			})
+		}
 	}
 
-	if !isFresh {
-		// Query to find an existing printing with the given parameters
-		existingPrinting, err := db.Printing.Query().Where(
-			printing.RarityEQ(rarity),               // Rarity of the card face
-			artistPred,                              // Artist predicate based on whether an artist is present or not
-			printing.HasSetWith(set.IDEQ(gotSetID)), // Set association of the printing
-			printing.HasCardFaceWith(cardface.IDEQ(gotCardFace))).Only(ctx)
-		if err == nil {
-			// If existingPrinting is found, a debug log is made and existing printing is returned
-			logger.Debug("printing already exists")
-			return existingPrinting, nil
-		}
+	// formats are listed from a map, so they need sorting for the hash to be stable
+	sort.Slice(record.legalities, func(i, j int) bool {
+		return record.legalities[i].format < record.legalities[j].format
+	})
 
-		// Error handling if the printing does not exist in the database
-		if !bones.IsNotFound(err) {
-			logger.Error("failed to query for existing printing", zap.Error(err))
-			return nil, fmt.Errorf("failed to query for existing printing: %w", err)
-		}
+	legalities := make([]string, 0, len(record.legalities))
+	for _, l := range record.legalities {
+		legalities = append(legalities, l.format+"="+string(l.legality))
 	}
 
-	// If no previous error occurred and the printing does not exist in the database, a new printing is created with given parameters
-	newPrintingQuery := db.Printing.Create().SetSetID(gotSetID).SetCardFaceID(gotCardFace).SetRarity(rarity)
-	if gotArtistID != 0 { // If an artist is present, it is set in the new printing query
-		newPrintingQuery = newPrintingQuery.SetArtistID(gotArtistID)
-	}
+	record.hash = contentHash(row.Name, record.colorIdentity, row.Layout, legalities)
 
-	// The newly created printing is saved into the database and returned along with any errors that might have occurred during saving
-	newPrinting, err := newPrintingQuery.Save(ctx)
-	if err != nil {
-		logger.Error("failed to create new printing", zap.Error(err))
-		return nil, fmt.Errorf("failed to create new printing: %w", err)
+	for _, face := range scryfallFaces(row) {
+		colors, err := stax.NewColorField(face.Colors)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse colors of %q: %w", face.Name, err)
+		}
+
+		record.faces = append(record.faces, faceRecord{
+			scryfallFace:   face,
+			colorField:     uint8(colors),
+			powerValue:     parseStat(face.Power),
+			toughnessValue: parseStat(face.Toughness),
+			loyaltyValue:   parseStat(face.Loyalty),
+			images:         printingImages(face.ImageURIs),
+			hash: contentHash(
+				face.Name, face.FlavorText, face.OracleText, face.ManaCost, face.TypeLine,
+				face.Power, face.Toughness, face.Loyalty, face.CMC, face.Colors, row.Language),
+			printingHash: contentHash(
+				row.ID, row.CollectorNumber, row.MultiverseIDs, row.MTGOID, row.ArenaID, row.TCGPlayerID,
+				row.Rarity, row.ReleasedAt, row.SetCode, face.Artist, face.IllustrationID, face.ImageURIs,
+				row.Language, face.PrintedName, face.PrintedText, face.PrintedTypeLine, face.FlavorText),
+		})
 	}
 
-	logger.Info("created new printing")
+	return record, nil
+}
+
+// contentHash hashes the JSON encoding of values.  It only needs to stay the same
+// between loads of the same values, so that rows which have changed can be found.
+func contentHash(values ...any) string {
+	hash := fnv.New64a()
+
+	// writing to a hash never fails
+	_ = json.NewEncoder(hash).Encode(values)
 
-	return newPrinting, nil // Return of the newly created printing and no error
+	return strconv.FormatUint(hash.Sum64(), 16)
 }
 
-func getOrCreateCardFace(
-	ctx context.Context,
-	logger *zap.Logger,
-	db *bones.Tx,
-	row *scryfall.Card,
-	gotCardID int,
-	isFresh bool,
-) (*bones.CardFace, error) {
-	logger = logger.With(zap.String("card_face_name", row.Name))
-
-	if !isFresh {
-		existingCardFace, err := db.CardFace.Query().Where(
-			cardface.NameEQ(row.Name),
-			cardface.HasCardWith(card.IDEQ(gotCardID)),
-		).
-			Only(ctx)
-		if err == nil {
-			logger.Debug("card face already exists")
-			return existingCardFace, nil
-		}
+// printingImages lists the images of a card face, ignoring any that are not present.
+func printingImages(images scryfall.ImageURIs) []imageRecord {
+	imageURIs := []imageRecord{
+		{images.Small, printingimage.ImageTypeSmall},
+		{images.Normal, printingimage.ImageTypeNormal},
+		{images.Large, printingimage.ImageTypeLarge},
+		{images.PNG, printingimage.ImageTypePng},
+		{images.ArtCrop, printingimage.ImageTypeArtCrop},
+		{images.BorderCrop, printingimage.ImageTypeBorderCrop},
+	}
+
+	ret := make([]imageRecord, 0, len(imageURIs))
 
-		if !bones.IsNotFound(err) {
-			logger.Error("failed to query for existing card face", zap.Error(err))
-			return nil, fmt.Errorf("failed to query for existing card face: %w", err)
+	for _, image := range imageURIs {
+		if image.url != "" {
+			ret = append(ret, image)
 		}
 	}
 
-	newCardFace, err := db.CardFace.Create().
-		SetName(row.Name).
-		SetFlavorText(row.FlavorText).
-		SetOracleText(row.OracleText).
-		SetLanguage(row.Language).
-		SetCmc(row.CMC).
-		SetPower(row.Power).
-		SetToughness(row.Toughness).
-		SetLoyalty(row.Loyalty).
-		SetManaCost(row.ManaCost).
-		SetTypeLine(row.TypeLine).
-		SetColors(strings.Join(row.Colors, "")).
-		SetCardID(gotCardID).
-		Save(ctx)
-	if err != nil {
-		logger.Error("failed to create new card face", zap.Error(err))
-		return nil, fmt.Errorf("failed to create new card face: %w", err)
+	return ret
+}
+
+// scryfallFace holds the fields of a single face of a card.  They come
+// from the card's card_faces if it has more than one face, or from the
+// card itself if it doesn't.
+type scryfallFace struct {
+	Name           string
+	FlavorText     string
+	OracleText     string
+	ManaCost       string
+	TypeLine       string
+	Power          string
+	Toughness      string
+	Loyalty        string
+	CMC            float32
+	Colors         []string
+	Artist         string
+	IllustrationID string
+	ImageURIs      scryfall.ImageURIs
+
+	// the face's text as it is printed, if it isn't the same as its Oracle
+	// text, such as when it is printed in another language
+	PrintedName     string
+	PrintedText     string
+	PrintedTypeLine string
+}
+
+// scryfallFaces splits a card into its faces, so that transform, modal
+// double-faced, split, adventure, flip and meld cards get a face each
+// with their own text, instead of one face named "A // B".
+//
+// Anything a face doesn't have is taken from the card, such as the
+// images of split cards, which are only printed once for both halves.
+func scryfallFaces(row *scryfall.Card) []scryfallFace {
+	if len(row.CardFaces) == 0 {
+		return []scryfallFace{{
+			Name:            row.Name,
+			FlavorText:      row.FlavorText,
+			OracleText:      row.OracleText,
+			ManaCost:        row.ManaCost,
+			TypeLine:        row.TypeLine,
+			Power:           row.Power,
+			Toughness:       row.Toughness,
+			Loyalty:         row.Loyalty,
+			CMC:             row.CMC,
+			Colors:          row.Colors,
+			Artist:          row.Artist,
+			IllustrationID:  row.IllustrationID,
+			ImageURIs:       row.ImageURIs,
+			PrintedName:     row.PrintedName,
+			PrintedText:     row.PrintedText,
+			PrintedTypeLine: row.PrintedTypeLine,
+		}}
 	}
 
-	logger.Info("created new card face")
+	faces := make([]scryfallFace, 0, len(row.CardFaces))
+
+	for _, f := range row.CardFaces {
+		face := scryfallFace{
+			Name:            f.Name,
+			FlavorText:      f.FlavorText,
+			OracleText:      f.OracleText,
+			ManaCost:        f.ManaCost,
+			TypeLine:        f.TypeLine,
+			Power:           f.Power,
+			Toughness:       f.Toughness,
+			Loyalty:         f.Loyalty,
+			CMC:             row.CMC,
+			Colors:          f.Colors,
+			Artist:          f.Artist,
+			IllustrationID:  f.IllustrationID,
+			ImageURIs:       row.ImageURIs,
+			PrintedName:     f.PrintedName,
+			PrintedText:     f.PrintedText,
+			PrintedTypeLine: f.PrintedTypeLine,
+		}
 
-	return newCardFace, nil
-}
+		if f.CMC != nil {
+			face.CMC = *f.CMC
+		}
 
-func getOrCreateSet(
-	ctx context.Context,
-	logger *zap.Logger,
-	db *bones.Tx,
-	setName string,
-	setCode string,
-	isFresh bool,
-) (*bones.Set, error) {
-	logger = logger.With(zap.String("set_name", setName), zap.String("set_code", setCode))
-
-	if !isFresh {
-		existingSet, err := db.Set.Query().Where(set.NameEQ(setName)).Only(ctx)
-		if err == nil {
-			logger.Debug("card already exists")
-			return existingSet, nil
+		// split and adventure cards only list colors on the card
+		if face.Colors == nil {
+			face.Colors = row.Colors
 		}
 
-		if !bones.IsNotFound(err) {
-			logger.Error("failed to query for existing set", zap.Error(err))
-			return nil, fmt.Errorf("failed to query for existing set: %w", err)
+		if face.Artist == "" {
+			face.Artist = row.Artist
 		}
-	}
 
-	newSet, err := db.Set.Create().SetName(setName).SetCode(setCode).Save(ctx)
-	if err != nil {
-		logger.Error("failed to create new set", zap.Error(err))
-		return nil, fmt.Errorf("failed to create new set: %w", err)
+		if face.IllustrationID == "" {
+			face.IllustrationID = row.IllustrationID
+		}
+
+		if f.ImageURIs != nil {
+			face.ImageURIs = *f.ImageURIs
+		}
+
+		faces = append(faces, face)
 	}
 
-	logger.Info("created new set")
+	return faces
+}
 
-	return newSet, nil
+// printingIDSetter is implemented by both the create and update builders for printings.
+type printingIDSetter[T any] interface {
+	SetScryfallID(string) T
+	SetCollectorNumber(string) T
+	SetMultiverseIds([]int) T
+	SetNillableMtgoID(*int) T
+	SetNillableArenaID(*int) T
+	SetNillableTcgplayerID(*int) T
 }
 
-// getOrCreateCardArtist checks whether a card artist exists in the database and creates
-// it if not.
-func getOrCreateCardArtist(
-	ctx context.Context,
-	logger *zap.Logger,
-	db *bones.Tx,
-	artistName string,
-	isFresh bool,
-) (*bones.Artist, error) {
-	logger = logger.With(zap.String("artist_name", artistName))
-
-	if !isFresh {
-		existingArtist, err := db.Artist.Query().Where(artist.NameEQ(artistName)).Only(ctx)
-		if err == nil {
-			logger.Debug("artist already exists")
-			return existingArtist, nil
-		}
+// setPrintingIDs sets Scryfall's ID for a printing, and the IDs of the printing
+// on Gatherer, MTGO, Arena and TCGplayer.  IDs that are zero are left unset.
+func setPrintingIDs[T printingIDSetter[T]](builder T, row *scryfall.Card) T {
+	return builder.
+		SetScryfallID(row.ID).
+		SetCollectorNumber(row.CollectorNumber).
+		SetMultiverseIds(row.MultiverseIDs).
+		SetNillableMtgoID(nonZeroInt(row.MTGOID)).
+		SetNillableArenaID(nonZeroInt(row.ArenaID)).
+		SetNillableTcgplayerID(nonZeroInt(row.TCGPlayerID))
+}
 
-		if !bones.IsNotFound(err) {
-			logger.Error("failed to query for existing artist", zap.Error(err))
-			return nil, fmt.Errorf("failed to query for existing artist: %w", err)
-		}
+// nonZeroInt returns a pointer to i, or nil if i is zero.
+func nonZeroInt(i int) *int {
+	if i == 0 {
+		return nil
 	}
 
-	newArtist, err := db.Artist.Create().SetName(artistName).Save(ctx)
-	if err != nil {
-		logger.Error("failed to create new artist", zap.Error(err))
-		return nil, fmt.Errorf("failed to create new artist: %w", err)
-	}
+	return &i
+}
 
-	logger.Info("created new artist")
+// parseStat converts a power, toughness or loyalty string into its numeric value.
+// It returns nil if the card doesn't have that stat, so the column is left NULL.
+func parseStat(stat string) *float32 {
+	value, ok := stax.ParseStat(stat)
+	if !ok {
+		return nil
+	}
 
-	return newArtist, nil
+	return &value
 }
@@ -0,0 +1,506 @@
+package etl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/SethCurry/stax/pkg/stax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func float32Ptr(f float32) *float32 {
+	return &f
+}
+
+// loadCards loads rows as if they were read from a bulk data file.
+func loadCards(t *testing.T, db *bones.Client, opts LoadOptions, rows ...*scryfall.Card) {
+	t.Helper()
+
+	next := func() (*scryfall.Card, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+
+		row := rows[0]
+		rows = rows[1:]
+
+		return row, nil
+	}
+
+	err := loadScryfallCards(context.Background(), zap.NewNop(), db, next, opts)
+	require.NoError(t, err)
+}
+
+func TestScryfallCards_Card(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	loadCards(t, db, DefaultLoadOptions, &scryfall.Card{
+		ID:            "9a8aea2f-1e1d-4e0d-8370-207b6cae76e6",
+		Name:          "Esper Charm",
+		OracleID:      "esperCharmOracleID",
+		Layout:        scryfall.LayoutNormal,
+		ColorIdentity: []string{"W", "U", "B"},
+		Rarity:        "uncommon",
+		SetCode:       "ala",
+		SetName:       "Shards of Alara",
+		Legality: scryfall.CardLegality{
+			Modern:    scryfall.LegalityLegal,
+			Commander: scryfall.LegalityLegal,
+			Standard:  scryfall.LegalityNotLegal,
+		},
+	})
+
+	created, err := db.Card.Query().Where(card.OracleIDEQ("esperCharmOracleID")).Only(ctx)
+	require.NoError(t, err)
+
+	colorIdentity := stax.ColorField(created.ColorIdentity)
+	assert.Equal(t, "WUB", colorIdentity.String())
+
+	legalities, err := created.QueryLegalities().All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, legalities, 3)
+}
+
+func TestScryfallCards_Sets(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// sets loaded by ScryfallSets are used instead of creating new ones
+	existing := db.Set.Create().SetName("Theros").SetCode("ths").SaveX(ctx)
+
+	loadCards(t, db, DefaultLoadOptions,
+		&scryfall.Card{
+			ID:         "5f7ae9a5-f1e4-4f1e-9d3c-0e5d5a8b7c01",
+			Name:       "Minimus Containment",
+			OracleID:   "minimusOracleID",
+			Layout:     scryfall.LayoutNormal,
+			Rarity:     "common",
+			SetID:      "44b8eb8f-fa23-401a-98b5-1fbb9871128e",
+			SetCode:    "afr",
+			SetName:    "Adventures in the Forgotten Realms",
+			SetType:    "expansion",
+			ReleasedAt: scryfall.Date(time.Date(2021, 7, 23, 0, 0, 0, 0, time.UTC)),
+		},
+		&scryfall.Card{
+			ID:       "0a3b2c1d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+			Name:     "Thassa's Emissary",
+			OracleID: "thassasEmissaryOracleID",
+			Layout:   scryfall.LayoutNormal,
+			Rarity:   "uncommon",
+			SetCode:  "ths",
+			SetName:  "Theros",
+		})
+
+	created, err := db.Set.Query().Where(set.CodeEQ("afr")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "44b8eb8f-fa23-401a-98b5-1fbb9871128e", created.ScryfallID)
+	assert.Equal(t, "expansion", created.SetType)
+	assert.NotNil(t, created.ReleasedAt)
+
+	assert.Equal(t, 1, db.Set.Query().Where(set.CodeEQ("ths")).CountX(ctx))
+	assert.Equal(t, 1, existing.QueryPrintings().CountX(ctx))
+}
+
+func TestScryfallCards_CardFaces(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	row := &scryfall.Card{
+		ID:              "28059d09-2c7d-4c61-af55-8942107a7c1f",
+		Name:            "Delver of Secrets // Insectile Aberration",
+		OracleID:        "delverOracleID",
+		CollectorNumber: "51",
+		MultiverseIDs:   []int{226749, 226755},
+		TCGPlayerID:     52191,
+		Layout:          scryfall.LayoutTransform,
+		Language:        "en",
+		CMC:             1,
+		Rarity:          "common",
+		SetCode:         "isd",
+		SetName:         "Innistrad",
+		CardFaces: []scryfall.CardFace{
+			{
+				Name:           "Delver of Secrets",
+				ManaCost:       "{U}",
+				TypeLine:       "Creature — Human Wizard",
+				OracleText:     "At the beginning of your upkeep, look at the top card of your library.",
+				Power:          "1",
+				Toughness:      "1",
+				Colors:         []string{"U"},
+				Artist:         "Nils Hamm",
+				IllustrationID: "delverFront",
+				ImageURIs:      &scryfall.ImageURIs{Normal: "https://example.com/front.jpg"},
+			},
+			{
+				Name:           "Insectile Aberration",
+				TypeLine:       "Creature — Human Insect",
+				OracleText:     "Flying",
+				Power:          "3",
+				Toughness:      "2",
+				CMC:            float32Ptr(0),
+				Colors:         []string{"U"},
+				Artist:         "Nils Hamm",
+				IllustrationID: "delverBack",
+				ImageURIs:      &scryfall.ImageURIs{Normal: "https://example.com/back.jpg"},
+			},
+		},
+	}
+
+	loadCards(t, db, DefaultLoadOptions, row)
+
+	created, err := db.Card.Query().Where(card.OracleIDEQ("delverOracleID")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "transform", created.Layout)
+
+	faces, err := created.QueryFaces().Order(cardface.ByName()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, faces, 2)
+
+	assert.Equal(t, "Delver of Secrets", faces[0].Name)
+	assert.Equal(t, "{U}", faces[0].ManaCost)
+	assert.Equal(t, float32(1), faces[0].Cmc)
+
+	assert.Equal(t, "Insectile Aberration", faces[1].Name)
+	assert.Equal(t, "Flying", faces[1].OracleText)
+	assert.Equal(t, "3", faces[1].Power)
+	assert.Equal(t, float32(0), faces[1].Cmc)
+
+	backFace, err := db.Card.Query().Where(card.HasFacesWith(cardface.OracleTextContains("Flying"))).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, backFace.ID)
+
+	printings, err := faces[1].QueryPrintings().WithImages().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, printings, 1)
+	assert.Equal(t, "delverBack", printings[0].IllustrationID)
+	assert.Equal(t, "28059d09-2c7d-4c61-af55-8942107a7c1f", printings[0].ScryfallID)
+	assert.Equal(t, "51", printings[0].CollectorNumber)
+	assert.Equal(t, []int{226749, 226755}, printings[0].MultiverseIds)
+	require.NotNil(t, printings[0].TcgplayerID)
+	assert.Equal(t, 52191, *printings[0].TcgplayerID)
+	assert.Nil(t, printings[0].MtgoID)
+	require.Len(t, printings[0].Edges.Images, 1)
+	assert.Equal(t, "https://example.com/back.jpg", printings[0].Edges.Images[0].URL)
+
+	// loading the same card again shouldn't create anything new
+	loadCards(t, db, DefaultLoadOptions, row)
+
+	delver := card.OracleIDEQ("delverOracleID")
+	assert.Equal(t, 1, db.Card.Query().Where(delver).CountX(ctx))
+	assert.Equal(t, 2, db.CardFace.Query().Where(cardface.HasCardWith(delver)).CountX(ctx))
+	assert.Equal(t, 2, db.Printing.Query().Where(printing.ScryfallIDEQ(row.ID)).CountX(ctx))
+	assert.Equal(t, 2, db.Printing.Query().Where(printing.ScryfallIDEQ(row.ID)).QueryImages().CountX(ctx))
+}
+
+func TestScryfallCards_LegacyPrintings(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	row := &scryfall.Card{
+		ID:              "b3bd2a9b-cb4c-4a50-8b29-ff4bd2a1d5e4",
+		Name:            "Legacy Printing",
+		OracleID:        "legacyPrintingOracleID",
+		CollectorNumber: "7",
+		MTGOID:          12345,
+		Layout:          scryfall.LayoutNormal,
+		Rarity:          "rare",
+		Artist:          "Legacy Artist",
+		SetCode:         "lgc",
+		SetName:         "Legacy Set",
+	}
+
+	// printings loaded before Scryfall's IDs were stored don't have any
+	withoutIDs := *row
+	withoutIDs.ID = ""
+	withoutIDs.CollectorNumber = ""
+	withoutIDs.MTGOID = 0
+
+	loadCards(t, db, DefaultLoadOptions, &withoutIDs)
+	loadCards(t, db, DefaultLoadOptions, row)
+
+	printings, err := db.Card.Query().
+		Where(card.OracleIDEQ("legacyPrintingOracleID")).
+		QueryFaces().
+		QueryPrintings().
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, printings, 1)
+
+	assert.Equal(t, row.ID, printings[0].ScryfallID)
+	assert.Equal(t, "7", printings[0].CollectorNumber)
+	require.NotNil(t, printings[0].MtgoID)
+	assert.Equal(t, 12345, *printings[0].MtgoID)
+}
+
+func TestScryfallCards_Batches(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	fd, err := os.Open("../../pkg/scryfall/test/cards.json")
+	require.NoError(t, err)
+
+	defer fd.Close()
+
+	var rows []*scryfall.Card
+
+	require.NoError(t, json.NewDecoder(fd).Decode(&rows))
+
+	// batches smaller than the file, with more workers than batches, are written
+	// in the same order as the file and don't duplicate cards shared between batches
+	loadCards(t, db, LoadOptions{BatchSize: 3, Workers: 4}, append(rows, rows...)...)
+
+	oracleIDs := []string{}
+	names := []string{}
+
+	for _, row := range rows {
+		oracleIDs = append(oracleIDs, row.OracleID)
+		names = append(names, row.Name)
+	}
+
+	cards, err := db.Card.Query().Where(card.OracleIDIn(oracleIDs...)).Order(card.ByID()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, cards, len(rows))
+
+	for i, c := range cards {
+		assert.Equal(t, names[i], c.Name)
+	}
+}
+
+func BenchmarkScryfallCards(b *testing.B) {
+	fixture, err := os.ReadFile("../../pkg/scryfall/test/cards.json")
+	require.NoError(b, err)
+
+	var rows []json.RawMessage
+
+	require.NoError(b, json.Unmarshal(fixture, &rows))
+
+	// repeat the fixture with new IDs so that every card is written
+	const copies = 200
+
+	cards := make([]json.RawMessage, 0, len(rows)*copies)
+
+	for i := 0; i < copies; i++ {
+		for j, row := range rows {
+			var parsed map[string]any
+
+			require.NoError(b, json.Unmarshal(row, &parsed))
+
+			parsed["id"] = fmt.Sprintf("bench-%d-%d", i, j)
+			parsed["oracle_id"] = fmt.Sprintf("bench-oracle-%d-%d", i, j)
+			parsed["name"] = fmt.Sprintf("%s %d", parsed["name"], i)
+
+			encoded, err := json.Marshal(parsed)
+			require.NoError(b, err)
+
+			cards = append(cards, encoded)
+		}
+	}
+
+	bulkFile, err := json.Marshal(cards)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+
+		// every iteration needs an empty database, instead of the one shared by the tests
+		db, err := bones.Open("sqlite3", fmt.Sprintf("file:bench%d?mode=memory&cache=shared&_fk=1", i))
+		require.NoError(b, err)
+		require.NoError(b, db.Schema.Create(context.Background()))
+
+		b.StartTimer()
+
+		reader, err := scryfall.NewBulkReader[scryfall.Card](bytes.NewReader(bulkFile))
+		require.NoError(b, err)
+
+		err = ScryfallCards(context.Background(), zap.NewNop(), db, reader, DefaultLoadOptions)
+		require.NoError(b, err)
+
+		b.StopTimer()
+		db.Close()
+		b.StartTimer()
+	}
+}
+
+func TestScryfallCards_Sync(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	newRows := func() []*scryfall.Card {
+		return []*scryfall.Card{
+			{
+				ID:         "6b1d3a4c-2f0e-4b8a-9c5d-7e6f5a4b3c2d",
+				Name:       "Synced Card",
+				OracleID:   "syncedOracleID",
+				Layout:     scryfall.LayoutNormal,
+				OracleText: "Draw a card.",
+				Rarity:     "common",
+				Artist:     "Synced Artist",
+				SetCode:    "syn",
+				SetName:    "Synced Set",
+				ImageURIs:  scryfall.ImageURIs{Normal: "https://example.com/synced.jpg"},
+				Legality:   scryfall.CardLegality{Modern: scryfall.LegalityLegal},
+			},
+			{
+				ID:       "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+				Name:     "Vanishing Card",
+				OracleID: "vanishingOracleID",
+				Layout:   scryfall.LayoutNormal,
+				Rarity:   "rare",
+				SetCode:  "syn",
+				SetName:  "Synced Set",
+				Legality: scryfall.CardLegality{Modern: scryfall.LegalityLegal},
+			},
+		}
+	}
+
+	loadCards(t, db, DefaultLoadOptions, newRows()...)
+
+	changed := newRows()[0]
+	changed.OracleText = "Draw two cards."
+	changed.Rarity = "uncommon"
+	changed.ImageURIs.Normal = "https://example.com/synced-v2.jpg"
+	changed.Legality.Modern = scryfall.LegalityBanned
+
+	// without syncing, existing cards aren't changed
+	loadCards(t, db, DefaultLoadOptions, changed)
+
+	synced := card.OracleIDEQ("syncedOracleID")
+	assert.Equal(t, "Draw a card.", db.CardFace.Query().Where(cardface.HasCardWith(synced)).OnlyX(ctx).OracleText)
+	assert.True(t, db.Card.Query().Where(card.OracleIDEQ("vanishingOracleID")).ExistX(ctx))
+
+	loadCards(t, db, LoadOptions{Sync: true}, changed)
+
+	face := db.CardFace.Query().Where(cardface.HasCardWith(synced)).OnlyX(ctx)
+	assert.Equal(t, "Draw two cards.", face.OracleText)
+
+	syncedPrinting := face.QueryPrintings().WithImages().OnlyX(ctx)
+	assert.Equal(t, printing.RarityUncommon, syncedPrinting.Rarity)
+	require.Len(t, syncedPrinting.Edges.Images, 1)
+	assert.Equal(t, "https://example.com/synced-v2.jpg", syncedPrinting.Edges.Images[0].URL)
+
+	legalities := db.Card.Query().Where(synced).QueryLegalities().AllX(ctx)
+	require.Len(t, legalities, 1)
+	assert.Equal(t, "banned", string(legalities[0].Legality))
+
+	// cards that are no longer in the file are deleted along with everything that belongs to them
+	assert.False(t, db.Card.Query().Where(card.OracleIDEQ("vanishingOracleID")).ExistX(ctx))
+	assert.False(t, db.Printing.Query().Where(printing.ScryfallIDEQ("1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f")).ExistX(ctx))
+	assert.Equal(t, 0, db.Legality.Query().Where(legality.Not(legality.HasCard())).CountX(ctx))
+	assert.Equal(t, 0, db.CardFace.Query().Where(cardface.Not(cardface.HasCard())).CountX(ctx))
+}
+
+func TestScryfallCards_Languages(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	english := &scryfall.Card{
+		ID:              "langBoltEnglish",
+		Name:            "Lightning Bolt",
+		OracleID:        "langBoltOracleID",
+		CollectorNumber: "141",
+		Language:        "en",
+		ManaCost:        "{R}",
+		TypeLine:        "Instant",
+		OracleText:      "Lightning Bolt deals 3 damage to any target.",
+		FlavorText:      "The sparkmage shrieked, calling on the rage of the storms of his youth.",
+		CMC:             1,
+		Colors:          []string{"R"},
+		Rarity:          "common",
+		SetCode:         "m10",
+		SetName:         "Magic 2010",
+	}
+
+	japanese := *english
+	japanese.ID = "langBoltJapanese"
+	japanese.Language = "ja"
+	japanese.PrintedName = "稲妻"
+	japanese.PrintedText = "稲妻は、１つを対象とし、それに３点のダメージを与える。"
+	japanese.PrintedTypeLine = "インスタント"
+	japanese.FlavorText = "そのスパークメイジは、若き日の嵐の怒りを呼び起こして叫んだ。"
+
+	// the face is created from the Japanese printing until an English one is loaded
+	loadCards(t, db, DefaultLoadOptions, &japanese)
+
+	face, err := db.CardFace.Query().Where(cardface.HasCardWith(card.OracleIDEQ("langBoltOracleID"))).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ja", face.Language)
+	assert.Equal(t, japanese.FlavorText, face.FlavorText)
+
+	loadCards(t, db, DefaultLoadOptions, &japanese, english)
+
+	face, err = db.CardFace.Query().Where(cardface.HasCardWith(card.OracleIDEQ("langBoltOracleID"))).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Lightning Bolt", face.Name)
+	assert.Equal(t, "en", face.Language)
+	assert.Equal(t, english.FlavorText, face.FlavorText)
+
+	printings, err := face.QueryPrintings().Order(printing.ByScryfallID()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, printings, 2)
+
+	assert.Equal(t, "langBoltEnglish", printings[0].ScryfallID)
+	assert.Equal(t, "en", printings[0].Language)
+	assert.Empty(t, printings[0].PrintedName)
+	assert.Equal(t, english.FlavorText, printings[0].FlavorText)
+
+	assert.Equal(t, "langBoltJapanese", printings[1].ScryfallID)
+	assert.Equal(t, "ja", printings[1].Language)
+	assert.Equal(t, "稲妻", printings[1].PrintedName)
+	assert.Equal(t, japanese.PrintedText, printings[1].PrintedText)
+	assert.Equal(t, "インスタント", printings[1].PrintedTypeLine)
+	assert.Equal(t, japanese.FlavorText, printings[1].FlavorText)
+
+	// faces first seen in another language in the same batch as English are created in English
+	shock := *english
+	shock.ID = "langShockEnglish"
+	shock.Name = "Shock"
+	shock.OracleID = "langShockOracleID"
+	shock.FlavorText = "Lightning tethers souls to the world."
+
+	shockJapanese := shock
+	shockJapanese.ID = "langShockJapanese"
+	shockJapanese.Language = "ja"
+	shockJapanese.PrintedName = "ショック"
+	shockJapanese.FlavorText = "稲妻は魂を世界に繋ぎとめる。"
+
+	loadCards(t, db, DefaultLoadOptions, &shockJapanese, &shock)
+
+	face, err = db.CardFace.Query().Where(cardface.HasCardWith(card.OracleIDEQ("langShockOracleID"))).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "en", face.Language)
+	assert.Equal(t, shock.FlavorText, face.FlavorText)
+	assert.Equal(t, 2, face.QueryPrintings().CountX(ctx))
+}
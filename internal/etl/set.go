@@ -0,0 +1,101 @@
+package etl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"go.uber.org/zap"
+)
+
+// ScryfallSets creates or updates a set for each of the provided sets from
+// Scryfall's API, with all of their metadata.
+//
+// The bulk card data only includes a few fields of each card's set, so this
+// should be run before ScryfallCards to fill in the rest.
+func ScryfallSets(ctx context.Context, logger *zap.Logger, db *bones.Client, sets []scryfall.Set) error {
+	txn, err := db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	for _, row := range sets {
+		if err := upsertSet(ctx, logger.With(zap.String("set_code", row.Code)), txn, row); err != nil {
+			_ = txn.Rollback()
+			return err
+		}
+	}
+
+	return txn.Commit()
+}
+
+// upsertSet creates a set from Scryfall's metadata, or updates it if a set
+// with the same code already exists.
+func upsertSet(ctx context.Context, logger *zap.Logger, db *bones.Tx, row scryfall.Set) error {
+	existingSet, err := db.Set.Query().Where(set.CodeEQ(row.Code)).Only(ctx)
+	if err == nil {
+		_, err = setSetMetadata(existingSet.Update(), row).Save(ctx)
+		if err != nil {
+			logger.Error("failed to update set", zap.Error(err))
+			return fmt.Errorf("failed to update set %q: %w", row.Code, err)
+		}
+
+		logger.Debug("updated set")
+
+		return nil
+	}
+
+	if !bones.IsNotFound(err) {
+		logger.Error("failed to query for existing set", zap.Error(err))
+		return fmt.Errorf("failed to query for existing set: %w", err)
+	}
+
+	_, err = setSetMetadata(db.Set.Create().SetCode(row.Code), row).Save(ctx)
+	if err != nil {
+		logger.Error("failed to create set", zap.Error(err))
+		return fmt.Errorf("failed to create set %q: %w", row.Code, err)
+	}
+
+	logger.Info("created new set")
+
+	return nil
+}
+
+// setMetadataSetter is implemented by both the create and update builders for sets.
+type setMetadataSetter[T any] interface {
+	SetName(string) T
+	SetScryfallID(string) T
+	SetSetType(string) T
+	SetNillableReleasedAt(*time.Time) T
+	SetCardCount(int) T
+	SetParentSetCode(string) T
+	SetBlock(string) T
+	SetBlockCode(string) T
+	SetDigital(bool) T
+	SetIconSvgURI(string) T
+}
+
+// setSetMetadata sets all of the fields of a set from Scryfall's metadata for it.
+func setSetMetadata[T setMetadataSetter[T]](builder T, row scryfall.Set) T {
+	var releasedAt *time.Time
+
+	if row.ReleasedAt != nil {
+		released := time.Time(*row.ReleasedAt)
+		releasedAt = &released
+	}
+
+	return builder.
+		SetName(row.Name).
+		SetScryfallID(row.ID).
+		SetSetType(row.SetType.String()).
+		SetNillableReleasedAt(releasedAt).
+		SetCardCount(row.CardCount).
+		SetParentSetCode(row.ParentSetCode).
+		SetBlock(row.Block).
+		SetBlockCode(row.BlockCode).
+		SetDigital(row.Digital).
+		SetIconSvgURI(row.IconSVGURI)
+}
@@ -0,0 +1,63 @@
+package etl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestScryfallSets(t *testing.T) {
+	db := testutils.NewDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	released := scryfall.Date(time.Date(2021, 6, 18, 0, 0, 0, 0, time.UTC))
+
+	sets := []scryfall.Set{
+		{
+			ID:         "c1c7eb8c-f205-40ab-a609-767cb296544e",
+			Code:       "mh2",
+			Name:       "Modern Horizons 2",
+			SetType:    scryfall.SetTypeDraftInnovation,
+			ReleasedAt: &released,
+			CardCount:  491,
+			IconSVGURI: "https://svgs.scryfall.io/sets/mh2.svg",
+		},
+		{
+			Code:          "tmh2",
+			Name:          "Modern Horizons 2 Tokens",
+			SetType:       scryfall.SetTypeToken,
+			ParentSetCode: "mh2",
+			CardCount:     27,
+		},
+	}
+
+	err := ScryfallSets(ctx, zap.NewNop(), db, sets)
+	require.NoError(t, err)
+
+	// loading again should update the sets instead of creating new ones
+	sets[0].CardCount = 492
+
+	err = ScryfallSets(ctx, zap.NewNop(), db, sets)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, db.Set.Query().Where(set.CodeIn("mh2", "tmh2")).CountX(ctx))
+
+	mh2 := db.Set.Query().Where(set.CodeEQ("mh2")).OnlyX(ctx)
+	assert.Equal(t, "draft_innovation", mh2.SetType)
+	assert.Equal(t, 492, mh2.CardCount)
+	require.NotNil(t, mh2.ReleasedAt)
+	assert.True(t, time.Time(released).Equal(*mh2.ReleasedAt))
+
+	tokens := db.Set.Query().Where(set.CodeEQ("tmh2")).OnlyX(ctx)
+	assert.Equal(t, "mh2", tokens.ParentSetCode)
+	assert.Nil(t, tokens.ReleasedAt)
+}
@@ -0,0 +1,990 @@
+package etl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/printingimage"
+	"github.com/SethCurry/stax/internal/bones/ruling"
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"go.uber.org/zap"
+)
+
+// maxBulkRows is the most rows that are inserted by a single statement, since
+// SQLite limits the number of variables a statement can have.
+const maxBulkRows = 500
+
+// englishLanguage is the language code of cards printed in English.
+const englishLanguage = "en"
+
+// printingLanguage returns the language a card is printed in, which is
+// English if Scryfall doesn't say.
+func printingLanguage(row *scryfall.Card) string {
+	if row.Language == "" {
+		return englishLanguage
+	}
+
+	return row.Language
+}
+
+type faceKey struct {
+	cardID int
+	name   string
+}
+
+type printingKey struct {
+	scryfallID string
+	faceID     int
+}
+
+// legacyPrintingKey identifies a printing created before Scryfall's IDs
+// were stored, which can only be matched on its rarity, artist and set.
+type legacyPrintingKey struct {
+	setID    int
+	faceID   int
+	artistID int
+	rarity   printing.Rarity
+}
+
+// createdPrinting is a printing that is being created by a batch.
+type createdPrinting struct {
+	key       printingKey
+	legacyKey legacyPrintingKey
+	images    []imageRecord
+}
+
+// cardWriter writes cardRecords to the database in batches.  It caches the
+// IDs of everything it has written or found in the database, so that nothing
+// is queried or written twice.
+type cardWriter struct {
+	logger    *zap.Logger
+	db        *bones.Tx
+	batchSize int
+	sync      bool
+	batch     []*cardRecord
+
+	artists         map[string]int
+	sets            map[string]int
+	cards           map[string]int
+	faces           map[faceKey]int
+	printings       map[printingKey]int
+	legacyPrintings map[legacyPrintingKey]int
+
+	// faces whose text was loaded from a printing that isn't in English, which is
+	// replaced by the English text if an English printing of the face is loaded
+	localizedFaces map[faceKey]bool
+
+	// the content hashes of rows that were already in the database, by their ID,
+	// which are removed once each row has been synced
+	cardHashes     map[int]string
+	faceHashes     map[int]string
+	printingHashes map[int]string
+
+	// the IDs of every printing in the file, so that the rest can be deleted when syncing
+	seenPrintings map[int]bool
+	updated       int
+}
+
+// newCardWriter creates a cardWriter, loading the IDs of everything already in the database.
+func newCardWriter(ctx context.Context, logger *zap.Logger, db *bones.Tx, opts LoadOptions) (*cardWriter, error) {
+	writer := &cardWriter{
+		logger:          logger,
+		db:              db,
+		batchSize:       opts.BatchSize,
+		sync:            opts.Sync,
+		batch:           make([]*cardRecord, 0, opts.BatchSize),
+		artists:         make(map[string]int),
+		sets:            make(map[string]int),
+		cards:           make(map[string]int),
+		faces:           make(map[faceKey]int),
+		printings:       make(map[printingKey]int),
+		legacyPrintings: make(map[legacyPrintingKey]int),
+		localizedFaces:  make(map[faceKey]bool),
+		cardHashes:      make(map[int]string),
+		faceHashes:      make(map[int]string),
+		printingHashes:  make(map[int]string),
+		seenPrintings:   make(map[int]bool),
+	}
+
+	var cards []struct {
+		ID          int    `json:"id"`
+		OracleID    string `json:"oracle_id"`
+		ContentHash string `json:"content_hash"`
+	}
+
+	err := db.Card.Query().Select(card.FieldID, card.FieldOracleID, card.FieldContentHash).Scan(ctx, &cards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing cards: %w", err)
+	}
+
+	for _, c := range cards {
+		writer.cards[c.OracleID] = c.ID
+		writer.cardHashes[c.ID] = c.ContentHash
+	}
+
+	var artists []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	if err = db.Artist.Query().Select(artist.FieldID, artist.FieldName).Scan(ctx, &artists); err != nil {
+		return nil, fmt.Errorf("failed to query existing artists: %w", err)
+	}
+
+	for _, a := range artists {
+		writer.artists[a.Name] = a.ID
+	}
+
+	// sets may have been loaded by ScryfallSets before any cards were
+	var sets []struct {
+		ID   int    `json:"id"`
+		Code string `json:"code"`
+	}
+
+	if err = db.Set.Query().Select(set.FieldID, set.FieldCode).Scan(ctx, &sets); err != nil {
+		return nil, fmt.Errorf("failed to query existing sets: %w", err)
+	}
+
+	for _, s := range sets {
+		writer.sets[s.Code] = s.ID
+	}
+
+	var faces []struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Language    string `json:"language"`
+		ContentHash string `json:"content_hash"`
+		CardID      *int   `json:"card_face_card"`
+	}
+
+	err = db.CardFace.Query().
+		Select(cardface.FieldID, cardface.FieldName, cardface.FieldLanguage, cardface.FieldContentHash, cardface.CardColumn).
+		Scan(ctx, &faces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing card faces: %w", err)
+	}
+
+	for _, f := range faces {
+		if f.CardID != nil {
+			key := faceKey{cardID: *f.CardID, name: f.Name}
+
+			writer.faces[key] = f.ID
+			writer.faceHashes[f.ID] = f.ContentHash
+
+			if f.Language != "" && f.Language != englishLanguage {
+				writer.localizedFaces[key] = true
+			}
+		}
+	}
+
+	var printings []struct {
+		ID          int    `json:"id"`
+		ScryfallID  string `json:"scryfall_id"`
+		Rarity      string `json:"rarity"`
+		ContentHash string `json:"content_hash"`
+		SetID       *int   `json:"printing_set"`
+		FaceID      *int   `json:"printing_card_face"`
+		ArtistID    *int   `json:"printing_artist"`
+	}
+
+	err = db.Printing.Query().
+		Select(
+			printing.FieldID,
+			printing.FieldScryfallID,
+			printing.FieldRarity,
+			printing.FieldContentHash,
+			printing.SetColumn,
+			printing.CardFaceColumn,
+			printing.ArtistColumn).
+		Scan(ctx, &printings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing printings: %w", err)
+	}
+
+	for _, p := range printings {
+		faceID := derefInt(p.FaceID)
+
+		if p.ScryfallID != "" {
+			writer.printings[printingKey{scryfallID: p.ScryfallID, faceID: faceID}] = p.ID
+			writer.printingHashes[p.ID] = p.ContentHash
+
+			continue
+		}
+
+		writer.legacyPrintings[legacyPrintingKey{
+			setID:    derefInt(p.SetID),
+			faceID:   faceID,
+			artistID: derefInt(p.ArtistID),
+			rarity:   printing.Rarity(p.Rarity),
+		}] = p.ID
+	}
+
+	logger.Debug("loaded existing records",
+		zap.Int("cards", len(writer.cards)),
+		zap.Int("printings", len(printings)))
+
+	return writer, nil
+}
+
+// derefInt returns the value of i, or zero if it is nil.
+func derefInt(i *int) int {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+// inChunks calls write with consecutive ranges of at most maxBulkRows of n rows.
+func inChunks(n int, write func(start, end int) error) error {
+	for start := 0; start < n; start += maxBulkRows {
+		if err := write(start, min(start+maxBulkRows, n)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// add queues a record to be written, writing the batch once it is full.
+func (w *cardWriter) add(ctx context.Context, record *cardRecord) error {
+	if record.row.OracleID == "" {
+		w.logger.Debug("ignoring card because it is missing an oracle ID", zap.String("card_name", record.row.Name))
+		return nil
+	}
+
+	w.batch = append(w.batch, record)
+
+	if len(w.batch) < w.batchSize {
+		return nil
+	}
+
+	return w.flush(ctx)
+}
+
+// flush writes all of the queued records.  Each table is written with bulk inserts
+// in the order of its dependencies, so that the IDs of new artists, sets, cards
+// and faces are known before the printings referencing them are created.
+func (w *cardWriter) flush(ctx context.Context) error {
+	if len(w.batch) == 0 {
+		return nil
+	}
+
+	steps := []func(context.Context) error{
+		w.writeArtists,
+		w.writeSets,
+		w.writeCards,
+		w.writeFaces,
+		w.writePrintings,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	w.logger.Debug("wrote batch of cards", zap.Int("count", len(w.batch)))
+
+	w.batch = w.batch[:0]
+
+	return nil
+}
+
+// finish writes any queued records and, when syncing, deletes everything that wasn't in the file.
+func (w *cardWriter) finish(ctx context.Context) error {
+	if err := w.flush(ctx); err != nil {
+		return err
+	}
+
+	if !w.sync {
+		return nil
+	}
+
+	w.logger.Info("updated changed rows", zap.Int("count", w.updated))
+
+	// an empty or truncated download shouldn't wipe out the database
+	if len(w.seenPrintings) == 0 {
+		w.logger.Warn("no printings were loaded, not deleting any")
+		return nil
+	}
+
+	return w.deleteVanished(ctx)
+}
+
+func (w *cardWriter) writeArtists(ctx context.Context) error {
+	names := []string{}
+	seen := make(map[string]bool)
+
+	for _, record := range w.batch {
+		for _, face := range record.faces {
+			if face.Artist == "" || seen[face.Artist] {
+				continue
+			}
+
+			if _, ok := w.artists[face.Artist]; !ok {
+				seen[face.Artist] = true
+				names = append(names, face.Artist)
+			}
+		}
+	}
+
+	return inChunks(len(names), func(start, end int) error {
+		builders := make([]*bones.ArtistCreate, 0, end-start)
+
+		for _, name := range names[start:end] {
+			builders = append(builders, w.db.Artist.Create().SetName(name))
+		}
+
+		created, err := w.db.Artist.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create artists: %w", err)
+		}
+
+		for _, a := range created {
+			w.artists[a.Name] = a.ID
+		}
+
+		return nil
+	})
+}
+
+func (w *cardWriter) writeSets(ctx context.Context) error {
+	builders := []*bones.SetCreate{}
+	seen := make(map[string]bool)
+
+	for _, record := range w.batch {
+		row := record.row
+
+		if _, ok := w.sets[row.SetCode]; ok || seen[row.SetCode] {
+			continue
+		}
+
+		seen[row.SetCode] = true
+
+		// cards only have a few fields of their set, the rest are filled in by ScryfallSets
+		create := w.db.Set.Create().
+			SetName(row.SetName).
+			SetCode(row.SetCode).
+			SetScryfallID(row.SetID).
+			SetSetType(row.SetType).
+			SetDigital(row.Digital).
+			SetNillableReleasedAt(releasedAt(row))
+
+		builders = append(builders, create)
+	}
+
+	return inChunks(len(builders), func(start, end int) error {
+		created, err := w.db.Set.CreateBulk(builders[start:end]...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create sets: %w", err)
+		}
+
+		for _, s := range created {
+			w.logger.Info("created new set", zap.String("set_code", s.Code))
+			w.sets[s.Code] = s.ID
+		}
+
+		return nil
+	})
+}
+
+// cardSetter is implemented by both the create and update builders for cards.
+type cardSetter[T any] interface {
+	SetName(string) T
+	SetColorIdentity(uint8) T
+	SetLayout(string) T
+	SetContentHash(string) T
+}
+
+// setCardFields sets all of the fields of a card that are loaded from Scryfall.
+func setCardFields[T cardSetter[T]](builder T, record *cardRecord) T {
+	builder = builder.
+		SetName(record.row.Name).
+		SetColorIdentity(record.colorIdentity).
+		SetContentHash(record.hash)
+	if record.row.Layout != "" {
+		builder = builder.SetLayout(string(record.row.Layout))
+	}
+
+	return builder
+}
+
+func (w *cardWriter) writeCards(ctx context.Context) error {
+	records := []*cardRecord{}
+	seen := make(map[string]bool)
+
+	for _, record := range w.batch {
+		oracleID := record.row.OracleID
+
+		if cardID, ok := w.cards[oracleID]; ok {
+			if err := w.syncCard(ctx, cardID, record); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if seen[oracleID] {
+			continue
+		}
+
+		seen[oracleID] = true
+		records = append(records, record)
+	}
+
+	return inChunks(len(records), func(start, end int) error {
+		builders := make([]*bones.CardCreate, 0, end-start)
+
+		for _, record := range records[start:end] {
+			builders = append(builders, setCardFields(w.db.Card.Create().SetOracleID(record.row.OracleID), record))
+		}
+
+		created, err := w.db.Card.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create cards: %w", err)
+		}
+
+		legalities := []*bones.LegalityCreate{}
+
+		for i, c := range created {
+			w.cards[c.OracleID] = c.ID
+			legalities = append(legalities, w.legalityBuilders(c.ID, records[start+i])...)
+		}
+
+		return w.createLegalities(ctx, legalities)
+	})
+}
+
+// syncCard updates a card that was already in the database the first time it is
+// seen while syncing, if it has changed since it was loaded.
+func (w *cardWriter) syncCard(ctx context.Context, cardID int, record *cardRecord) error {
+	hash, ok := w.cardHashes[cardID]
+	if !w.sync || !ok {
+		return nil
+	}
+
+	delete(w.cardHashes, cardID)
+
+	if hash == record.hash {
+		return nil
+	}
+
+	if err := setCardFields(w.db.Card.UpdateOneID(cardID), record).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update card %q: %w", record.row.Name, err)
+	}
+
+	if _, err := w.db.Legality.Delete().Where(legality.HasCardWith(card.IDEQ(cardID))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete legalities of card %q: %w", record.row.Name, err)
+	}
+
+	w.logger.Debug("updated card", zap.String("card_name", record.row.Name))
+	w.updated++
+
+	return w.createLegalities(ctx, w.legalityBuilders(cardID, record))
+}
+
+func (w *cardWriter) legalityBuilders(cardID int, record *cardRecord) []*bones.LegalityCreate {
+	builders := make([]*bones.LegalityCreate, 0, len(record.legalities))
+
+	for _, l := range record.legalities {
+		builders = append(builders, w.db.Legality.Create().
+			SetFormat(l.format).
+			SetLegality(l.legality).
+			SetCardID(cardID))
+	}
+
+	return builders
+}
+
+func (w *cardWriter) createLegalities(ctx context.Context, builders []*bones.LegalityCreate) error {
+	return inChunks(len(builders), func(start, end int) error {
+		if err := w.db.Legality.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create card legalities: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// cardFaceSetter is implemented by both the create and update builders for card faces.
+type cardFaceSetter[T any] interface {
+	SetName(string) T
+	SetFlavorText(string) T
+	SetOracleText(string) T
+	SetLanguage(string) T
+	SetCmc(float32) T
+	SetPower(string) T
+	SetToughness(string) T
+	SetLoyalty(string) T
+	SetNillablePowerValue(*float32) T
+	SetNillableToughnessValue(*float32) T
+	SetNillableLoyaltyValue(*float32) T
+	SetManaCost(string) T
+	SetTypeLine(string) T
+	SetColors(string) T
+	SetColorField(uint8) T
+	SetContentHash(string) T
+}
+
+// setCardFaceFields sets all of the fields of a card face that are loaded from Scryfall.
+// Stats that are nil are left unset.
+func setCardFaceFields[T cardFaceSetter[T]](builder T, record *cardRecord, face faceRecord) T {
+	return builder.
+		SetName(face.Name).
+		SetFlavorText(face.FlavorText).
+		SetOracleText(face.OracleText).
+		SetLanguage(record.row.Language).
+		SetCmc(face.CMC).
+		SetPower(face.Power).
+		SetToughness(face.Toughness).
+		SetLoyalty(face.Loyalty).
+		SetNillablePowerValue(face.powerValue).
+		SetNillableToughnessValue(face.toughnessValue).
+		SetNillableLoyaltyValue(face.loyaltyValue).
+		SetManaCost(face.ManaCost).
+		SetTypeLine(face.TypeLine).
+		SetColors(strings.Join(face.Colors, "")).
+		SetColorField(face.colorField).
+		SetContentHash(face.hash)
+}
+
+// writeFaces creates the faces of every card in the batch.  Faces are shared by
+// every printing of a card, so they are created from the first printing in English,
+// or from the first printing in another language until an English one is loaded.
+func (w *cardWriter) writeFaces(ctx context.Context) error {
+	keys := []faceKey{}
+	builders := []*bones.CardFaceCreate{}
+	pending := make(map[faceKey]int)
+
+	for _, record := range w.batch {
+		cardID := w.cards[record.row.OracleID]
+		english := printingLanguage(record.row) == englishLanguage
+
+		for _, face := range record.faces {
+			key := faceKey{cardID: cardID, name: face.Name}
+
+			if i, ok := pending[key]; ok {
+				if english && w.localizedFaces[key] {
+					builders[i] = setCardFaceFields(w.db.CardFace.Create().SetCardID(cardID), record, face)
+					delete(w.localizedFaces, key)
+				}
+
+				continue
+			}
+
+			if faceID, ok := w.faces[key]; ok {
+				if err := w.existingCardFace(ctx, key, faceID, record, face); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			pending[key] = len(builders)
+			keys = append(keys, key)
+
+			builders = append(builders, setCardFaceFields(w.db.CardFace.Create().SetCardID(cardID), record, face))
+
+			if !english {
+				w.localizedFaces[key] = true
+			}
+		}
+	}
+
+	return inChunks(len(builders), func(start, end int) error {
+		created, err := w.db.CardFace.CreateBulk(builders[start:end]...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create card faces: %w", err)
+		}
+
+		for i, f := range created {
+			w.faces[keys[start+i]] = f.ID
+		}
+
+		return nil
+	})
+}
+
+// existingCardFace updates a card face that was already written with the English
+// text of record if the face's text wasn't in English, or syncs it otherwise.
+// Only printings in the same language as the face are used to sync it, so that
+// syncing a file with every language doesn't replace its text with a translation.
+func (w *cardWriter) existingCardFace(
+	ctx context.Context,
+	key faceKey,
+	faceID int,
+	record *cardRecord,
+	face faceRecord,
+) error {
+	english := printingLanguage(record.row) == englishLanguage
+
+	if english && w.localizedFaces[key] {
+		delete(w.localizedFaces, key)
+		delete(w.faceHashes, faceID)
+
+		w.updated++
+
+		return w.updateCardFace(ctx, faceID, record, face)
+	}
+
+	if !english && !w.localizedFaces[key] {
+		return nil
+	}
+
+	return w.syncCardFace(ctx, faceID, record, face)
+}
+
+// syncCardFace updates a card face that was already in the database the first time
+// it is seen while syncing, if it has changed since it was loaded.
+func (w *cardWriter) syncCardFace(ctx context.Context, faceID int, record *cardRecord, face faceRecord) error {
+	hash, ok := w.faceHashes[faceID]
+	if !w.sync || !ok {
+		return nil
+	}
+
+	delete(w.faceHashes, faceID)
+
+	if hash == face.hash {
+		return nil
+	}
+
+	w.updated++
+
+	return w.updateCardFace(ctx, faceID, record, face)
+}
+
+// updateCardFace sets all of the fields of an existing card face.
+func (w *cardWriter) updateCardFace(ctx context.Context, faceID int, record *cardRecord, face faceRecord) error {
+	update := setCardFaceFields(w.db.CardFace.UpdateOneID(faceID), record, face)
+	if face.powerValue == nil {
+		update = update.ClearPowerValue()
+	}
+	if face.toughnessValue == nil {
+		update = update.ClearToughnessValue()
+	}
+	if face.loyaltyValue == nil {
+		update = update.ClearLoyaltyValue()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update card face %q: %w", face.Name, err)
+	}
+
+	w.logger.Debug("updated card face", zap.String("card_face_name", face.Name))
+
+	return nil
+}
+
+// releasedAt returns the date a card was released, or nil if it doesn't have one.
+func releasedAt(row *scryfall.Card) *time.Time {
+	released := time.Time(row.ReleasedAt)
+	if released.IsZero() {
+		return nil
+	}
+
+	return &released
+}
+
+// printingSetter is implemented by both the create and update builders for printings.
+type printingSetter[T any] interface {
+	printingIDSetter[T]
+	SetSetID(int) T
+	SetRarity(printing.Rarity) T
+	SetIllustrationID(string) T
+	SetNillableReleasedAt(*time.Time) T
+	SetLanguage(string) T
+	SetPrintedName(string) T
+	SetPrintedText(string) T
+	SetPrintedTypeLine(string) T
+	SetFlavorText(string) T
+	SetContentHash(string) T
+}
+
+// setPrintingFields sets all of the fields of a printing that are loaded from Scryfall,
+// apart from its artist.  Fields that are nil or zero are left unset.
+func setPrintingFields[T printingSetter[T]](builder T, row *scryfall.Card, face faceRecord, setID int) T {
+	builder = builder.
+		SetSetID(setID).
+		SetRarity(printing.Rarity(row.Rarity)).
+		SetIllustrationID(face.IllustrationID).
+		SetNillableReleasedAt(releasedAt(row)).
+		SetLanguage(printingLanguage(row)).
+		SetPrintedName(face.PrintedName).
+		SetPrintedText(face.PrintedText).
+		SetPrintedTypeLine(face.PrintedTypeLine).
+		SetFlavorText(face.FlavorText).
+		SetContentHash(face.printingHash)
+
+	return setPrintingIDs(builder, row)
+}
+
+func (w *cardWriter) writePrintings(ctx context.Context) error {
+	builders := []*bones.PrintingCreate{}
+	created := []createdPrinting{}
+
+	for _, record := range w.batch {
+		row := record.row
+		cardID := w.cards[row.OracleID]
+		setID := w.sets[row.SetCode]
+
+		for _, face := range record.faces {
+			faceID := w.faces[faceKey{cardID: cardID, name: face.Name}]
+			artistID := w.artists[face.Artist]
+
+			key := printingKey{scryfallID: row.ID, faceID: faceID}
+			if printingID, ok := w.printings[key]; ok && row.ID != "" {
+				if err := w.syncPrinting(ctx, printingID, row, face, setID, artistID); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			legacyKey := legacyPrintingKey{setID: setID, faceID: faceID, artistID: artistID, rarity: printing.Rarity(row.Rarity)}
+			if legacyID, ok := w.legacyPrintings[legacyKey]; ok {
+				// a printing without an ID that was created in this batch won't have its own ID yet
+				if row.ID == "" || legacyID == 0 {
+					if legacyID != 0 {
+						w.seenPrintings[legacyID] = true
+					}
+
+					continue
+				}
+
+				// printings from before we stored Scryfall's IDs need them filled in
+				if err := w.updatePrinting(ctx, legacyID, row, face, setID, artistID); err != nil {
+					return err
+				}
+
+				delete(w.legacyPrintings, legacyKey)
+				w.printings[key] = legacyID
+				w.seenPrintings[legacyID] = true
+
+				continue
+			}
+
+			create := setPrintingFields(w.db.Printing.Create().SetCardFaceID(faceID), row, face, setID)
+			if artistID != 0 {
+				create = create.SetArtistID(artistID)
+			}
+
+			// the IDs are filled in once the printings are created
+			if row.ID != "" {
+				w.printings[key] = 0
+			} else {
+				w.legacyPrintings[legacyKey] = 0
+			}
+
+			builders = append(builders, create)
+			created = append(created, createdPrinting{key: key, legacyKey: legacyKey, images: face.images})
+		}
+	}
+
+	return inChunks(len(builders), func(start, end int) error {
+		printings, err := w.db.Printing.CreateBulk(builders[start:end]...).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create printings: %w", err)
+		}
+
+		imageBuilders := []*bones.PrintingImageCreate{}
+
+		for i, p := range printings {
+			pending := created[start+i]
+
+			if p.ScryfallID != "" {
+				w.printings[pending.key] = p.ID
+			} else {
+				w.legacyPrintings[pending.legacyKey] = p.ID
+			}
+
+			w.seenPrintings[p.ID] = true
+			imageBuilders = append(imageBuilders, w.imageBuilders(p.ID, pending.images)...)
+		}
+
+		return w.createImages(ctx, imageBuilders)
+	})
+}
+
+// syncPrinting updates a printing that was already in the database while syncing,
+// if it has changed since it was loaded.
+func (w *cardWriter) syncPrinting(
+	ctx context.Context,
+	printingID int,
+	row *scryfall.Card,
+	face faceRecord,
+	setID int,
+	artistID int,
+) error {
+	// the printing is being created by this batch
+	if printingID == 0 {
+		return nil
+	}
+
+	w.seenPrintings[printingID] = true
+
+	hash, ok := w.printingHashes[printingID]
+	if !w.sync || !ok {
+		return nil
+	}
+
+	delete(w.printingHashes, printingID)
+
+	if hash == face.printingHash {
+		return nil
+	}
+
+	w.updated++
+
+	return w.updatePrinting(ctx, printingID, row, face, setID, artistID)
+}
+
+// updatePrinting sets all of the fields of an existing printing and replaces its images.
+func (w *cardWriter) updatePrinting(
+	ctx context.Context,
+	printingID int,
+	row *scryfall.Card,
+	face faceRecord,
+	setID int,
+	artistID int,
+) error {
+	update := setPrintingFields(w.db.Printing.UpdateOneID(printingID), row, face, setID)
+	if artistID != 0 {
+		update = update.SetArtistID(artistID)
+	} else {
+		update = update.ClearArtist()
+	}
+	if releasedAt(row) == nil {
+		update = update.ClearReleasedAt()
+	}
+	if row.MTGOID == 0 {
+		update = update.ClearMtgoID()
+	}
+	if row.ArenaID == 0 {
+		update = update.ClearArenaID()
+	}
+	if row.TCGPlayerID == 0 {
+		update = update.ClearTcgplayerID()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update printing %q: %w", row.ID, err)
+	}
+
+	_, err := w.db.PrintingImage.Delete().
+		Where(printingimage.HasPrintingWith(printing.IDEQ(printingID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete images of printing %q: %w", row.ID, err)
+	}
+
+	w.logger.Debug("updated printing", zap.String("scryfall_id", row.ID), zap.String("card_face_name", face.Name))
+
+	return w.createImages(ctx, w.imageBuilders(printingID, face.images))
+}
+
+func (w *cardWriter) imageBuilders(printingID int, images []imageRecord) []*bones.PrintingImageCreate {
+	builders := make([]*bones.PrintingImageCreate, 0, len(images))
+
+	for _, image := range images {
+		builders = append(builders, w.db.PrintingImage.Create().
+			SetURL(image.url).
+			SetImageType(image.imageType).
+			SetPrintingID(printingID))
+	}
+
+	return builders
+}
+
+func (w *cardWriter) createImages(ctx context.Context, builders []*bones.PrintingImageCreate) error {
+	return inChunks(len(builders), func(start, end int) error {
+		if err := w.db.PrintingImage.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create printing images: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// deleteVanished deletes every printing that wasn't in the file, and then any card faces
+// and cards that are left without printings.  Edges are set to NULL when the row they
+// point to is deleted, so the rows that belong to deleted rows are deleted first.
+func (w *cardWriter) deleteVanished(ctx context.Context) error {
+	printingIDs, err := w.db.Printing.Query().IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query printing IDs: %w", err)
+	}
+
+	vanished := []int{}
+
+	for _, id := range printingIDs {
+		if !w.seenPrintings[id] {
+			vanished = append(vanished, id)
+		}
+	}
+
+	err = inChunks(len(vanished), func(start, end int) error {
+		ids := vanished[start:end]
+
+		_, err := w.db.PrintingImage.Delete().Where(printingimage.HasPrintingWith(printing.IDIn(ids...))).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete printing images: %w", err)
+		}
+
+		if _, err = w.db.Printing.Delete().Where(printing.IDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete printings: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	deletedFaces, err := w.db.CardFace.Delete().Where(cardface.Not(cardface.HasPrintings())).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete card faces: %w", err)
+	}
+
+	cardIDs, err := w.db.Card.Query().Where(card.Not(card.HasFaces())).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query cards without faces: %w", err)
+	}
+
+	err = inChunks(len(cardIDs), func(start, end int) error {
+		ids := cardIDs[start:end]
+
+		if _, err := w.db.Legality.Delete().Where(legality.HasCardWith(card.IDIn(ids...))).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete legalities: %w", err)
+		}
+
+		if _, err := w.db.Ruling.Delete().Where(ruling.HasCardWith(card.IDIn(ids...))).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete rulings: %w", err)
+		}
+
+		if _, err := w.db.Card.Delete().Where(card.IDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete cards: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	w.logger.Info("deleted rows that are no longer in the file",
+		zap.Int("printings", len(vanished)),
+		zap.Int("card_faces", deletedFaces),
+		zap.Int("cards", len(cardIDs)))
+
+	return nil
+}
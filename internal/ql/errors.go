@@ -1,12 +1,20 @@
 package ql
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // ErrNoField is returned when a query specifies a field that does not exist.
 // For example, "asdf > 2" would return this error (unless you have registered
 // a custom field called "asdf" with the *Parser).
 type ErrNoField struct {
 	Field string
+
+	// Suggestions holds the names of registered fields that are similar
+	// to Field, in case it was misspelled.
+	Suggestions []string
 }
 
 func (e *ErrNoField) Error() string {
@@ -19,8 +27,133 @@ func (e *ErrNoField) Error() string {
 type ErrNoOperationForField struct {
 	Field    string
 	Operator operator
+
+	// Supported holds the operators the field does support.
+	Supported []string
 }
 
 func (e *ErrNoOperationForField) Error() string {
 	return fmt.Sprintf("field %q has no such operation: %s", e.Field, e.Operator)
 }
+
+// ErrEmptyQuery is returned when parsing a query that has no terms in it.
+var ErrEmptyQuery = errors.New("query is empty")
+
+// ErrUnexpectedEOF is returned when a query ends in the middle of a term,
+// such as "cmc>" or "name=foo AND".
+var ErrUnexpectedEOF = errors.New("unexpected end of query")
+
+// ErrUnclosedParen is returned when a query opens a parenthesized group
+// but never closes it, such as "(c=R OR c=G".
+var ErrUnclosedParen = errors.New("unclosed parenthesis")
+
+// ErrMismatchedQuotes is returned when a query opens a quoted literal
+// but never closes it, such as `name="Static Orb`.
+var ErrMismatchedQuotes = errors.New("mismatched quotes")
+
+// ErrNegatedOption is returned when a query negates a term that
+// changes how results are displayed instead of filtering them, such as
+// "-order:cmc".
+var ErrNegatedOption = errors.New("search options cannot be negated")
+
+// ErrUnexpectedToken is returned when the parser encounters a token that
+// is not valid at its position in the query, such as the ")" in "cmc>3)".
+type ErrUnexpectedToken struct {
+	Token    Token
+	Expected []string
+}
+
+func (e *ErrUnexpectedToken) Error() string {
+	return fmt.Sprintf("unexpected %s %q, expected %s", e.Token.Family, e.Token.Value, strings.Join(e.Expected, " or "))
+}
+
+// QueryError wraps any error returned while lexing or parsing a query
+// with the location of the problem in the query, so it can be pointed
+// out to the user.  Use errors.As to get one from ParseQuery.
+//
+// The underlying error is still available via errors.Is and errors.As.
+type QueryError struct {
+	// Query is the query that failed to parse.  It is only set when
+	// parsing a string, not when calling ParseTokens directly.
+	Query string
+
+	// Offset is the byte offset of the start of the problem in the query.
+	Offset int
+
+	// Length is the number of bytes the problem spans in the query.
+	// It's 0 for problems like the query ending too early.
+	Length int
+
+	// Expected lists what the parser expected to find at Offset, if anything.
+	Expected []string
+
+	// Suggestions lists field names similar to a misspelled one.
+	Suggestions []string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// newQueryError creates a new *QueryError for the given span of the query.
+func newQueryError(err error, offset int, length int) *QueryError {
+	queryErr := &QueryError{
+		Err:    err,
+		Offset: offset,
+		Length: length,
+	}
+
+	var unexpected *ErrUnexpectedToken
+	if errors.As(err, &unexpected) {
+		queryErr.Expected = unexpected.Expected
+	}
+
+	var noField *ErrNoField
+	if errors.As(err, &noField) {
+		queryErr.Suggestions = noField.Suggestions
+	}
+
+	var noOperation *ErrNoOperationForField
+	if errors.As(err, &noOperation) {
+		queryErr.Expected = noOperation.Supported
+	}
+
+	return queryErr
+}
+
+// newQueryErrorAt creates a new *QueryError spanning the given token.
+func newQueryErrorAt(err error, token *Token) *QueryError {
+	return newQueryError(err, token.Offset, token.Length)
+}
+
+func (e *QueryError) Error() string {
+	msg := e.Err.Error()
+
+	if len(e.Suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean %q?)", strings.Join(e.Suggestions, `" or "`))
+	}
+
+	return msg
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// Diagram renders the query with carets underneath the part that caused
+// the error, followed by the error message, e.g.:
+//
+//	name=foo cnc>3
+//	         ^^^
+//	no such field: cnc (did you mean "cmc"?)
+func (e *QueryError) Diagram() string {
+	// The offset is in bytes, but the carets need to line up with
+	// characters when the query has multi-byte characters in it.
+	padding := len([]rune(e.Query[:min(e.Offset, len(e.Query))]))
+	carets := len([]rune(e.Query[min(e.Offset, len(e.Query)):min(e.Offset+e.Length, len(e.Query))]))
+
+	return fmt.Sprintf("%s\n%s%s\n%s",
+		e.Query,
+		strings.Repeat(" ", padding),
+		strings.Repeat("^", max(carets, 1)),
+		e.Error())
+}
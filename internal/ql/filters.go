@@ -1,12 +1,22 @@
 package ql
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/SethCurry/stax/internal/bones/artist"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/SethCurry/stax/pkg/stax"
 )
 
 // FieldFilterHandler is a function that can return an AST node for
@@ -43,17 +53,62 @@ func (f *FieldFilter) MatchesName(name string) bool {
 }
 
 // Handle calls the handler for the given operator and value.
+//
+// If the field has no handler registered for :, the = handler is used
+// instead.  If it has none for !=, the = handler is used and its result
+// negated, so every field supports : and != for free.
 func (f *FieldFilter) Handle(op operator, value string) (leaf, error) {
 	if handler, ok := f.Handlers[op]; ok {
 		return handler(value)
 	}
 
+	if eqHandler, ok := f.Handlers[opEQ]; ok && op == opColon {
+		return eqHandler(value)
+	}
+
+	if eqHandler, ok := f.Handlers[opEQ]; ok && op == opNE {
+		eqLeaf, err := eqHandler(value)
+		if err != nil {
+			return nil, err
+		}
+
+		// options like "order:cmc" don't filter anything, so they can't be negated
+		if _, isOption := eqLeaf.(*optionLeaf); isOption {
+			return nil, &ErrNoOperationForField{Field: f.Name, Operator: op, Supported: []string{string(opEQ)}}
+		}
+
+		return newNotNode(eqLeaf), nil
+	}
+
 	return nil, &ErrNoOperationForField{
-		Field:    f.Name,
-		Operator: op,
+		Field:     f.Name,
+		Operator:  op,
+		Supported: f.supportedOperators(),
 	}
 }
 
+// supportedOperators returns the operators the field has handlers for, sorted.
+func (f *FieldFilter) supportedOperators() []string {
+	supported := []string{}
+
+	for op := range f.Handlers {
+		supported = append(supported, string(op))
+	}
+
+	// : and != are supported for free when = is
+	if _, hasEQ := f.Handlers[opEQ]; hasEQ {
+		for _, op := range []operator{opColon, opNE} {
+			if _, ok := f.Handlers[op]; !ok {
+				supported = append(supported, string(op))
+			}
+		}
+	}
+
+	sort.Strings(supported)
+
+	return supported
+}
+
 func FloatFieldFilterHandler(handler func(value float32) (leaf, error)) FieldFilterHandler {
 	return func(value string) (leaf, error) {
 		f, err := strconv.ParseFloat(value, 64)
@@ -65,90 +120,405 @@ func FloatFieldFilterHandler(handler func(value float32) (leaf, error)) FieldFil
 	}
 }
 
-// colorsNotInQuery returns a slice of colors that are not in the query.
-// This is useful for constructing some of the comparison operators for colors
-// and color identities.
-func colorsNotInQuery(queryColors []string) []string {
-	notInQuery := []string{}
+// likeEscaper escapes the LIKE wildcards so user input is matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
-	for _, color := range allColors {
-		found := false
+// typeLineHasWord returns a predicate matching card faces whose type line
+// contains the given word as a whole word, case-insensitively.
+// The type line is padded with spaces so that words at the start and end
+// of the line match too, while "elf" will not match "Self".
+func typeLineHasWord(word string) predicate.CardFace {
+	return predicate.CardFace(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(' ' || LOWER(").
+				Ident(s.C(cardface.FieldTypeLine)).
+				WriteString(") || ' ') LIKE ").
+				Arg("% " + likeEscaper.Replace(strings.ToLower(word)) + " %").
+				WriteString(" ESCAPE ").
+				Arg(`\`)
+		}))
+	})
+}
 
-		for _, queryColor := range queryColors {
-			if color == queryColor {
-				found = true
-				break
-			}
+// typesEQ matches cards with a face whose type line contains every word
+// in the value, such as "legendary creature" or "elf".
+func typesEQ() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		words := strings.Fields(value)
+		if len(words) == 0 {
+			return nil, errors.New("no types specified")
 		}
 
-		if !found {
-			notInQuery = append(notInQuery, color)
+		preds := make([]predicate.CardFace, 0, len(words))
+
+		for _, word := range words {
+			preds = append(preds, typeLineHasWord(word))
 		}
+
+		return &basicLeaf{predicator: card.HasFacesWith(cardface.And(preds...))}, nil
 	}
+}
 
-	return notInQuery
+// statComparison holds the SQL predicates used to implement an operator
+// for the numeric stat fields.
+type statComparison struct {
+	toValue  func(col string, value any) *sql.Predicate
+	toColumn func(col1, col2 string) *sql.Predicate
 }
 
-var allColors = []string{"W", "U", "B", "R", "G"}
+var statComparisons = map[operator]statComparison{
+	opEQ: {toValue: sql.EQ, toColumn: sql.ColumnsEQ},
+	opNE: {toValue: sql.NEQ, toColumn: sql.ColumnsNEQ},
+	opLT: {toValue: sql.LT, toColumn: sql.ColumnsLT},
+	opLE: {toValue: sql.LTE, toColumn: sql.ColumnsLTE},
+	opGT: {toValue: sql.GT, toColumn: sql.ColumnsGT},
+	opGE: {toValue: sql.GTE, toColumn: sql.ColumnsGTE},
+}
 
-type colorQuery struct {
-	MustInclude []string
-	MustExclude []string
+// statColumns maps the names that can be used on the right-hand side of a
+// stat comparison, like the "tou" in "pow>tou", to their card face columns.
+var statColumns = map[string]string{
+	"pow":       cardface.FieldPowerValue,
+	"power":     cardface.FieldPowerValue,
+	"tou":       cardface.FieldToughnessValue,
+	"toughness": cardface.FieldToughnessValue,
+	"loy":       cardface.FieldLoyaltyValue,
+	"loyalty":   cardface.FieldLoyaltyValue,
+	"cmc":       cardface.FieldCmc,
 }
 
-func (c *colorQuery) toPredicator() predicate.Card {
-	preds := []predicate.Card{}
+// statHandler returns a FieldFilterHandler comparing a numeric card face column
+// against either a number like "3" or "1+*", or another stat like "tou".
+// Faces without the stat never match, so "pow!=3" doesn't return instants.
+func statHandler(column string, comparison statComparison) FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		if otherColumn, ok := statColumns[strings.ToLower(value)]; ok {
+			return &basicLeaf{predicator: card.HasFacesWith(func(s *sql.Selector) {
+				s.Where(comparison.toColumn(s.C(column), s.C(otherColumn)))
+			})}, nil
+		}
+
+		parsed, ok := stax.ParseStat(value)
+		if !ok {
+			return nil, fmt.Errorf("invalid stat: %q", value)
+		}
 
-	for _, include := range c.MustInclude {
-		preds = append(preds, card.HasFacesWith(cardface.ColorsContainsFold(include)))
+		return &basicLeaf{predicator: card.HasFacesWith(func(s *sql.Selector) {
+			s.Where(comparison.toValue(s.C(column), parsed))
+		})}, nil
 	}
+}
+
+// newStatFieldFilter creates a FieldFilter supporting every comparison
+// operator for a numeric card face column, such as power or loyalty.
+func newStatFieldFilter(name string, aliases []string, column string) FieldFilter {
+	handlers := map[operator]FieldFilterHandler{}
 
-	for _, exclude := range c.MustExclude {
-		preds = append(preds, card.HasFacesWith(cardface.Not(cardface.ColorsContainsFold(exclude))))
+	for op, comparison := range statComparisons {
+		handlers[op] = statHandler(column, comparison)
 	}
 
-	return card.And(preds...)
+	return FieldFilter{
+		Name:     name,
+		Aliases:  aliases,
+		Handlers: handlers,
+	}
+}
+
+// allColorsField is a ColorField with every color set.
+var allColorsField = stax.ColorField(0b11111)
+
+// colorFieldComparisons maps each operator to a function that builds a
+// bitwise comparison between a ColorField column and a set of colors.
+// The comparisons follow Scryfall's semantics: <= means "a subset of",
+// >= means "a superset of", and < and > exclude the exact set of colors.
+var colorFieldComparisons = map[operator]func(column string, colors stax.ColorField) *sql.Predicate{
+	opEQ: func(column string, colors stax.ColorField) *sql.Predicate {
+		return sql.EQ(column, uint8(colors))
+	},
+	opNE: func(column string, colors stax.ColorField) *sql.Predicate {
+		return sql.NEQ(column, uint8(colors))
+	},
+	opLE: colorFieldSubset,
+	opLT: func(column string, colors stax.ColorField) *sql.Predicate {
+		return sql.And(colorFieldSubset(column, colors), sql.NEQ(column, uint8(colors)))
+	},
+	opGE: colorFieldSuperset,
+	opGT: func(column string, colors stax.ColorField) *sql.Predicate {
+		return sql.And(colorFieldSuperset(column, colors), sql.NEQ(column, uint8(colors)))
+	},
+}
+
+// colorFieldMasked returns a predicate checking that column & mask = expected.
+func colorFieldMasked(column string, mask stax.ColorField, expected stax.ColorField) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteString(" & ").Arg(uint8(mask)).WriteString(" = ").Arg(uint8(expected))
+	})
+}
+
+// colorFieldSubset matches ColorField columns with no colors outside of colors.
+func colorFieldSubset(column string, colors stax.ColorField) *sql.Predicate {
+	return colorFieldMasked(column, allColorsField&^colors, 0)
+}
+
+// colorFieldSuperset matches ColorField columns with at least all of colors.
+func colorFieldSuperset(column string, colors stax.ColorField) *sql.Predicate {
+	return colorFieldMasked(column, colors, colors)
+}
+
+// colorCountComparison returns a predicate comparing the number of colors
+// set in a ColorField column against count, e.g. for "c>=2".
+func colorCountComparison(column string, op operator, count int) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString("(")
+
+		for i := range stax.AllColors {
+			if i > 0 {
+				b.WriteString(" + ")
+			}
+
+			b.WriteString("((").Ident(column).WriteString(fmt.Sprintf(" >> %d) & 1)", i))
+		}
+
+		b.WriteString(") " + string(op) + " ").Arg(count)
+	})
 }
 
-// FieldFilterHandlerWithColors is a helper function that converts a string of colors
-// into a slice of colors and calls the given handler.
-// You can use this to create FieldFilterHandlers with the colors already parsed.
-func FieldFilterHandlerWithColors(handler func([]string) (leaf, error)) FieldFilterHandler {
-	return func(colorString string) (leaf, error) {
-		var colors []string
+// colorFieldHandler returns a FieldFilterHandler for a ColorField column of cards,
+// where column returns the column, or an expression, for a card selector.  The
+// value can be a set of colors like "wub" or "esper", "c" for colorless, "m" for
+// multicolored, or a number to compare against the number of colors.
+func colorFieldHandler(column func(*sql.Selector) string, op operator) FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		var pred func(column string) *sql.Predicate
+
+		count, countErr := strconv.Atoi(value)
+
+		switch lowered := strings.ToLower(value); {
+		case countErr == nil:
+			pred = func(column string) *sql.Predicate {
+				return colorCountComparison(column, op, count)
+			}
+		case lowered == "m" || lowered == "multicolor":
+			switch op {
+			case opEQ:
+				pred = func(column string) *sql.Predicate {
+					return colorCountComparison(column, opGE, 2)
+				}
+			case opNE:
+				pred = func(column string) *sql.Predicate {
+					return colorCountComparison(column, opLT, 2)
+				}
+			default:
+				return nil, fmt.Errorf("operator %s is not supported for multicolor", op)
+			}
+		default:
+			colors, err := stax.ParseColorField(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse colors: %w", err)
+			}
 
-		for _, value := range colorString {
-			colors = append(colors, string(value))
+			pred = func(column string) *sql.Predicate {
+				return colorFieldComparisons[op](column, colors)
+			}
 		}
 
-		return handler(colors)
+		return &basicLeaf{predicator: func(s *sql.Selector) {
+			s.Where(pred(column(s)))
+		}}, nil
 	}
 }
 
-func colorsEQ() FieldFilterHandler {
-	return FieldFilterHandlerWithColors(func(colors []string) (leaf, error) {
-		colorQuery := &colorQuery{
-			MustInclude: colors,
-			MustExclude: colorsNotInQuery(colors),
+// colorFieldColonHandler returns the handler for ":" on a ColorField column, which
+// compares colors with op, but matches numbers of colors, multicolor and colorless
+// exactly, so that "id:esper" can mean "id<=esper" while "id:c" is still colorless.
+func colorFieldColonHandler(column func(*sql.Selector) string, op operator) FieldFilterHandler {
+	exact := colorFieldHandler(column, opEQ)
+	compare := colorFieldHandler(column, op)
+
+	return func(value string) (leaf, error) {
+		if colors, err := stax.ParseColorField(value); err == nil && colors != 0 {
+			return compare(value)
 		}
-		return &basicLeaf{predicator: colorQuery.toPredicator()}, nil
-	})
+
+		return exact(value)
+	}
+}
+
+// newColorFieldFilter creates a FieldFilter supporting every comparison
+// operator for a ColorField column of cards, with ":" comparing colors with
+// colonOp.  See colorFieldHandler for the values it accepts.
+func newColorFieldFilter(name string, aliases []string, column func(*sql.Selector) string, colonOp operator) FieldFilter {
+	handlers := map[operator]FieldFilterHandler{
+		opColon: colorFieldColonHandler(column, colonOp),
+	}
+
+	for op := range colorFieldComparisons {
+		handlers[op] = colorFieldHandler(column, op)
+	}
+
+	return FieldFilter{
+		Name:     name,
+		Aliases:  aliases,
+		Handlers: handlers,
+	}
+}
+
+// cardColors returns an expression for the colors of every face of a card
+// combined, the same as the colors Scryfall lists for the whole card.
+func cardColors(s *sql.Selector) string {
+	bits := make([]string, 0, len(stax.AllColors))
+
+	for i := range stax.AllColors {
+		bits = append(bits, fmt.Sprintf("MAX(f.%s & %d)", cardface.FieldColorField, 1<<i))
+	}
+
+	return fmt.Sprintf("(SELECT COALESCE(%s, 0) FROM %s AS f WHERE f.%s = %s)",
+		strings.Join(bits, " | "), cardface.Table, cardface.CardColumn, s.C(card.FieldID))
+}
+
+// newColorsFieldFilter creates the FieldFilter for searching by the colors of
+// a card, e.g. "c:ur" or "c>=ur" for cards that are at least blue and red.
+// The colors of double-faced cards are those of both faces combined.
+func newColorsFieldFilter() FieldFilter {
+	return newColorFieldFilter("colors", []string{"c", "color"}, cardColors, opGE)
+}
+
+// newColorIdentityFieldFilter creates the FieldFilter for searching by a card's
+// color identity, e.g. "id:esper" or "id<=esper" for cards playable in an Esper
+// commander deck.
+func newColorIdentityFieldFilter() FieldFilter {
+	return newColorFieldFilter("identity", []string{"id", "ci"}, func(s *sql.Selector) string {
+		return s.C(card.FieldColorIdentity)
+	}, opLE)
+}
+
+// formatAliases maps common nicknames for formats to the names Scryfall uses.
+var formatAliases = map[string]string{
+	"edh":     "commander",
+	"pdh":     "paupercommander",
+	"1v1":     "duel",
+	"pennies": "penny",
 }
 
-func colorsLT() FieldFilterHandler {
-	return FieldFilterHandlerWithColors(func(colors []string) (leaf, error) {
-		colorQuery := &colorQuery{
-			MustExclude: colorsNotInQuery(colors),
+// normalizeFormat converts a format name from a query into the name it is
+// stored under, returning an error if the format doesn't exist.
+func normalizeFormat(value string) (string, error) {
+	format := strings.ToLower(strings.ReplaceAll(value, " ", ""))
+
+	if alias, ok := formatAliases[format]; ok {
+		format = alias
+	}
+
+	for _, known := range scryfall.AllFormats() {
+		if known == format {
+			return format, nil
 		}
-		return &basicLeaf{predicator: colorQuery.toPredicator()}, nil
-	})
+	}
+
+	return "", fmt.Errorf("unknown format: %q", value)
 }
 
-func colorsGT() FieldFilterHandler {
-	return FieldFilterHandlerWithColors(func(colors []string) (leaf, error) {
-		colorQuery := &colorQuery{
-			MustInclude: colors,
+// legalityHandler returns a FieldFilterHandler matching cards that have any of
+// the provided legalities in the format named by the value, e.g. "banned:modern".
+func legalityHandler(legalities ...legality.Legality) FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		format, err := normalizeFormat(value)
+		if err != nil {
+			return nil, err
 		}
-		return &basicLeaf{predicator: colorQuery.toPredicator()}, nil
-	})
+
+		return &basicLeaf{predicator: card.HasLegalitiesWith(
+			legality.FormatEQ(format),
+			legality.LegalityIn(legalities...),
+		)}, nil
+	}
+}
+
+// printingsWith wraps printing predicates in a card predicate that matches
+// if any printing of any of the card's faces satisfies all of them.
+func printingsWith(preds ...predicate.Printing) predicate.Card {
+	return card.HasFacesWith(cardface.HasPrintingsWith(preds...))
+}
+
+// newPrintingLeaf creates a leaf for a filter on printings, which matches cards
+// with a printing satisfying all of preds, or only those printings when
+// searching printings.
+func newPrintingLeaf(preds ...predicate.Printing) *basicLeaf {
+	return &basicLeaf{predicator: printingsWith(preds...), printingPredicator: printing.And(preds...)}
+}
+
+// raritiesInOrder lists the rarities from least to most rare,
+// the same order Scryfall uses when comparing rarities.
+var raritiesInOrder = []printing.Rarity{
+	printing.RarityCommon,
+	printing.RarityUncommon,
+	printing.RarityRare,
+	printing.RaritySpecial,
+	printing.RarityMythic,
+	printing.RarityBonus,
+}
+
+// parseRarity parses a rarity name or its first letter, such as "mythic" or "m",
+// and returns its position in raritiesInOrder.
+func parseRarity(value string) (int, error) {
+	lowered := strings.ToLower(value)
+
+	for i, rarity := range raritiesInOrder {
+		if lowered == string(rarity) || lowered == string(rarity)[:1] {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown rarity: %q", value)
+}
+
+// rarityHandler returns a FieldFilterHandler matching cards that have been
+// printed at a rarity satisfying the comparison, e.g. "r>=rare".
+func rarityHandler(compare func(rarityIndex, valueIndex int) bool) FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		valueIndex, err := parseRarity(value)
+		if err != nil {
+			return nil, err
+		}
+
+		matching := []printing.Rarity{}
+
+		for i, rarity := range raritiesInOrder {
+			if compare(i, valueIndex) {
+				matching = append(matching, rarity)
+			}
+		}
+
+		return newPrintingLeaf(printing.RarityIn(matching...)), nil
+	}
+}
+
+// setHandler matches cards that have been printed in the set with the given code.
+func setHandler() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		return newPrintingLeaf(printing.HasSetWith(set.CodeEqualFold(value))), nil
+	}
+}
+
+// artistHandler matches cards with a printing illustrated by an artist whose
+// name contains the value, so "a:guay" matches Rebecca Guay.
+func artistHandler() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		return newPrintingLeaf(printing.HasArtistWith(artist.NameContainsFold(value))), nil
+	}
+}
+
+// inHandler matches cards that have ever been printed in a set, like "in:lea",
+// or at a rarity, like "in:rare".  Set codes are at least three characters
+// long, so they can't be confused with rarities.  Unlike "s:" and "r:", it
+// matches every printing of those cards when searching printings.
+func inHandler() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		if rarityIndex, err := parseRarity(value); err == nil {
+			return &basicLeaf{predicator: printingsWith(printing.RarityEQ(raritiesInOrder[rarityIndex]))}, nil
+		}
+
+		return &basicLeaf{predicator: printingsWith(printing.HasSetWith(set.CodeEqualFold(value)))}, nil
+	}
 }
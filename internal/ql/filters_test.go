@@ -0,0 +1,620 @@
+package ql
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/legality"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/testutils"
+	"github.com/SethCurry/stax/pkg/stax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testFace holds the card face fields the filter tests care about.
+type testFace struct {
+	Name      string
+	TypeLine  string
+	Power     string
+	Toughness string
+	Loyalty   string
+	Colors    string
+}
+
+// testStat parses a stat the same way the ETL does.
+func testStat(stat string) *float32 {
+	value, ok := stax.ParseStat(stat)
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
+
+// newFilterTestDB creates an empty database that is torn down when the test ends.
+func newFilterTestDB(t *testing.T) *bones.Client {
+	db := testutils.NewDB(t)
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
+
+// createTestCard creates a card with the given faces in the database.
+func createTestCard(t *testing.T, db *bones.Client, name string, faces ...testFace) *bones.Card {
+	ctx := context.Background()
+
+	created, err := db.Card.Create().
+		SetName(name).
+		SetOracleID(name).
+		SetColorIdentity(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	for _, face := range faces {
+		colors, err := stax.ParseColorField(face.Colors)
+		require.NoError(t, err)
+
+		_, err = db.CardFace.Create().
+			SetName(face.Name).
+			SetFlavorText("").
+			SetOracleText("").
+			SetLanguage("en").
+			SetCmc(0).
+			SetPower(face.Power).
+			SetToughness(face.Toughness).
+			SetLoyalty(face.Loyalty).
+			SetNillablePowerValue(testStat(face.Power)).
+			SetNillableToughnessValue(testStat(face.Toughness)).
+			SetNillableLoyaltyValue(testStat(face.Loyalty)).
+			SetManaCost("").
+			SetTypeLine(face.TypeLine).
+			SetColors(strings.ToUpper(face.Colors)).
+			SetColorField(uint8(colors)).
+			SetCard(created).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	return created
+}
+
+// queryCardNames runs a query with the DefaultParser and returns the sorted
+// names of all matching cards.
+func queryCardNames(t *testing.T, db *bones.Client, query string) []string {
+	parsed, err := ParseQuery(query)
+	require.NoError(t, err)
+
+	found, err := db.Card.Query().Where(parsed.Predicate()).All(context.Background())
+	require.NoError(t, err)
+
+	names := []string{}
+
+	for _, v := range found {
+		names = append(names, v.Name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func TestTypeFilter(t *testing.T) {
+	db := newFilterTestDB(t)
+
+	createTestCard(t, db, "Llanowar Elves", testFace{Name: "Llanowar Elves", TypeLine: "Creature — Elf Druid"})
+	createTestCard(t, db, "Elvish Mystic", testFace{Name: "Elvish Mystic", TypeLine: "Creature — Elf Druid"})
+	createTestCard(t, db, "Self-Destruct", testFace{Name: "Self-Destruct", TypeLine: "Instant"})
+	createTestCard(t, db, "Tyvar Kell", testFace{Name: "Tyvar Kell", TypeLine: "Legendary Planeswalker — Tyvar"})
+	createTestCard(t, db, "Ragavan, Nimble Pilferer", testFace{Name: "Ragavan, Nimble Pilferer", TypeLine: "Legendary Creature — Monkey Pirate"})
+	createTestCard(t, db, "Delver of Secrets // Insectile Aberration",
+		testFace{Name: "Delver of Secrets", TypeLine: "Creature — Human Wizard"},
+		testFace{Name: "Insectile Aberration", TypeLine: "Creature — Human Insect"})
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "subtype matches whole words only",
+			query:    "t:elf",
+			expected: []string{"Elvish Mystic", "Llanowar Elves"},
+		},
+		{
+			name:     "case insensitive",
+			query:    "type:INSTANT",
+			expected: []string{"Self-Destruct"},
+		},
+		{
+			name:     "multiple values must all match",
+			query:    `t:"legendary creature"`,
+			expected: []string{"Ragavan, Nimble Pilferer"},
+		},
+		{
+			name:     "supertype",
+			query:    "t:legendary",
+			expected: []string{"Ragavan, Nimble Pilferer", "Tyvar Kell"},
+		},
+		{
+			name:     "back face",
+			query:    "t:insect",
+			expected: []string{"Delver of Secrets // Insectile Aberration"},
+		},
+		{
+			name:     "negated",
+			query:    "-t:creature",
+			expected: []string{"Self-Destruct", "Tyvar Kell"},
+		},
+		{
+			name:     "not equal",
+			query:    "t!=legendary t!=creature",
+			expected: []string{"Self-Destruct"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+}
+
+func TestStatFilters(t *testing.T) {
+	db := newFilterTestDB(t)
+
+	createTestCard(t, db, "Grizzly Bears", testFace{Name: "Grizzly Bears", Power: "2", Toughness: "2"})
+	createTestCard(t, db, "Tarmogoyf", testFace{Name: "Tarmogoyf", Power: "*", Toughness: "1+*"})
+	createTestCard(t, db, "Wall of Stone", testFace{Name: "Wall of Stone", Power: "0", Toughness: "8"})
+	createTestCard(t, db, "Little Girl", testFace{Name: "Little Girl", Power: "½", Toughness: "½"})
+	createTestCard(t, db, "Goblin Guide", testFace{Name: "Goblin Guide", Power: "2", Toughness: "2"})
+	createTestCard(t, db, "Craterhoof Behemoth", testFace{Name: "Craterhoof Behemoth", Power: "5", Toughness: "5"})
+	createTestCard(t, db, "Jace, the Mind Sculptor", testFace{Name: "Jace, the Mind Sculptor", Loyalty: "3"})
+	createTestCard(t, db, "Lightning Bolt", testFace{Name: "Lightning Bolt"})
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "equal",
+			query:    "pow=2",
+			expected: []string{"Goblin Guide", "Grizzly Bears"},
+		},
+		{
+			name:     "star counts as zero",
+			query:    "power<=0",
+			expected: []string{"Tarmogoyf", "Wall of Stone"},
+		},
+		{
+			name:     "star arithmetic",
+			query:    "tou=1",
+			expected: []string{"Tarmogoyf"},
+		},
+		{
+			name:     "half values",
+			query:    "pow>0 pow<1",
+			expected: []string{"Little Girl"},
+		},
+		{
+			name:     "not equal skips cards without the stat",
+			query:    "pow!=2 pow>=1",
+			expected: []string{"Craterhoof Behemoth"},
+		},
+		{
+			name:     "compare two fields",
+			query:    "tou>pow",
+			expected: []string{"Tarmogoyf", "Wall of Stone"},
+		},
+		{
+			name:     "loyalty",
+			query:    "loy>=3",
+			expected: []string{"Jace, the Mind Sculptor"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+
+	_, err := ParseQuery("pow>banana")
+	assert.Error(t, err)
+}
+
+func TestColorIdentityFilter(t *testing.T) {
+	db := newFilterTestDB(t)
+
+	identities := map[string]string{
+		"Sol Ring":                   "c",
+		"Swords to Plowshares":       "w",
+		"Counterspell":               "u",
+		"Dimir Signet":               "ub",
+		"Esper Charm":                "wub",
+		"Kenrith, the Returned King": "wubrg",
+		"Lightning Helix":            "rw",
+	}
+
+	for name, identity := range identities {
+		colors, err := stax.ParseColorField(identity)
+		require.NoError(t, err)
+
+		created := createTestCard(t, db, name)
+		_, err = created.Update().SetColorIdentity(uint8(colors)).Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "exact",
+			query:    "id=ub",
+			expected: []string{"Dimir Signet"},
+		},
+		{
+			name:     "colorless",
+			query:    "identity:c",
+			expected: []string{"Sol Ring"},
+		},
+		{
+			name:     "colon means subset",
+			query:    "id:esper",
+			expected: []string{"Counterspell", "Dimir Signet", "Esper Charm", "Sol Ring", "Swords to Plowshares"},
+		},
+		{
+			name:     "negated colon",
+			query:    "-id:esper",
+			expected: []string{"Kenrith, the Returned King", "Lightning Helix"},
+		},
+		{
+			name:     "colon with a number of colors",
+			query:    "id:2",
+			expected: []string{"Dimir Signet", "Lightning Helix"},
+		},
+		{
+			name:     "commander legal subset",
+			query:    "id<=WUB",
+			expected: []string{"Counterspell", "Dimir Signet", "Esper Charm", "Sol Ring", "Swords to Plowshares"},
+		},
+		{
+			name:     "strict subset by shard name",
+			query:    "ci<esper",
+			expected: []string{"Counterspell", "Dimir Signet", "Sol Ring", "Swords to Plowshares"},
+		},
+		{
+			name:     "superset",
+			query:    "id>=w",
+			expected: []string{"Esper Charm", "Kenrith, the Returned King", "Lightning Helix", "Swords to Plowshares"},
+		},
+		{
+			name:     "strict superset by guild name",
+			query:    "id>dimir",
+			expected: []string{"Esper Charm", "Kenrith, the Returned King"},
+		},
+		{
+			name:     "not equal",
+			query:    "id!=c id<=boros",
+			expected: []string{"Lightning Helix", "Swords to Plowshares"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+
+	_, err := ParseQuery("id=purple")
+	assert.Error(t, err)
+}
+
+func TestColorsFilter(t *testing.T) {
+	db := newFilterTestDB(t)
+
+	createTestCard(t, db, "Sol Ring", testFace{Name: "Sol Ring"})
+	createTestCard(t, db, "Lightning Bolt", testFace{Name: "Lightning Bolt", Colors: "r"})
+	createTestCard(t, db, "Counterspell", testFace{Name: "Counterspell", Colors: "u"})
+	createTestCard(t, db, "Izzet Charm", testFace{Name: "Izzet Charm", Colors: "ur"})
+	createTestCard(t, db, "Expansion // Explosion",
+		testFace{Name: "Expansion", Colors: "ur"},
+		testFace{Name: "Explosion", Colors: "ur"})
+	createTestCard(t, db, "Esper Charm", testFace{Name: "Esper Charm", Colors: "wub"})
+
+	// the colors of double-faced cards are those of both faces combined
+	createTestCard(t, db, "Huntmaster of the Fells // Ravager of the Fells",
+		testFace{Name: "Huntmaster of the Fells", Colors: "g"},
+		testFace{Name: "Ravager of the Fells", Colors: "rg"})
+	createTestCard(t, db, "Search for Azcanta // Azcanta, the Sunken Ruin",
+		testFace{Name: "Search for Azcanta", Colors: "u"},
+		testFace{Name: "Azcanta, the Sunken Ruin"})
+
+	azcanta := "Search for Azcanta // Azcanta, the Sunken Ruin"
+	huntmaster := "Huntmaster of the Fells // Ravager of the Fells"
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "exact",
+			query:    "c=ur",
+			expected: []string{"Expansion // Explosion", "Izzet Charm"},
+		},
+		{
+			name:     "superset",
+			query:    "c>=u",
+			expected: []string{"Counterspell", "Esper Charm", "Expansion // Explosion", "Izzet Charm", azcanta},
+		},
+		{
+			name:     "colon means superset",
+			query:    "c:ur",
+			expected: []string{"Expansion // Explosion", "Izzet Charm"},
+		},
+		{
+			name:     "double-faced exact",
+			query:    "c=rg",
+			expected: []string{huntmaster},
+		},
+		{
+			name:     "double-faced colon",
+			query:    "c:g",
+			expected: []string{huntmaster},
+		},
+		{
+			name:     "strict superset",
+			query:    "c>u",
+			expected: []string{"Esper Charm", "Expansion // Explosion", "Izzet Charm"},
+		},
+		{
+			name:     "subset",
+			query:    "c<=izzet",
+			expected: []string{"Counterspell", "Expansion // Explosion", "Izzet Charm", "Lightning Bolt", azcanta, "Sol Ring"},
+		},
+		{
+			name:     "colorless",
+			query:    "c:c",
+			expected: []string{"Sol Ring"},
+		},
+		{
+			name:     "multicolor",
+			query:    "c:m",
+			expected: []string{"Esper Charm", "Expansion // Explosion", huntmaster, "Izzet Charm"},
+		},
+		{
+			name:     "not multicolor",
+			query:    "c!=m",
+			expected: []string{"Counterspell", "Lightning Bolt", azcanta, "Sol Ring"},
+		},
+		{
+			name:     "color count",
+			query:    "c>=3",
+			expected: []string{"Esper Charm"},
+		},
+		{
+			name:     "exact color count",
+			query:    "color=1",
+			expected: []string{"Counterspell", "Lightning Bolt", azcanta},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+
+	_, err := ParseQuery("c>m")
+	assert.Error(t, err)
+}
+
+func TestLegalityFilters(t *testing.T) {
+	db := newFilterTestDB(t)
+	ctx := context.Background()
+
+	legalities := map[string]map[string]legality.Legality{
+		"Black Lotus": {
+			"vintage":   legality.LegalityRestricted,
+			"legacy":    legality.LegalityBanned,
+			"commander": legality.LegalityBanned,
+		},
+		"Sol Ring": {
+			"vintage":   legality.LegalityRestricted,
+			"legacy":    legality.LegalityBanned,
+			"commander": legality.LegalityLegal,
+		},
+		"Lightning Bolt": {
+			"vintage":   legality.LegalityLegal,
+			"legacy":    legality.LegalityLegal,
+			"commander": legality.LegalityLegal,
+			"modern":    legality.LegalityLegal,
+		},
+	}
+
+	for name, formats := range legalities {
+		created := createTestCard(t, db, name)
+
+		for format, formatLegality := range formats {
+			_, err := db.Legality.Create().
+				SetFormat(format).
+				SetLegality(formatLegality).
+				SetCard(created).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "legal includes restricted",
+			query:    "f:vintage",
+			expected: []string{"Black Lotus", "Lightning Bolt", "Sol Ring"},
+		},
+		{
+			name:     "legal alias and nickname",
+			query:    "legal:edh",
+			expected: []string{"Lightning Bolt", "Sol Ring"},
+		},
+		{
+			name:     "banned",
+			query:    "banned:legacy",
+			expected: []string{"Black Lotus", "Sol Ring"},
+		},
+		{
+			name:     "restricted",
+			query:    "restricted:Vintage",
+			expected: []string{"Black Lotus", "Sol Ring"},
+		},
+		{
+			name:     "not legal when missing",
+			query:    "format:modern",
+			expected: []string{"Lightning Bolt"},
+		},
+		{
+			name:     "negated",
+			query:    "-banned:commander f:legacy",
+			expected: []string{"Lightning Bolt"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+
+	_, err := ParseQuery("f:notaformat")
+	assert.Error(t, err)
+}
+
+func TestPrintingFilters(t *testing.T) {
+	db := newFilterTestDB(t)
+	ctx := context.Background()
+
+	alpha := db.Set.Create().SetName("Limited Edition Alpha").SetCode("lea").SaveX(ctx)
+	m10 := db.Set.Create().SetName("Magic 2010").SetCode("m10").SaveX(ctx)
+	rebecca := db.Artist.Create().SetName("Rebecca Guay").SaveX(ctx)
+	christopher := db.Artist.Create().SetName("Christopher Moeller").SaveX(ctx)
+
+	printings := []struct {
+		card   string
+		set    *bones.Set
+		rarity printing.Rarity
+		artist *bones.Artist
+	}{
+		{"Lightning Bolt", alpha, printing.RarityCommon, christopher},
+		{"Lightning Bolt", m10, printing.RarityCommon, christopher},
+		{"Dark Ritual", alpha, printing.RarityCommon, nil},
+		{"Birds of Paradise", alpha, printing.RarityRare, nil},
+		{"Birds of Paradise", m10, printing.RarityRare, christopher},
+		{"Elvish Archdruid", m10, printing.RarityRare, nil},
+		{"Baneslayer Angel", m10, printing.RarityMythic, nil},
+		{"Enchanted Evening", m10, printing.RarityUncommon, rebecca},
+		{"Giant Growth", alpha, printing.RarityCommon, nil},
+		{"Giant Growth", m10, printing.RarityRare, nil},
+	}
+
+	faces := map[string]*bones.CardFace{}
+
+	for _, p := range printings {
+		face, ok := faces[p.card]
+		if !ok {
+			created := createTestCard(t, db, p.card, testFace{Name: p.card})
+			face = created.QueryFaces().OnlyX(ctx)
+			faces[p.card] = face
+		}
+
+		create := db.Printing.Create().SetCardFace(face).SetSet(p.set).SetRarity(p.rarity)
+		if p.artist != nil {
+			create = create.SetArtist(p.artist)
+		}
+
+		create.SaveX(ctx)
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "set",
+			query:    "s:LEA",
+			expected: []string{"Birds of Paradise", "Dark Ritual", "Giant Growth", "Lightning Bolt"},
+		},
+		{
+			name:     "in set",
+			query:    "in:m10 -in:lea",
+			expected: []string{"Baneslayer Angel", "Elvish Archdruid", "Enchanted Evening"},
+		},
+		{
+			name:     "in rarity",
+			query:    "in:uncommon",
+			expected: []string{"Enchanted Evening"},
+		},
+		{
+			name:     "rarity",
+			query:    "r:c",
+			expected: []string{"Dark Ritual", "Giant Growth", "Lightning Bolt"},
+		},
+		{
+			name:     "set and rarity of the same printing",
+			query:    "s:m10 r:c",
+			expected: []string{"Lightning Bolt"},
+		},
+		{
+			name:     "set and rarity of the same rare printing",
+			query:    "s:m10 r:rare",
+			expected: []string{"Birds of Paradise", "Elvish Archdruid", "Giant Growth"},
+		},
+		{
+			name:     "set and any of several rarities",
+			query:    "s:lea (r:u OR r:c) -t:sorcery",
+			expected: []string{"Dark Ritual", "Giant Growth", "Lightning Bolt"},
+		},
+		{
+			name:     "rarity at least rare",
+			query:    "rarity>=rare",
+			expected: []string{"Baneslayer Angel", "Birds of Paradise", "Elvish Archdruid", "Giant Growth"},
+		},
+		{
+			name:     "rarity below rare",
+			query:    "r<r set:m10",
+			expected: []string{"Enchanted Evening", "Lightning Bolt"},
+		},
+		{
+			name:     "artist",
+			query:    `a:"christopher moeller"`,
+			expected: []string{"Birds of Paradise", "Lightning Bolt"},
+		},
+		{
+			name:     "partial artist",
+			query:    "artist:guay",
+			expected: []string{"Enchanted Evening"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryCardNames(t, db, tc.query))
+		})
+	}
+
+	_, err := ParseQuery("r>=shiny")
+	assert.Error(t, err)
+}
@@ -1,22 +1,19 @@
 package ql
 
-import (
-	"errors"
-	"strings"
-
-	"github.com/SethCurry/scurry-go/fp"
-)
+import "strings"
 
 type keyword string
 
 const (
 	keywordOr  = "OR"
 	keywordAnd = "AND"
+	keywordNot = "NOT"
 )
 
 var keywords = []keyword{
 	keywordOr,
 	keywordAnd,
+	keywordNot,
 }
 
 // TokenFamily represents the general category of a token,
@@ -30,7 +27,8 @@ const (
 	// FamilyLiteral represents a literal token such as a string or number.
 	FamilyLiteral TokenFamily = "literal"
 
-	// FamilyKeyword represents a keyword token such as OR or AND.
+	// FamilyKeyword represents a keyword token such as OR, AND or NOT.
+	// A leading "-" on a term is lexed as a NOT keyword.
 	FamilyKeyword TokenFamily = "keyword"
 
 	// FamilyParen represents a parenthesis token such as ( or ).
@@ -44,6 +42,13 @@ type Token struct {
 
 	// Value represents the actual value of the token from the query.
 	Value string
+
+	// Offset is the byte offset of the start of the token in the query.
+	Offset int
+
+	// Length is the number of bytes the token spans in the query,
+	// including the quotes around quoted literals.
+	Length int
 }
 
 // isKeyword checks if a given string is a keyword, case-insensitively.
@@ -58,24 +63,54 @@ func isKeyword(item string) bool {
 	return false
 }
 
-// tokenLiteralsToKeywords accepts a slice of tokens and returns a new slice
-// with all literal tokens whose value is a keyword converted to a keyword token.
-func tokenLiteralsToKeywords(tokens []Token) []Token {
-	return fp.Map(func(t Token) Token {
-		if isKeyword(t.Value) {
-			t.Value = strings.ToUpper(t.Value)
-			t.Family = FamilyKeyword
+// wordToken returns the token for an unquoted word, which is a keyword
+// token if the word is a keyword.  Quoted words are always literals,
+// so that keywords can be searched for, like o:"or".
+func wordToken(word string) Token {
+	if isKeyword(word) {
+		return Token{
+			Family: FamilyKeyword,
+			Value:  strings.ToUpper(word),
 		}
+	}
+
+	return Token{
+		Family: FamilyLiteral,
+		Value:  word,
+	}
+}
+
+// followsOperator returns true if the last token lexed so far is an operator,
+// meaning the next token is the value of a filter.
+func followsOperator(tokens []Token) bool {
+	return len(tokens) > 0 && tokens[len(tokens)-1].Family == FamilyOperator
+}
+
+// setTokenPositions sets the Offset and Length of tokens lexed from the
+// bytes between start and end of the query.  Only a negated term
+// like "-o" produces two tokens from one item, with the NOT keyword
+// spanning just the "-".
+func setTokenPositions(tokens []Token, start int, end int) {
+	if len(tokens) == 2 {
+		tokens[0].Offset = start
+		tokens[0].Length = 1
+		start++
+		tokens = tokens[1:]
+	}
 
-		return t
-	}, tokens)
+	for i := range tokens {
+		tokens[i].Offset = start
+		tokens[i].Length = end - start
+	}
 }
 
 func lex(t *lexReader) ([]Token, error) {
-	// TODO attach line/column info to tokens so it can be propagated in errors
 	var ret []Token
 
 	for {
+		start := t.offset()
+		lexedBefore := len(ret)
+
 		nextItem, done := t.readUntilSeparator()
 		if nextItem == "" {
 			nextChar, charDone := t.next()
@@ -111,9 +146,26 @@ func lex(t *lexReader) ([]Token, error) {
 					// consume the next character, since it's part of the operator
 					_, done = t.next()
 				} else {
+					// ":" is kept apart from "=", since it means something
+					// else for some fields, like "id:esper"
 					ret = append(ret, Token{
 						Family: FamilyOperator,
-						Value:  "=",
+						Value:  nextItem,
+					})
+				}
+			case "!":
+				// ! is only meaningful as part of !=, otherwise it's
+				// treated like any other literal
+				if next, ok := t.peek(); ok && next == '=' {
+					ret = append(ret, Token{
+						Family: FamilyOperator,
+						Value:  "!=",
+					})
+					_, done = t.next()
+				} else {
+					ret = append(ret, Token{
+						Family: FamilyLiteral,
+						Value:  nextItem,
 					})
 				}
 			case "(", ")":
@@ -124,7 +176,7 @@ func lex(t *lexReader) ([]Token, error) {
 			case "\"":
 				quoted, ok := t.readUntilOneOf([]rune{'"'})
 				if !ok {
-					return ret, errors.New("mismatched quotes")
+					return ret, newQueryError(ErrMismatchedQuotes, start, t.offset()-start)
 				}
 				_, done = t.next()
 
@@ -133,15 +185,26 @@ func lex(t *lexReader) ([]Token, error) {
 					Value:  quoted,
 				})
 			default:
-				ret = append(ret, Token{
-					Family: FamilyLiteral,
-					Value:  nextItem,
-				})
+				// A leading - negates the term, e.g. -o:flying or -(c=R OR c=G).
+				// Values like the -1 in "cmc>-1" are left alone since they follow an operator.
+				if strings.HasPrefix(nextItem, "-") && !followsOperator(ret) {
+					ret = append(ret, Token{
+						Family: FamilyKeyword,
+						Value:  keywordNot,
+					})
+					nextItem = strings.TrimPrefix(nextItem, "-")
+				}
+
+				if nextItem != "" {
+					ret = append(ret, wordToken(nextItem))
+				}
 			}
 		}
 
+		setTokenPositions(ret[lexedBefore:], start, t.offset())
+
 		if !done {
-			return tokenLiteralsToKeywords(ret), nil
+			return ret, nil
 		}
 	}
 }
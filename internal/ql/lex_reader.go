@@ -19,6 +19,11 @@ func (l *lexReader) next() (rune, bool) {
 	return char, true
 }
 
+// offset returns the byte offset of the next character in the query string.
+func (l *lexReader) offset() int {
+	return len(string(l.query[:l.index]))
+}
+
 // peek returns the next character from the query string without advancing the index.
 func (l *lexReader) peek() (rune, bool) {
 	if l.index >= len(l.query) {
@@ -63,6 +68,7 @@ func (l *lexReader) readUntilSeparator() (string, bool) {
 		'(',
 		')',
 		':',
+		'!',
 	}
 
 	return l.readUntilOneOf(separators)
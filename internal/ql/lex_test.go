@@ -20,14 +20,20 @@ func TestLex(t *testing.T) {
 				{
 					Family: FamilyLiteral,
 					Value:  "colors",
+					Offset: 0,
+					Length: 6,
 				},
 				{
 					Family: FamilyOperator,
 					Value:  "<",
+					Offset: 6,
+					Length: 1,
 				},
 				{
 					Family: FamilyLiteral,
 					Value:  "RWB",
+					Offset: 7,
+					Length: 3,
 				},
 			},
 		},
@@ -38,14 +44,20 @@ func TestLex(t *testing.T) {
 				{
 					Family: FamilyLiteral,
 					Value:  "name",
+					Offset: 0,
+					Length: 4,
 				},
 				{
 					Family: FamilyOperator,
 					Value:  "=",
+					Offset: 4,
+					Length: 1,
 				},
 				{
 					Family: FamilyLiteral,
 					Value:  "Some Long Name",
+					Offset: 5,
+					Length: 16,
 				},
 			},
 		},
@@ -56,30 +68,206 @@ func TestLex(t *testing.T) {
 				{
 					Family: FamilyLiteral,
 					Value:  "name",
+					Offset: 0,
+					Length: 4,
 				},
 				{
 					Family: FamilyOperator,
 					Value:  "=",
+					Offset: 4,
+					Length: 1,
 				},
 				{
 					Family: FamilyLiteral,
 					Value:  "something",
+					Offset: 5,
+					Length: 9,
 				},
 				{
 					Family: FamilyKeyword,
 					Value:  "OR",
+					Offset: 15,
+					Length: 2,
 				},
 				{
 					Family: FamilyLiteral,
 					Value:  "name",
+					Offset: 18,
+					Length: 4,
 				},
 				{
 					Family: FamilyOperator,
 					Value:  "=",
+					Offset: 22,
+					Length: 1,
 				},
 				{
 					Family: FamilyLiteral,
 					Value:  "other",
+					Offset: 23,
+					Length: 5,
+				},
+			},
+		},
+		{
+			name:  "negated term",
+			query: "-o:flying",
+			expected: []Token{
+				{
+					Family: FamilyKeyword,
+					Value:  "NOT",
+					Offset: 0,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "o",
+					Offset: 1,
+					Length: 1,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  ":",
+					Offset: 2,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "flying",
+					Offset: 3,
+					Length: 6,
+				},
+			},
+		},
+		{
+			name:  "negated group",
+			query: "-(c=R)",
+			expected: []Token{
+				{
+					Family: FamilyKeyword,
+					Value:  "NOT",
+					Offset: 0,
+					Length: 1,
+				},
+				{
+					Family: FamilyParen,
+					Value:  "(",
+					Offset: 1,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "c",
+					Offset: 2,
+					Length: 1,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  "=",
+					Offset: 3,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "R",
+					Offset: 4,
+					Length: 1,
+				},
+				{
+					Family: FamilyParen,
+					Value:  ")",
+					Offset: 5,
+					Length: 1,
+				},
+			},
+		},
+		{
+			name:  "negative value",
+			query: "cmc>-1",
+			expected: []Token{
+				{
+					Family: FamilyLiteral,
+					Value:  "cmc",
+					Offset: 0,
+					Length: 3,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  ">",
+					Offset: 3,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "-1",
+					Offset: 4,
+					Length: 2,
+				},
+			},
+		},
+		{
+			name:  "not equal",
+			query: "name!=Opt",
+			expected: []Token{
+				{
+					Family: FamilyLiteral,
+					Value:  "name",
+					Offset: 0,
+					Length: 4,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  "!=",
+					Offset: 4,
+					Length: 2,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "Opt",
+					Offset: 6,
+					Length: 3,
+				},
+			},
+		},
+		{
+			name:  "quoted keywords",
+			query: `o:"or" name:"And"`,
+			expected: []Token{
+				{
+					Family: FamilyLiteral,
+					Value:  "o",
+					Offset: 0,
+					Length: 1,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  ":",
+					Offset: 1,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "or",
+					Offset: 2,
+					Length: 4,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "name",
+					Offset: 7,
+					Length: 4,
+				},
+				{
+					Family: FamilyOperator,
+					Value:  ":",
+					Offset: 11,
+					Length: 1,
+				},
+				{
+					Family: FamilyLiteral,
+					Value:  "And",
+					Offset: 12,
+					Length: 5,
 				},
 			},
 		},
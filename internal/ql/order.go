@@ -0,0 +1,454 @@
+package ql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/SethCurry/stax/internal/bones/artist"
+	"github.com/SethCurry/stax/internal/bones/card"
+	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/SethCurry/stax/internal/bones/printing"
+	"github.com/SethCurry/stax/internal/bones/set"
+	"github.com/SethCurry/stax/pkg/stax"
+)
+
+// SortOrder is the field that search results are sorted by.
+// The values match the "order" parameter of Scryfall's search API.
+type SortOrder string
+
+const (
+	OrderName      SortOrder = "name"
+	OrderCMC       SortOrder = "cmc"
+	OrderSet       SortOrder = "set"
+	OrderReleased  SortOrder = "released"
+	OrderRarity    SortOrder = "rarity"
+	OrderColor     SortOrder = "color"
+	OrderPower     SortOrder = "power"
+	OrderToughness SortOrder = "toughness"
+	OrderArtist    SortOrder = "artist"
+)
+
+// SortDirection is the direction search results are sorted in.
+type SortDirection string
+
+const (
+	// DirectionAuto sorts in whichever direction is most natural for
+	// the sort order; newest first for released, ascending otherwise.
+	DirectionAuto SortDirection = "auto"
+	DirectionAsc  SortDirection = "asc"
+	DirectionDesc SortDirection = "desc"
+)
+
+// UniqueMode controls how duplicate printings of a card are collapsed
+// in search results.
+type UniqueMode string
+
+const (
+	// UniqueCards returns each card once, no matter how often it was printed.
+	UniqueCards UniqueMode = "cards"
+
+	// UniqueArt returns each unique illustration of a card once.
+	UniqueArt UniqueMode = "art"
+
+	// UniquePrints returns every printing of every card.
+	UniquePrints UniqueMode = "prints"
+)
+
+// SearchOptions control how the results of a query are sorted and
+// deduplicated, rather than which cards match it.
+type SearchOptions struct {
+	Order     SortOrder
+	Direction SortDirection
+	Unique    UniqueMode
+}
+
+// DefaultSearchOptions are the options used when a search doesn't specify any.
+var DefaultSearchOptions = SearchOptions{
+	Order:     OrderName,
+	Direction: DirectionAuto,
+	Unique:    UniqueCards,
+}
+
+// ParseSortOrder parses the name of a sort order, such as "cmc".
+func ParseSortOrder(value string) (SortOrder, error) {
+	order := SortOrder(strings.ToLower(value))
+
+	if _, ok := sortKeys[order]; !ok {
+		return "", fmt.Errorf("unknown sort order %q, expected one of %s", value, strings.Join(sortOrderNames(), ", "))
+	}
+
+	return order, nil
+}
+
+// ParseSortDirection parses a sort direction: "auto", "asc" or "desc".
+func ParseSortDirection(value string) (SortDirection, error) {
+	switch direction := SortDirection(strings.ToLower(value)); direction {
+	case DirectionAuto, DirectionAsc, DirectionDesc:
+		return direction, nil
+	default:
+		return "", fmt.Errorf("unknown sort direction %q, expected auto, asc or desc", value)
+	}
+}
+
+// ParseUniqueMode parses a unique mode: "cards", "art" or "prints".
+func ParseUniqueMode(value string) (UniqueMode, error) {
+	switch unique := UniqueMode(strings.ToLower(value)); unique {
+	case UniqueCards, UniqueArt, UniquePrints:
+		return unique, nil
+	default:
+		return "", fmt.Errorf("unknown unique mode %q, expected cards, art or prints", value)
+	}
+}
+
+// descending returns true if the results should be sorted in descending order.
+func (o SearchOptions) descending() bool {
+	if o.Direction == DirectionAuto || o.Direction == "" {
+		return o.Order == OrderReleased
+	}
+
+	return o.Direction == DirectionDesc
+}
+
+// OrderCards returns an order option for a card query that sorts it by the options.
+// Ties are broken by name and then ID, so the order is always deterministic.
+func (o SearchOptions) OrderCards() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		key := sortKeys[o.sortOrder()]
+
+		orderByExpr(s, key.card(s.C(card.FieldID)), o.descending())
+		s.OrderBy(sql.Asc(s.C(card.FieldName)), sql.Asc(s.C(card.FieldID)))
+	}
+}
+
+// OrderPrintings returns an order option for a printing query that sorts it by the options.
+// Ties are broken by the card's name, then newest printing first, then ID.
+func (o SearchOptions) OrderPrintings() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		key := sortKeys[o.sortOrder()]
+		cardID := printingCardID(s)
+
+		if key.printing != nil {
+			orderByExpr(s, key.printing(s), o.descending())
+		} else {
+			orderByExpr(s, key.card(cardID), o.descending())
+		}
+
+		orderByExpr(s, cardName(cardID), false)
+		orderByExpr(s, s.C(printing.FieldReleasedAt), true)
+		s.OrderBy(sql.Asc(s.C(printing.FieldID)))
+	}
+}
+
+// FilterPrintings returns a predicate for searching printings that keeps the
+// printings matching pred, without any duplicates for the unique mode.
+//
+// Multi-faced cards have a printing for each face which share a Scryfall ID,
+// so only the first matching face of each is kept.  For UniqueArt, only the
+// first matching printing of each illustration to be released is kept, and
+// printings without an illustration ID are always kept, since there's no way
+// to tell.
+func (o SearchOptions) FilterPrintings(pred predicate.Printing) predicate.Printing {
+	filters := []predicate.Printing{
+		pred,
+		printing.Or(
+			printing.ScryfallIDEQ(""),
+			noEarlierMatch(pred, printing.FieldScryfallID, idBefore),
+		),
+	}
+
+	if o.Unique == UniqueArt {
+		filters = append(filters, printing.Or(
+			printing.IllustrationIDEQ(""),
+			noEarlierMatch(pred, printing.FieldIllustrationID, releasedBefore),
+		))
+	}
+
+	return printing.And(filters...)
+}
+
+// noEarlierMatch returns a predicate matching printings where no other printing
+// matching pred has the same value in column and comes before it.  before
+// builds the condition for the earlier printing coming before the printing.
+func noEarlierMatch(
+	pred predicate.Printing,
+	column string,
+	before func(earlier *sql.Selector, s *sql.Selector) *sql.Predicate,
+) predicate.Printing {
+	return func(s *sql.Selector) {
+		table := sql.Table(printing.Table).As("earlier")
+		earlier := sql.Dialect(s.Dialect()).Select(table.C(printing.FieldID)).From(table)
+
+		pred(earlier)
+		earlier.Where(sql.And(
+			sql.ColumnsEQ(earlier.C(column), s.C(column)),
+			before(earlier, s),
+		))
+
+		s.Where(sql.NotExists(earlier))
+	}
+}
+
+// idBefore orders printings by their ID, so the first face of a card comes first.
+func idBefore(earlier *sql.Selector, s *sql.Selector) *sql.Predicate {
+	return sql.ColumnsLT(earlier.C(printing.FieldID), s.C(printing.FieldID))
+}
+
+// releasedBefore orders printings by the date they were released, falling back to
+// the release date of their set, with printings without either coming last.
+// Printings released on the same day are ordered by their ID.
+func releasedBefore(earlier *sql.Selector, s *sql.Selector) *sql.Predicate {
+	released := func(sel *sql.Selector) string {
+		return fmt.Sprintf("COALESCE(%s, '9999')", sortKeys[OrderReleased].printing(sel))
+	}
+
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(fmt.Sprintf("(%s < %s OR (%s = %s AND ",
+			released(earlier), released(s), released(earlier), released(s))).
+			Join(sql.ColumnsLT(earlier.C(printing.FieldID), s.C(printing.FieldID))).
+			WriteString("))")
+	})
+}
+
+// sortOrder returns the sort order, falling back to the default if unset.
+func (o SearchOptions) sortOrder() SortOrder {
+	if o.Order == "" {
+		return DefaultSearchOptions.Order
+	}
+
+	return o.Order
+}
+
+// orderByExpr sorts the selector by the SQL expression, always putting
+// NULLs last so that cards without a value (like the power of an instant)
+// don't jump to the front.
+func orderByExpr(s *sql.Selector, expr string, descending bool) {
+	direction := "ASC"
+	if descending {
+		direction = "DESC"
+	}
+
+	s.OrderExpr(sql.Expr(fmt.Sprintf("%s IS NULL ASC, %s %s", expr, expr, direction)))
+}
+
+// sortKey builds the SQL expression a SortOrder sorts by.
+type sortKey struct {
+	// card returns the expression for the card with the given ID expression.
+	card func(cardID string) string
+
+	// printing returns the expression for a printing query.  If it's nil,
+	// printings are sorted by the card expression of their card.
+	printing func(s *sql.Selector) string
+}
+
+// sortKeys maps each SortOrder to how it sorts cards and printings.
+// Cards that have multiple faces or printings are sorted by the
+// lowest value among them, except for power and toughness which
+// use the highest, and color which uses the front face.  Printings
+// without a release date are sorted by the release date of their set.
+var sortKeys = map[SortOrder]sortKey{
+	OrderName: {
+		card: cardName,
+	},
+	OrderCMC: {
+		card: func(cardID string) string {
+			return faceAggregate("MIN", cardface.FieldCmc, cardID)
+		},
+	},
+	OrderPower: {
+		card: func(cardID string) string {
+			return faceAggregate("MAX", cardface.FieldPowerValue, cardID)
+		},
+	},
+	OrderToughness: {
+		card: func(cardID string) string {
+			return faceAggregate("MAX", cardface.FieldToughnessValue, cardID)
+		},
+	},
+	OrderColor: {
+		card: func(cardID string) string {
+			return fmt.Sprintf("(SELECT %s FROM %s AS f WHERE f.%s = %s ORDER BY f.%s LIMIT 1)",
+				colorSortExpr("f."+cardface.FieldColorField),
+				cardface.Table, cardface.CardColumn, cardID, cardface.FieldID)
+		},
+	},
+	OrderSet: {
+		card: func(cardID string) string {
+			return printingAggregate("MIN(s."+set.FieldCode+")", cardID)
+		},
+		printing: func(s *sql.Selector) string {
+			return fmt.Sprintf("(SELECT s.%s FROM %s AS s WHERE s.%s = %s)",
+				set.FieldCode, set.Table, set.FieldID, s.C(printing.SetColumn))
+		},
+	},
+	OrderReleased: {
+		card: func(cardID string) string {
+			return printingAggregate(fmt.Sprintf("MIN(COALESCE(p.%s, s.%s))", printing.FieldReleasedAt, set.FieldReleasedAt), cardID)
+		},
+		printing: func(s *sql.Selector) string {
+			return fmt.Sprintf("COALESCE(%s, (SELECT s.%s FROM %s AS s WHERE s.%s = %s))",
+				s.C(printing.FieldReleasedAt), set.FieldReleasedAt, set.Table, set.FieldID, s.C(printing.SetColumn))
+		},
+	},
+	OrderRarity: {
+		card: func(cardID string) string {
+			return printingAggregate("MIN("+raritySortExpr("p."+printing.FieldRarity)+")", cardID)
+		},
+		printing: func(s *sql.Selector) string {
+			return raritySortExpr(s.C(printing.FieldRarity))
+		},
+	},
+	OrderArtist: {
+		card: func(cardID string) string {
+			return printingAggregate("MIN(a."+artist.FieldName+")", cardID)
+		},
+		printing: func(s *sql.Selector) string {
+			return fmt.Sprintf("(SELECT a.%s FROM %s AS a WHERE a.%s = %s)",
+				artist.FieldName, artist.Table, artist.FieldID, s.C(printing.ArtistColumn))
+		},
+	},
+}
+
+// sortOrderNames returns the names of every sort order, sorted.
+func sortOrderNames() []string {
+	names := make([]string, 0, len(sortKeys))
+
+	for order := range sortKeys {
+		names = append(names, string(order))
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// cardName returns an expression for the name of the card with the given ID.
+func cardName(cardID string) string {
+	return fmt.Sprintf("(SELECT c.%s FROM %s AS c WHERE c.%s = %s)", card.FieldName, card.Table, card.FieldID, cardID)
+}
+
+// printingCardID returns an expression for the ID of the card a printing is of.
+func printingCardID(s *sql.Selector) string {
+	return fmt.Sprintf("(SELECT f.%s FROM %s AS f WHERE f.%s = %s)",
+		cardface.CardColumn, cardface.Table, cardface.FieldID, s.C(printing.CardFaceColumn))
+}
+
+// faceAggregate returns an expression aggregating a column across the card's faces.
+func faceAggregate(aggregate string, column string, cardID string) string {
+	return fmt.Sprintf("(SELECT %s(f.%s) FROM %s AS f WHERE f.%s = %s)",
+		aggregate, column, cardface.Table, cardface.CardColumn, cardID)
+}
+
+// printingAggregate returns an expression aggregating across every printing
+// of the card's faces.  The printing, its set and its artist are available
+// as p, s and a respectively.
+func printingAggregate(aggregate string, cardID string) string {
+	return fmt.Sprintf("(SELECT %s FROM %s AS p JOIN %s AS f ON p.%s = f.%s "+
+		"LEFT JOIN %s AS s ON p.%s = s.%s LEFT JOIN %s AS a ON p.%s = a.%s WHERE f.%s = %s)",
+		aggregate,
+		printing.Table, cardface.Table, printing.CardFaceColumn, cardface.FieldID,
+		set.Table, printing.SetColumn, set.FieldID,
+		artist.Table, printing.ArtistColumn, artist.FieldID,
+		cardface.CardColumn, cardID)
+}
+
+// raritySortExpr returns an expression for the position of the rarity
+// column in raritiesInOrder, so rarities sort from common to bonus.
+func raritySortExpr(column string) string {
+	var b strings.Builder
+
+	b.WriteString("CASE " + column)
+
+	for i, rarity := range raritiesInOrder {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", rarity, i)
+	}
+
+	b.WriteString(" END")
+
+	return b.String()
+}
+
+// colorSortExpr returns an expression that sorts a ColorField column the
+// way Scryfall does: mono-colored cards in WUBRG order first, then cards
+// with more colors, then colorless cards last.
+func colorSortExpr(column string) string {
+	bits := make([]string, 0, len(stax.AllColors))
+
+	for i := range stax.AllColors {
+		bits = append(bits, fmt.Sprintf("((%s >> %d) & 1)", column, i))
+	}
+
+	// The higher bits are earlier in WUBRG, so subtracting the field from
+	// the largest possible value sorts them first among the same count.
+	width := int(allColorsField) + 1
+
+	return fmt.Sprintf("CASE WHEN %s = 0 THEN %d ELSE (%s) * %d + %d - %s END",
+		column, (len(stax.AllColors)+1)*width, strings.Join(bits, " + "), width, allColorsField, column)
+}
+
+// optionLeaf is a leaf for a term like "order:cmc" that changes the
+// SearchOptions instead of filtering cards, so it has no predicate.
+type optionLeaf struct {
+	apply func(*SearchOptions)
+}
+
+// Predicate returns nil, since options don't filter anything.
+// This is required to satisfy the leaf interface.
+func (o *optionLeaf) Predicate() predicate.Card {
+	return nil
+}
+
+// PrintingPredicate returns nil, since options don't filter anything.
+// This is required to satisfy the leaf interface.
+func (o *optionLeaf) PrintingPredicate() predicate.Printing {
+	return nil
+}
+
+// orderHandler handles inline "order:" terms.
+func orderHandler() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		order, err := ParseSortOrder(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &optionLeaf{apply: func(o *SearchOptions) { o.Order = order }}, nil
+	}
+}
+
+// directionHandler handles inline "direction:" terms.
+func directionHandler() FieldFilterHandler {
+	return func(value string) (leaf, error) {
+		direction, err := ParseSortDirection(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &optionLeaf{apply: func(o *SearchOptions) { o.Direction = direction }}, nil
+	}
+}
+
+// ApplySearchOptions returns a copy of opts updated with any inline
+// options in the parsed query, such as "order:cmc" or "direction:desc".
+// Inline options take precedence over opts, and later ones win.
+func ApplySearchOptions(root leaf, opts SearchOptions) SearchOptions {
+	switch n := root.(type) {
+	case *optionLeaf:
+		n.apply(&opts)
+	case *notNode:
+		opts = ApplySearchOptions(n.inner, opts)
+	case node:
+		if n.Left() != nil {
+			opts = ApplySearchOptions(n.Left(), opts)
+		}
+
+		if n.Right() != nil {
+			opts = ApplySearchOptions(n.Right(), opts)
+		}
+	}
+
+	return opts
+}
@@ -0,0 +1,283 @@
+package ql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SethCurry/stax/internal/bones"
+	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/printing"
+)
+
+// queryOrderedCardNames runs a query with the DefaultParser, applying any inline
+// options on top of opts, and returns the names of the matching cards in order.
+func queryOrderedCardNames(t *testing.T, db *bones.Client, query string, opts SearchOptions) []string {
+	parsed, err := ParseQuery(query)
+	require.NoError(t, err)
+
+	opts = ApplySearchOptions(parsed, opts)
+
+	found, err := db.Card.Query().Where(parsed.Predicate()).Order(opts.OrderCards()).All(context.Background())
+	require.NoError(t, err)
+
+	names := []string{}
+
+	for _, v := range found {
+		names = append(names, v.Name)
+	}
+
+	return names
+}
+
+func TestOrderCards(t *testing.T) {
+	db := newFilterTestDB(t)
+	ctx := context.Background()
+
+	alpha := db.Set.Create().SetName("Limited Edition Alpha").SetCode("lea").SaveX(ctx)
+	m10 := db.Set.Create().SetName("Magic 2010").SetCode("m10").SaveX(ctx)
+
+	cards := []struct {
+		face     testFace
+		set      *bones.Set
+		rarity   printing.Rarity
+		released time.Time
+	}{
+		{testFace{Name: "Lightning Bolt", TypeLine: "Instant", Colors: "R"}, alpha, printing.RarityCommon, time.Date(1993, 8, 5, 0, 0, 0, 0, time.UTC)},
+		{testFace{Name: "Serra Angel", TypeLine: "Creature", Power: "4", Toughness: "4", Colors: "W"}, alpha, printing.RarityUncommon, time.Date(1993, 8, 5, 0, 0, 0, 0, time.UTC)},
+		{testFace{Name: "Baneslayer Angel", TypeLine: "Creature", Power: "5", Toughness: "5", Colors: "W"}, m10, printing.RarityMythic, time.Date(2009, 7, 17, 0, 0, 0, 0, time.UTC)},
+		{testFace{Name: "Ornithopter", TypeLine: "Artifact Creature", Power: "0", Toughness: "2", Colors: ""}, m10, printing.RarityUncommon, time.Date(2009, 7, 17, 0, 0, 0, 0, time.UTC)},
+		{testFace{Name: "Sprouting Thrinax", TypeLine: "Creature", Power: "3", Toughness: "3", Colors: "BRG"}, m10, printing.RarityUncommon, time.Date(2009, 7, 17, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cards {
+		created := createTestCard(t, db, c.face.Name, c.face)
+		face := created.QueryFaces().OnlyX(ctx)
+
+		db.Printing.Create().
+			SetCardFace(face).
+			SetSet(c.set).
+			SetRarity(c.rarity).
+			SetReleasedAt(c.released).
+			SaveX(ctx)
+	}
+
+	testCases := []struct {
+		name     string
+		query    string
+		opts     SearchOptions
+		expected []string
+	}{
+		{
+			name:     "default is by name",
+			query:    "t:creature",
+			opts:     DefaultSearchOptions,
+			expected: []string{"Baneslayer Angel", "Ornithopter", "Serra Angel", "Sprouting Thrinax"},
+		},
+		{
+			name:     "power puts missing values last",
+			query:    "order:power",
+			opts:     DefaultSearchOptions,
+			expected: []string{"Ornithopter", "Sprouting Thrinax", "Serra Angel", "Baneslayer Angel", "Lightning Bolt"},
+		},
+		{
+			name:     "power descending",
+			query:    "t:creature order:power dir:desc",
+			opts:     DefaultSearchOptions,
+			expected: []string{"Baneslayer Angel", "Serra Angel", "Sprouting Thrinax", "Ornithopter"},
+		},
+		{
+			name:     "color",
+			query:    "order:color",
+			opts:     DefaultSearchOptions,
+			expected: []string{"Baneslayer Angel", "Serra Angel", "Lightning Bolt", "Sprouting Thrinax", "Ornithopter"},
+		},
+		{
+			name:     "released is newest first",
+			query:    "order:released",
+			opts:     DefaultSearchOptions,
+			expected: []string{"Baneslayer Angel", "Ornithopter", "Sprouting Thrinax", "Lightning Bolt", "Serra Angel"},
+		},
+		{
+			name:     "rarity",
+			query:    "t:creature",
+			opts:     SearchOptions{Order: OrderRarity, Direction: DirectionDesc},
+			expected: []string{"Baneslayer Angel", "Ornithopter", "Serra Angel", "Sprouting Thrinax"},
+		},
+		{
+			name:     "inline options win",
+			query:    "t:creature direction:asc",
+			opts:     SearchOptions{Order: OrderToughness, Direction: DirectionDesc},
+			expected: []string{"Ornithopter", "Sprouting Thrinax", "Serra Angel", "Baneslayer Angel"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, queryOrderedCardNames(t, db, tc.query, tc.opts))
+		})
+	}
+
+	t.Run("printings by set", func(t *testing.T) {
+		opts := SearchOptions{Order: OrderSet, Direction: DirectionAsc}
+
+		found, err := db.Printing.Query().
+			Order(opts.OrderPrintings()).
+			WithSet().
+			All(ctx)
+		require.NoError(t, err)
+
+		codes := []string{}
+
+		for _, p := range found {
+			codes = append(codes, p.Edges.Set.Code)
+		}
+
+		assert.Equal(t, []string{"lea", "lea", "m10", "m10", "m10"}, codes)
+	})
+}
+
+func TestOrderPrintings_SetReleaseDate(t *testing.T) {
+	db := newFilterTestDB(t)
+	ctx := context.Background()
+
+	alpha := db.Set.Create().SetName("Limited Edition Alpha").SetCode("lea").
+		SetReleasedAt(time.Date(1993, 8, 5, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	m10 := db.Set.Create().SetName("Magic 2010").SetCode("m10").SaveX(ctx)
+	lotr := db.Set.Create().SetName("The Lord of the Rings: Tales of Middle-earth").SetCode("ltr").
+		SetReleasedAt(time.Date(2023, 6, 23, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+
+	created := createTestCard(t, db, "Lightning Bolt", testFace{Name: "Lightning Bolt"})
+	face := created.QueryFaces().OnlyX(ctx)
+
+	// only the M10 printing has its own release date
+	db.Printing.Create().SetCardFace(face).SetSet(alpha).SetRarity(printing.RarityCommon).SaveX(ctx)
+	db.Printing.Create().SetCardFace(face).SetSet(m10).SetRarity(printing.RarityCommon).
+		SetReleasedAt(time.Date(2009, 7, 17, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	db.Printing.Create().SetCardFace(face).SetSet(lotr).SetRarity(printing.RarityCommon).SaveX(ctx)
+
+	opts := SearchOptions{Order: OrderReleased, Direction: DirectionAsc}
+
+	found, err := db.Printing.Query().Order(opts.OrderPrintings()).WithSet().All(ctx)
+	require.NoError(t, err)
+
+	codes := []string{}
+
+	for _, p := range found {
+		codes = append(codes, p.Edges.Set.Code)
+	}
+
+	assert.Equal(t, []string{"lea", "m10", "ltr"}, codes)
+}
+
+func TestFilterPrintings(t *testing.T) {
+	db := newFilterTestDB(t)
+	ctx := context.Background()
+
+	alpha := db.Set.Create().SetName("Limited Edition Alpha").SetCode("lea").
+		SetReleasedAt(time.Date(1993, 8, 5, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	m10 := db.Set.Create().SetName("Magic 2010").SetCode("m10").
+		SetReleasedAt(time.Date(2009, 7, 17, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+
+	bolt := createTestCard(t, db, "Lightning Bolt", testFace{Name: "Lightning Bolt"}).QueryFaces().OnlyX(ctx)
+
+	// the M10 printing of illustration "a" is created first, but released later
+	boltPrintings := []struct {
+		scryfallID   string
+		set          *bones.Set
+		illustration string
+	}{
+		{"bolt-m10-a", m10, "a"},
+		{"bolt-lea-a", alpha, "a"},
+		{"bolt-m10-b", m10, "b"},
+		{"bolt-lea-none", alpha, ""},
+	}
+
+	for _, p := range boltPrintings {
+		db.Printing.Create().
+			SetCardFace(bolt).
+			SetSet(p.set).
+			SetRarity(printing.RarityCommon).
+			SetScryfallID(p.scryfallID).
+			SetIllustrationID(p.illustration).
+			SaveX(ctx)
+	}
+
+	// both faces of a double-faced card share the Scryfall ID of their printing
+	delver := createTestCard(t, db, "Delver of Secrets // Insectile Aberration",
+		testFace{Name: "Delver of Secrets"}, testFace{Name: "Insectile Aberration"})
+
+	for i, face := range delver.QueryFaces().Order(cardface.ByID()).AllX(ctx) {
+		db.Printing.Create().
+			SetCardFace(face).
+			SetSet(m10).
+			SetRarity(printing.RarityCommon).
+			SetScryfallID("delver-m10").
+			SetIllustrationID(fmt.Sprintf("delver-%d", i)).
+			SaveX(ctx)
+	}
+
+	search := func(query string, unique UniqueMode) []string {
+		parsed, err := ParseQuery(query)
+		require.NoError(t, err)
+
+		found, err := db.Printing.Query().
+			Where(SearchOptions{Unique: unique}.FilterPrintings(parsed.PrintingPredicate())).
+			Order(printing.ByScryfallID()).
+			All(ctx)
+		require.NoError(t, err)
+
+		ids := []string{}
+
+		for _, p := range found {
+			ids = append(ids, p.ScryfallID)
+		}
+
+		return ids
+	}
+
+	assert.Equal(t,
+		[]string{"bolt-lea-a", "bolt-lea-none", "bolt-m10-a", "bolt-m10-b", "delver-m10"},
+		search("cmc>=0", UniquePrints))
+
+	// the first printing of illustration "a" to be released is kept
+	assert.Equal(t,
+		[]string{"bolt-lea-a", "bolt-lea-none", "bolt-m10-b", "delver-m10"},
+		search("cmc>=0", UniqueArt))
+
+	// filters on printings only return the printings they match
+	assert.Equal(t, []string{"bolt-lea-a", "bolt-lea-none"}, search("s:lea", UniquePrints))
+	assert.Equal(t, []string{"bolt-m10-a", "bolt-m10-b", "delver-m10"}, search("-s:lea", UniquePrints))
+
+	// illustrations are deduplicated within the printings that match
+	assert.Equal(t, []string{"bolt-m10-a", "bolt-m10-b"}, search("s:m10 name:\"Lightning Bolt\"", UniqueArt))
+
+	// in: matches cards rather than printings
+	assert.Equal(t,
+		[]string{"bolt-lea-a", "bolt-lea-none", "bolt-m10-a", "bolt-m10-b"},
+		search("in:lea", UniquePrints))
+}
+
+func TestSearchOptions_Errors(t *testing.T) {
+	_, err := ParseQuery("order:flavor")
+	assert.Error(t, err)
+
+	_, err = ParseQuery("-order:cmc")
+	assert.True(t, errors.Is(err, ErrNegatedOption), "got error: %v", err)
+
+	var noOperation *ErrNoOperationForField
+
+	_, err = ParseQuery("order!=cmc")
+	assert.True(t, errors.As(err, &noOperation), "got error: %v", err)
+
+	_, err = ParseSortDirection("sideways")
+	assert.Error(t, err)
+
+	_, err = ParseUniqueMode("faces")
+	assert.Error(t, err)
+}
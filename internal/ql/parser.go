@@ -3,12 +3,15 @@ package ql
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/SethCurry/scurry-go/fp"
 	"github.com/SethCurry/stax/internal/bones/card"
 	"github.com/SethCurry/stax/internal/bones/cardface"
+	"github.com/SethCurry/stax/internal/bones/legality"
 	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/SethCurry/stax/internal/bones/printing"
 )
 
 // operator represents a comparison operator in the query.
@@ -21,6 +24,11 @@ const (
 	opGE operator = ">="
 	opLT operator = "<"
 	opLE operator = "<="
+
+	// opColon is Scryfall's default operator, as in "o:flying".  It means the
+	// same as = for most fields, but some give it a looser meaning, like
+	// "id:esper" matching any card that fits in an Esper deck.
+	opColon operator = ":"
 )
 
 // leaf is a terminal node in the parse tree.
@@ -28,6 +36,11 @@ const (
 // "name:Static"
 type leaf interface {
 	Predicate() predicate.Card
+
+	// PrintingPredicate returns the predicate for searching printings
+	// instead of cards, so that filters on printings like "s:lea" only
+	// match the printings they describe.
+	PrintingPredicate() predicate.Printing
 }
 
 // node is a single node in the parse tree.
@@ -42,14 +55,39 @@ type node interface {
 
 // logicNode is a node that holds a logic operator (AND or OR) and two children.
 type logicNode struct {
-	predicator func(...predicate.Card) predicate.Card
-	left       leaf
-	right      leaf
+	keyword            keyword
+	predicator         func(...predicate.Card) predicate.Card
+	printingPredicator func(...predicate.Printing) predicate.Printing
+	left               leaf
+	right              leaf
 }
 
 // Predicate returns the predicate for the node,
 // such as ANDing or ORing two predicates together.
+//
+// Filters on printings that are ANDed together must all be satisfied by
+// the same printing, so that "s:m10 r:c" doesn't match a card printed in
+// m10 that was only common in some other set.
 func (l *logicNode) Predicate() predicate.Card {
+	if l.keyword == keywordAnd {
+		cards := []predicate.Card{}
+		printings := []predicate.Printing{}
+
+		for _, term := range l.conjuncts() {
+			if pred, ok := printingTerms(term); ok {
+				printings = append(printings, pred)
+			} else {
+				cards = append(cards, term.Predicate())
+			}
+		}
+
+		if len(printings) > 0 {
+			cards = append(cards, printingsWith(printings...))
+		}
+
+		return l.predicator(cards...)
+	}
+
 	var leftValue predicate.Card
 	var rightValue predicate.Card
 
@@ -64,6 +102,67 @@ func (l *logicNode) Predicate() predicate.Card {
 	return l.predicator(leftValue, rightValue)
 }
 
+// PrintingPredicate returns the printing predicate for the node,
+// such as ANDing or ORing two predicates together.
+func (l *logicNode) PrintingPredicate() predicate.Printing {
+	var leftValue predicate.Printing
+	var rightValue predicate.Printing
+
+	if l.left != nil {
+		leftValue = l.left.PrintingPredicate()
+	}
+
+	if l.right != nil {
+		rightValue = l.right.PrintingPredicate()
+	}
+
+	return l.printingPredicator(leftValue, rightValue)
+}
+
+// conjuncts returns the terms ANDed together by an AND node,
+// including the terms of any AND nodes beneath it.
+func (l *logicNode) conjuncts() []leaf {
+	ret := []leaf{}
+
+	for _, child := range []leaf{l.left, l.right} {
+		if child == nil {
+			continue
+		}
+
+		if childNode, ok := child.(*logicNode); ok && childNode.keyword == keywordAnd {
+			ret = append(ret, childNode.conjuncts()...)
+		} else {
+			ret = append(ret, child)
+		}
+	}
+
+	return ret
+}
+
+// printingTerms returns the printing predicate of a term that only
+// filters printings, such as "s:lea" or "(r:c OR r:u)".  It returns
+// false if any part of the term filters cards instead.
+func printingTerms(term leaf) (predicate.Printing, bool) {
+	switch t := term.(type) {
+	case *basicLeaf:
+		return t.printingPredicator, t.printingPredicator != nil
+	case *logicNode:
+		left, ok := printingTerms(t.left)
+		if !ok {
+			return nil, false
+		}
+
+		right, ok := printingTerms(t.right)
+		if !ok {
+			return nil, false
+		}
+
+		return t.printingPredicator(left, right), true
+	default:
+		return nil, false
+	}
+}
+
 // Left returns the left child of the node.
 // This is required to satisfy the node interface.
 func (l *logicNode) Left() leaf {
@@ -88,13 +187,23 @@ func (l *logicNode) SetRight(right leaf) {
 	l.right = right
 }
 
+// nonNilPredicates filters out any nil predicates, which occur when
+// a logicNode only has one child.
+func nonNilPredicates[P predicate.Card | predicate.Printing](preds []P) []P {
+	return fp.Filter[P](func(p P) bool {
+		return p != nil
+	}, preds)
+}
+
 // newAndNode creates a new AND node.
 func newAndNode(left, right leaf) *logicNode {
 	return &logicNode{
+		keyword: keywordAnd,
 		predicator: func(cards ...predicate.Card) predicate.Card {
-			return card.And(fp.Filter[predicate.Card](func(c predicate.Card) bool {
-				return c != nil
-			}, cards)...)
+			return card.And(nonNilPredicates(cards)...)
+		},
+		printingPredicator: func(printings ...predicate.Printing) predicate.Printing {
+			return printing.And(nonNilPredicates(printings)...)
 		},
 		left:  left,
 		right: right,
@@ -104,17 +213,48 @@ func newAndNode(left, right leaf) *logicNode {
 // newOrNode creates a new OR node.
 func newOrNode(left, right leaf) *logicNode {
 	return &logicNode{
+		keyword: keywordOr,
 		predicator: func(cards ...predicate.Card) predicate.Card {
-			return card.Or(cards...)
+			return card.Or(nonNilPredicates(cards)...)
+		},
+		printingPredicator: func(printings ...predicate.Printing) predicate.Printing {
+			return printing.Or(nonNilPredicates(printings)...)
 		},
 		left:  left,
 		right: right,
 	}
 }
 
+// notNode negates whatever leaf or node it wraps.
+// These are created for "-term", "NOT term" and != filters.
+type notNode struct {
+	inner leaf
+}
+
+// Predicate returns the negated predicate of the wrapped leaf.
+// This is required to satisfy the leaf interface.
+func (n *notNode) Predicate() predicate.Card {
+	return card.Not(n.inner.Predicate())
+}
+
+// PrintingPredicate returns the negated printing predicate of the wrapped leaf.
+// This is required to satisfy the leaf interface.
+func (n *notNode) PrintingPredicate() predicate.Printing {
+	return printing.Not(n.inner.PrintingPredicate())
+}
+
+// newNotNode creates a new NOT node wrapping the given leaf.
+func newNotNode(inner leaf) *notNode {
+	return &notNode{inner: inner}
+}
+
 // basicLeaf is a leaf node that holds a single predicate.
 type basicLeaf struct {
 	predicator predicate.Card
+
+	// printingPredicator is set for filters on printings rather than
+	// cards, see newPrintingLeaf.
+	printingPredicator predicate.Printing
 }
 
 // Predicate returns the predicate for the leaf node.
@@ -123,6 +263,17 @@ func (l *basicLeaf) Predicate() predicate.Card {
 	return l.predicator
 }
 
+// PrintingPredicate returns the printing predicate for the leaf node, which
+// matches every printing of the matching cards unless the leaf filters printings.
+// This is required to satisfy the leaf interface.
+func (l *basicLeaf) PrintingPredicate() predicate.Printing {
+	if l.printingPredicator != nil {
+		return l.printingPredicator
+	}
+
+	return printing.HasCardFaceWith(cardface.HasCardWith(l.predicator))
+}
+
 // newTokenReader creates a new token reader for a slice of tokens.
 func newTokenReader(tokens []Token) *tokenReader {
 	return &tokenReader{
@@ -153,6 +304,34 @@ func (r *tokenReader) next() (*Token, bool) {
 	return &ret, true
 }
 
+// peek returns the next token in the slice without advancing the index.
+// It returns nil, false if there are no more tokens to read.
+func (r *tokenReader) peek() (*Token, bool) {
+	if !r.hasMore() {
+		return nil, false
+	}
+
+	ret := r.tokens[r.index]
+
+	return &ret, true
+}
+
+// eofError returns a *QueryError for the query ending while the
+// parser still expected one of the provided tokens.
+func (r *tokenReader) eofError(expected []string) *QueryError {
+	end := 0
+
+	if len(r.tokens) > 0 {
+		last := r.tokens[len(r.tokens)-1]
+		end = last.Offset + last.Length
+	}
+
+	queryErr := newQueryError(fmt.Errorf("%w, expected %s", ErrUnexpectedEOF, strings.Join(expected, " or ")), end, 0)
+	queryErr.Expected = expected
+
+	return queryErr
+}
+
 // hasMore returns true if there are more tokens to read,
 // or false if the index is out of bounds.
 func (r *tokenReader) hasMore() bool {
@@ -178,84 +357,308 @@ func (p *Parser) handleField(field string, op operator, value string) (leaf, err
 		}
 	}
 
-	return nil, &ErrNoField{Field: field}
+	return nil, &ErrNoField{Field: field, Suggestions: p.suggestFields(field)}
+}
+
+// maxFieldSuggestions is the most "did you mean" suggestions returned for a misspelled field.
+const maxFieldSuggestions = 3
+
+// suggestFields returns the names and aliases of registered fields that are
+// within a few edits of the provided field name, closest first.
+func (p *Parser) suggestFields(field string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	// allow roughly one typo for every three characters
+	maxDistance := max(1, len(field)/3)
+	suggestions := []suggestion{}
+
+	for _, f := range p.Fields {
+		for _, name := range append([]string{f.Name}, f.Aliases...) {
+			distance := editDistance(strings.ToLower(field), name)
+			if distance <= maxDistance {
+				suggestions = append(suggestions, suggestion{name: name, distance: distance})
+			}
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	names := []string{}
+
+	for i := 0; i < len(suggestions) && i < maxFieldSuggestions; i++ {
+		names = append(names, suggestions[i].name)
+	}
+
+	return names
+}
+
+// editDistance returns the number of insertions, deletions, substitutions
+// and swaps of adjacent characters needed to turn a into b.  Counting swaps
+// as a single edit means typos like "nmae" are as close to "name" as "nme".
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// dist[i][j] is the distance between the first i runes of a and the first j runes of b
+	dist := make([][]int, len(ra)+1)
+
+	for i := range dist {
+		dist[i] = make([]int, len(rb)+1)
+		dist[i][0] = i
+	}
+
+	for j := range dist[0] {
+		dist[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			dist[i][j] = min(dist[i-1][j]+1, dist[i][j-1]+1, dist[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				dist[i][j] = min(dist[i][j], dist[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return dist[len(ra)][len(rb)]
 }
 
 // ParseTokens parses a slice of tokens and returns a node that can be converted to a bones predicate.
 // This is useful if you want to separate the lexing and parsing phases.
+//
+// The grammar follows Scryfall's: terms next to each other are implicitly
+// ANDed together, AND binds tighter than OR, and parentheses can be used
+// to group terms.
+//
+//	query := or
+//	or    := and { "OR" and }
+//	and   := term { ["AND"] term }
+//	term  := ("NOT" | "-") term | "(" or ")" | literal operator literal
 func (p *Parser) ParseTokens(tokens []Token) (node, error) {
 	reader := newTokenReader(tokens)
 
-	var root node
-	var previous node
+	if !reader.hasMore() {
+		return nil, newQueryError(ErrEmptyQuery, 0, 0)
+	}
+
+	root, err := p.parseOr(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// parseOr only stops early when it hits a token it can't use,
+	// which at the top level can only be an unbalanced closing paren.
+	if extra, ok := reader.next(); ok {
+		return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *extra, Expected: []string{"end of query"}}, extra)
+	}
+
+	// Always hand back a node so callers can walk the tree uniformly,
+	// even if the query was a single term.
+	if rootNode, ok := root.(node); ok {
+		return rootNode, nil
+	}
+
+	return newAndNode(root, nil), nil
+}
+
+// parseOr parses one or more AND groups separated by OR keywords.
+func (p *Parser) parseOr(reader *tokenReader) (leaf, error) {
+	left, err := p.parseAnd(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		nextToken, ok := reader.peek()
+		if !ok || nextToken.Family != FamilyKeyword || nextToken.Value != keywordOr {
+			return left, nil
+		}
+
+		reader.next()
+
+		right, err := p.parseAnd(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		left = newOrNode(left, right)
+	}
+}
+
+// parseAnd parses one or more terms that are either separated by
+// an explicit AND keyword or simply placed next to each other.
+func (p *Parser) parseAnd(reader *tokenReader) (leaf, error) {
+	left, err := p.parseTerm(reader)
+	if err != nil {
+		return nil, err
+	}
 
-	for reader.hasMore() {
-		nextToken, ok := reader.next()
+	for {
+		nextToken, ok := reader.peek()
 		if !ok {
-			return nil, errors.New("unexpected EOF")
+			return left, nil
 		}
 
-		switch nextToken.Family {
-		case FamilyOperator:
-			return nil, fmt.Errorf("expected keyword instead of operator, but got: %s", nextToken.Value)
-		case FamilyParen:
-			return nil, errors.New("parentheses are not yet supported")
-		case FamilyKeyword:
-			keyword := nextToken.Value
-
-			switch strings.ToLower(keyword) {
-			case "and":
-				newPrevious := newAndNode(nil, nil)
-				previous.SetRight(newPrevious)
-				previous = newPrevious
-			case "or":
-				newPrevious := newOrNode(nil, nil)
-				previous.SetRight(newPrevious)
-				previous = newPrevious
-			default:
-				return nil, fmt.Errorf("unrecognized keyword: %s", keyword)
-			}
-		case FamilyLiteral:
-			filterType := nextToken.Value
+		switch {
+		case nextToken.Family == FamilyKeyword && nextToken.Value == keywordAnd:
+			reader.next()
+		case nextToken.Family == FamilyKeyword && nextToken.Value == keywordNot:
+		case nextToken.Family == FamilyLiteral:
+		case nextToken.Family == FamilyParen && nextToken.Value == "(":
+		default:
+			// Either an OR or a closing paren, both of which
+			// are handled further up the call stack.
+			return left, nil
+		}
 
-			opToken, ok := reader.next()
-			if !ok {
-				return nil, errors.New("expected operator after literal")
-			}
+		right, err := p.parseTerm(reader)
+		if err != nil {
+			return nil, err
+		}
 
-			valueToken, ok := reader.next()
-			if !ok {
-				return nil, errors.New("expected value after operator")
-			}
+		left = newAndNode(left, right)
+	}
+}
 
-			leafNode, err := p.handleField(filterType, operator(opToken.Value), valueToken.Value)
-			if err != nil {
-				return nil, fmt.Errorf("failed to build leaf node: %w", err)
-			}
+// termExpected is what the parser expects at the start of a term.
+var termExpected = []string{"filter", "(", "NOT"}
 
-			if root == nil {
-				root = newAndNode(leafNode, previous)
-				previous = root
-			} else {
-				newPrevious := newAndNode(nil, nil)
-				previous.SetLeft(leafNode)
-				previous.SetRight(newPrevious)
-				previous = newPrevious
-			}
+// parseTerm parses either a negated term, a parenthesized group,
+// or a single field filter like "cmc>3".
+func (p *Parser) parseTerm(reader *tokenReader) (leaf, error) {
+	nextToken, ok := reader.next()
+	if !ok {
+		return nil, reader.eofError(termExpected)
+	}
+
+	switch nextToken.Family {
+	case FamilyKeyword:
+		if nextToken.Value != keywordNot {
+			return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *nextToken, Expected: termExpected}, nextToken)
+		}
+
+		inner, err := p.parseTerm(reader)
+		if err != nil {
+			return nil, err
 		}
+
+		if _, isOption := inner.(*optionLeaf); isOption {
+			return nil, newQueryErrorAt(ErrNegatedOption, nextToken)
+		}
+
+		return newNotNode(inner), nil
+	case FamilyParen:
+		if nextToken.Value != "(" {
+			return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *nextToken, Expected: termExpected}, nextToken)
+		}
+
+		inner, err := p.parseOr(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		closing, ok := reader.next()
+		if !ok {
+			// point at the opening paren, since that's what needs fixing
+			queryErr := newQueryErrorAt(ErrUnclosedParen, nextToken)
+			queryErr.Expected = []string{")"}
+
+			return nil, queryErr
+		}
+
+		if closing.Family != FamilyParen || closing.Value != ")" {
+			return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *closing, Expected: []string{")"}}, closing)
+		}
+
+		return inner, nil
+	case FamilyLiteral:
+		return p.parseFilter(nextToken, reader)
+	default:
+		return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *nextToken, Expected: termExpected}, nextToken)
 	}
+}
 
-	return root, nil
+// parseFilter parses the operator and value following a field name
+// and builds the leaf for it.
+func (p *Parser) parseFilter(fieldToken *Token, reader *tokenReader) (leaf, error) {
+	opToken, ok := reader.next()
+	if !ok {
+		return nil, reader.eofError([]string{"operator"})
+	}
+
+	if opToken.Family != FamilyOperator {
+		return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *opToken, Expected: []string{"operator"}}, opToken)
+	}
+
+	valueToken, ok := reader.next()
+	if !ok {
+		return nil, reader.eofError([]string{"value"})
+	}
+
+	// Keywords are allowed as values so that queries like o:and still work.
+	if valueToken.Family != FamilyLiteral && valueToken.Family != FamilyKeyword {
+		return nil, newQueryErrorAt(&ErrUnexpectedToken{Token: *valueToken, Expected: []string{"value"}}, valueToken)
+	}
+
+	leafNode, err := p.handleField(fieldToken.Value, operator(opToken.Value), valueToken.Value)
+	if err != nil {
+		// point at whichever part of the filter was wrong
+		var noField *ErrNoField
+		var noOperation *ErrNoOperationForField
+
+		switch {
+		case errors.As(err, &noField):
+			return nil, newQueryErrorAt(err, fieldToken)
+		case errors.As(err, &noOperation):
+			return nil, newQueryErrorAt(err, opToken)
+		default:
+			return nil, newQueryErrorAt(err, valueToken)
+		}
+	}
+
+	return leafNode, nil
 }
 
 // ParseQuery parses a query string and returns a node that can be converted to a bones predicate.
+//
+// Any error returned can be unwrapped to a *QueryError describing where in the query the problem is.
 func (p *Parser) ParseQuery(query string) (node, error) {
 	tokens, err := LexString(query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to lex query: %w", err)
+		return nil, withQuery(fmt.Errorf("failed to lex query: %w", err), query)
+	}
+
+	root, err := p.ParseTokens(tokens)
+	if err != nil {
+		return nil, withQuery(err, query)
+	}
+
+	return root, nil
+}
+
+// withQuery records the query on the *QueryError in err, if there is one,
+// so that it can render a diagram.
+func withQuery(err error, query string) error {
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		queryErr.Query = query
 	}
 
-	return p.ParseTokens(tokens)
+	return err
 }
 
 // DefaultParser is a parser with the standard set of fields already registered.
@@ -279,6 +682,13 @@ var DefaultParser = &Parser{
 				},
 			},
 		},
+		{
+			Name:    "type",
+			Aliases: []string{"t"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: typesEQ(),
+			},
+		},
 		{
 			Name: "cmc",
 			Handlers: map[operator]FieldFilterHandler{
@@ -299,15 +709,73 @@ var DefaultParser = &Parser{
 				}),
 			},
 		},
+		newStatFieldFilter("power", []string{"pow"}, cardface.FieldPowerValue),
+		newStatFieldFilter("toughness", []string{"tou"}, cardface.FieldToughnessValue),
+		newStatFieldFilter("loyalty", []string{"loy"}, cardface.FieldLoyaltyValue),
+		newColorsFieldFilter(),
+		newColorIdentityFieldFilter(),
+		{
+			// Restricted cards are still legal to play, just limited to one copy.
+			Name:    "format",
+			Aliases: []string{"f", "legal"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: legalityHandler(legality.LegalityLegal, legality.LegalityRestricted),
+			},
+		},
 		{
-			Name:    "colors",
-			Aliases: []string{"c"},
+			Name: "banned",
 			Handlers: map[operator]FieldFilterHandler{
-				opEQ: colorsEQ(),
-				opLT: colorsLT(),
-				opLE: colorsLT(),
-				opGT: colorsGT(),
-				opGE: colorsGT(),
+				opEQ: legalityHandler(legality.LegalityBanned),
+			},
+		},
+		{
+			Name: "restricted",
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: legalityHandler(legality.LegalityRestricted),
+			},
+		},
+		{
+			Name:    "set",
+			Aliases: []string{"s", "edition", "e"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: setHandler(),
+			},
+		},
+		{
+			Name: "in",
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: inHandler(),
+			},
+		},
+		{
+			Name:    "rarity",
+			Aliases: []string{"r"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: rarityHandler(func(r, v int) bool { return r == v }),
+				opLT: rarityHandler(func(r, v int) bool { return r < v }),
+				opLE: rarityHandler(func(r, v int) bool { return r <= v }),
+				opGT: rarityHandler(func(r, v int) bool { return r > v }),
+				opGE: rarityHandler(func(r, v int) bool { return r >= v }),
+			},
+		},
+		{
+			Name:    "artist",
+			Aliases: []string{"a"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: artistHandler(),
+			},
+		},
+		{
+			Name: "order",
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: orderHandler(),
+			},
+		},
+		{
+			Name:    "direction",
+			Aliases: []string{"dir"},
+			Handlers: map[operator]FieldFilterHandler{
+				opEQ: directionHandler(),
 			},
 		},
 	},
@@ -316,10 +784,5 @@ var DefaultParser = &Parser{
 // ParseQuery parses a query string and returns a node that can be converted to a bones predicate.
 // This is the main entry point for the ql package.
 func ParseQuery(query string) (node, error) {
-	tokens, err := LexString(query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to lex query: %w", err)
-	}
-
-	return DefaultParser.ParseTokens(tokens)
+	return DefaultParser.ParseQuery(query)
 }
@@ -1,5 +1,14 @@
 package ql
 
+import (
+	"errors"
+	"testing"
+
+	"github.com/SethCurry/stax/internal/bones/predicate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
 func ExampleParseQuery() {
 	query := "name=\"Static Orb\" AND cmc<4"
 
@@ -14,3 +23,317 @@ func ExampleParseQuery() {
 	// like so:
 	// cards, err := dbClient.Cards().Query().Where(parsed.Predicate()).All(ctx)
 }
+
+// namedLeaf is a leaf that remembers the filter it was built from,
+// so tests can assert on the shape of the parse tree.
+type namedLeaf struct {
+	name string
+}
+
+func (n *namedLeaf) Predicate() predicate.Card {
+	return nil
+}
+
+func (n *namedLeaf) PrintingPredicate() predicate.Printing {
+	return nil
+}
+
+// renderTree converts a parse tree into a Lisp-like string such as
+// "(AND c=R (OR c=G c=B))".
+func renderTree(l leaf) string {
+	switch typed := l.(type) {
+	case *logicNode:
+		if typed.right == nil {
+			return renderTree(typed.left)
+		}
+
+		return "(" + string(typed.keyword) + " " + renderTree(typed.left) + " " + renderTree(typed.right) + ")"
+	case *notNode:
+		return "(NOT " + renderTree(typed.inner) + ")"
+	case *namedLeaf:
+		return typed.name
+	default:
+		return "?"
+	}
+}
+
+func newTestParser() *Parser {
+	handler := func(field string) FieldFilterHandler {
+		return func(value string) (leaf, error) {
+			return &namedLeaf{name: field + "=" + value}, nil
+		}
+	}
+
+	return &Parser{
+		Fields: []FieldFilter{
+			{
+				Name: "c",
+				Handlers: map[operator]FieldFilterHandler{
+					opEQ: handler("c"),
+				},
+			},
+			{
+				Name: "t",
+				Handlers: map[operator]FieldFilterHandler{
+					opEQ: handler("t"),
+				},
+			},
+		},
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "single term",
+			query:    "c=R",
+			expected: "c=R",
+		},
+		{
+			name:     "explicit and",
+			query:    "c=R AND t=goblin",
+			expected: "(AND c=R t=goblin)",
+		},
+		{
+			name:     "implicit and",
+			query:    "c=R t=goblin",
+			expected: "(AND c=R t=goblin)",
+		},
+		{
+			name:     "and binds tighter than or",
+			query:    "c=R OR c=G t=elf",
+			expected: "(OR c=R (AND c=G t=elf))",
+		},
+		{
+			name:     "or chains are left associative",
+			query:    "c=R or c=G OR c=B",
+			expected: "(OR (OR c=R c=G) c=B)",
+		},
+		{
+			name:     "parentheses override precedence",
+			query:    "(c=R OR c=G) t=elf",
+			expected: "(AND (OR c=R c=G) t=elf)",
+		},
+		{
+			name:     "nested parentheses",
+			query:    "t=elf ((c=R OR c=G) OR (c=B AND t=rogue))",
+			expected: "(AND t=elf (OR (OR c=R c=G) (AND c=B t=rogue)))",
+		},
+		{
+			name:     "dash negation",
+			query:    "-c=R t=elf",
+			expected: "(AND (NOT c=R) t=elf)",
+		},
+		{
+			name:     "not keyword",
+			query:    "c=R not t=elf",
+			expected: "(AND c=R (NOT t=elf))",
+		},
+		{
+			name:     "negated group",
+			query:    "NOT (t=creature OR t=planeswalker)",
+			expected: "(NOT (OR t=creature t=planeswalker))",
+		},
+		{
+			name:     "double negation",
+			query:    "NOT -c=R",
+			expected: "(NOT (NOT c=R))",
+		},
+		{
+			name:     "generic not equal",
+			query:    "c!=R",
+			expected: "(NOT c=R)",
+		},
+		{
+			name:     "keyword as a value",
+			query:    "t=or",
+			expected: "t=OR",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := LexString(tc.query)
+			require.NoError(t, err)
+
+			got, err := newTestParser().ParseTokens(tokens)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, renderTree(got))
+		})
+	}
+}
+
+func TestParseTokens_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected error
+	}{
+		{
+			name:     "empty query",
+			query:    "",
+			expected: ErrEmptyQuery,
+		},
+		{
+			name:     "unclosed paren",
+			query:    "(c=R OR c=G",
+			expected: ErrUnclosedParen,
+		},
+		{
+			name:     "dangling keyword",
+			query:    "c=R AND",
+			expected: ErrUnexpectedEOF,
+		},
+		{
+			name:     "dangling not",
+			query:    "c=R -",
+			expected: ErrUnexpectedEOF,
+		},
+		{
+			name:     "missing value",
+			query:    "c=",
+			expected: ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := LexString(tc.query)
+			require.NoError(t, err)
+
+			_, err = newTestParser().ParseTokens(tokens)
+			assert.True(t, errors.Is(err, tc.expected), "got error: %v", err)
+		})
+	}
+
+	unexpected := []string{
+		"c=R)",
+		"()",
+		"OR c=R",
+		"c R",
+	}
+
+	for _, query := range unexpected {
+		t.Run(query, func(t *testing.T) {
+			tokens, err := LexString(query)
+			require.NoError(t, err)
+
+			_, err = newTestParser().ParseTokens(tokens)
+
+			var unexpectedErr *ErrUnexpectedToken
+			assert.True(t, errors.As(err, &unexpectedErr), "got error: %v", err)
+		})
+	}
+}
+
+func TestParseQuery_ErrorPositions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		query       string
+		offset      int
+		length      int
+		expected    []string
+		suggestions []string
+	}{
+		{
+			name:        "misspelled field",
+			query:       "name=foo cnc>3",
+			offset:      9,
+			length:      3,
+			suggestions: []string{"cmc"},
+		},
+		{
+			name:        "swapped letters in field",
+			query:       "nmae:bolt",
+			offset:      0,
+			length:      4,
+			suggestions: []string{"name"},
+		},
+		{
+			name:     "unsupported operator",
+			query:    "name<Opt",
+			offset:   4,
+			length:   1,
+			expected: []string{"!=", ":", "="},
+		},
+		{
+			name:     "unclosed paren",
+			query:    "c=R (c=G OR c=U",
+			offset:   4,
+			length:   1,
+			expected: []string{")"},
+		},
+		{
+			name:     "dangling keyword",
+			query:    "c=R AND",
+			offset:   7,
+			length:   0,
+			expected: termExpected,
+		},
+		{
+			name:     "stray paren",
+			query:    "c=R)",
+			offset:   3,
+			length:   1,
+			expected: []string{"end of query"},
+		},
+		{
+			name:   "mismatched quotes",
+			query:  `c=R name="Static Orb`,
+			offset: 9,
+			length: 11,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseQuery(tc.query)
+
+			var queryErr *QueryError
+			require.True(t, errors.As(err, &queryErr), "got error: %v", err)
+
+			assert.Equal(t, tc.query, queryErr.Query)
+			assert.Equal(t, tc.offset, queryErr.Offset)
+			assert.Equal(t, tc.length, queryErr.Length)
+			assert.Equal(t, tc.expected, queryErr.Expected)
+			assert.Equal(t, tc.suggestions, queryErr.Suggestions)
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"name", "name", 0},
+		{"nme", "name", 1},
+		{"nmae", "name", 1},
+		{"cnc", "cmc", 1},
+		{"", "cmc", 3},
+		{"power", "pow", 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a+"/"+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.expected, editDistance(tc.a, tc.b))
+		})
+	}
+}
+
+func TestQueryError_Diagram(t *testing.T) {
+	_, err := ParseQuery("name=foo cnc>3")
+
+	var queryErr *QueryError
+	require.True(t, errors.As(err, &queryErr))
+
+	var noField *ErrNoField
+	assert.True(t, errors.As(err, &noField))
+
+	assert.Equal(t, "name=foo cnc>3\n         ^^^\nno such field: cnc (did you mean \"cmc\"?)", queryErr.Diagram())
+}
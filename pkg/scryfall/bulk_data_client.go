@@ -10,11 +10,27 @@ import (
 )
 
 type BulkDataClient struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
 }
 
 var ErrUnrecognizedBulkDataType = errors.New("unrecognized bulk data type")
 
+// The types of bulk data files that Scryfall exports.
+const (
+	// BulkTypeOracleCards has one card per oracle ID, in the most recent printing.
+	BulkTypeOracleCards = "oracle_cards"
+	// BulkTypeUniqueArtwork has one card for each unique illustration.
+	BulkTypeUniqueArtwork = "unique_artwork"
+	// BulkTypeDefaultCards has every printing, in English, or in another language
+	// if it was never printed in English.
+	BulkTypeDefaultCards = "default_cards"
+	// BulkTypeAllCards has every printing in every language.
+	BulkTypeAllCards = "all_cards"
+	// BulkTypeRulings has the rulings of every card.
+	BulkTypeRulings = "rulings"
+)
+
 func getBulkDataSources(data []BulkDataSource) (*BulkDataSources, error) {
 	var ret BulkDataSources
 
@@ -22,15 +38,15 @@ func getBulkDataSources(data []BulkDataSource) (*BulkDataSources, error) {
 		dataCopy := item
 
 		switch item.Type {
-		case "oracle_cards":
+		case BulkTypeOracleCards:
 			ret.OracleCards = &dataCopy
-		case "unique_artwork":
+		case BulkTypeUniqueArtwork:
 			ret.UniqueArtwork = &dataCopy
-		case "default_cards":
+		case BulkTypeDefaultCards:
 			ret.DefaultCards = &dataCopy
-		case "all_cards":
+		case BulkTypeAllCards:
 			ret.AllCards = &dataCopy
-		case "rulings":
+		case BulkTypeRulings:
 			ret.Rulings = &dataCopy
 		default:
 			return nil, fmt.Errorf("%w: \"%s\"", ErrUnrecognizedBulkDataType, item.Type)
@@ -42,7 +58,7 @@ func getBulkDataSources(data []BulkDataSource) (*BulkDataSources, error) {
 
 // ListSources lists all available bulk data sources.
 func (b *BulkDataClient) ListSources(ctx context.Context) (*BulkDataSources, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/bulk-data", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.baseURL+"/bulk-data", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request to get bulk data list: %w", err)
 	}
@@ -109,6 +125,25 @@ type BulkDataSources struct {
 	Rulings  *BulkDataSource
 }
 
+// ByType returns the source with the given type, such as BulkTypeAllCards, or nil
+// if there isn't one.
+func (b *BulkDataSources) ByType(bulkType string) *BulkDataSource {
+	switch bulkType {
+	case BulkTypeOracleCards:
+		return b.OracleCards
+	case BulkTypeUniqueArtwork:
+		return b.UniqueArtwork
+	case BulkTypeDefaultCards:
+		return b.DefaultCards
+	case BulkTypeAllCards:
+		return b.AllCards
+	case BulkTypeRulings:
+		return b.Rulings
+	default:
+		return nil
+	}
+}
+
 // ErrFirstTokenNotDelim is returned when the first token in the JSON stream is not a delimeter.
 var ErrFirstTokenNotDelim = errors.New("first token is not a delimeter")
 
@@ -77,6 +77,33 @@ func TestBulkData(t *testing.T) {
 	})
 }
 
+func TestBulkDataSources_ByType(t *testing.T) {
+	t.Parallel()
+
+	sources := &scryfall.BulkDataSources{
+		OracleCards:   &scryfall.BulkDataSource{Type: scryfall.BulkTypeOracleCards},
+		UniqueArtwork: &scryfall.BulkDataSource{Type: scryfall.BulkTypeUniqueArtwork},
+		DefaultCards:  &scryfall.BulkDataSource{Type: scryfall.BulkTypeDefaultCards},
+		AllCards:      &scryfall.BulkDataSource{Type: scryfall.BulkTypeAllCards},
+		Rulings:       &scryfall.BulkDataSource{Type: scryfall.BulkTypeRulings},
+	}
+
+	for _, bulkType := range []string{
+		scryfall.BulkTypeOracleCards,
+		scryfall.BulkTypeUniqueArtwork,
+		scryfall.BulkTypeDefaultCards,
+		scryfall.BulkTypeAllCards,
+		scryfall.BulkTypeRulings,
+	} {
+		source := sources.ByType(bulkType)
+		if assert.NotNil(t, source, bulkType) {
+			assert.Equal(t, bulkType, source.Type)
+		}
+	}
+
+	assert.Nil(t, sources.ByType("unknown"))
+}
+
 func TestBulkReader(t *testing.T) {
 	t.Parallel()
 	//nolint:lll
@@ -0,0 +1,381 @@
+package scryfall
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrBulkSizeMismatch is returned when a downloaded bulk data file isn't the size
+// that Scryfall listed for it.
+var ErrBulkSizeMismatch = errors.New("bulk data file is not the expected size")
+
+// gzipMagic are the first bytes of every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ProgressFunc is called while a bulk data file is downloading with the number of
+// bytes received so far, and the total number of bytes, or -1 if it isn't known.
+// Both count the bytes as they are sent, which may be before they are decompressed.
+type ProgressFunc func(received, total int64)
+
+// downloaderOptions holds the settings that can be changed with a DownloaderOption.
+type downloaderOptions struct {
+	httpClient *http.Client
+	userAgent  string
+	progress   ProgressFunc
+	maxRetries int
+}
+
+// DownloaderOption changes a setting of a BulkDownloader created by NewBulkDownloader.
+type DownloaderOption func(*downloaderOptions)
+
+// WithDownloadHTTPClient sets the HTTP client used to download files.  Bulk data files
+// are hundreds of megabytes, so it shouldn't have a short timeout.
+func WithDownloadHTTPClient(client *http.Client) DownloaderOption {
+	return func(o *downloaderOptions) {
+		o.httpClient = client
+	}
+}
+
+// WithDownloadUserAgent sets the User-Agent header sent when downloading files.
+func WithDownloadUserAgent(userAgent string) DownloaderOption {
+	return func(o *downloaderOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithDownloadProgress sets a function to call as files are downloaded.
+func WithDownloadProgress(progress ProgressFunc) DownloaderOption {
+	return func(o *downloaderOptions) {
+		o.progress = progress
+	}
+}
+
+// WithDownloadRetries sets how many times a download is resumed after it fails.
+func WithDownloadRetries(retries int) DownloaderOption {
+	return func(o *downloaderOptions) {
+		o.maxRetries = retries
+	}
+}
+
+// NewBulkDownloader creates a BulkDownloader that caches files in dir.
+func NewBulkDownloader(dir string, opts ...DownloaderOption) *BulkDownloader {
+	defaultMaxRetries := 5
+
+	options := downloaderOptions{
+		httpClient: &http.Client{},
+		userAgent:  DefaultUserAgent,
+		maxRetries: defaultMaxRetries,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &BulkDownloader{dir: dir, options: options}
+}
+
+// BulkDownloader downloads bulk data files into a cache directory, so that each
+// version of a file is only downloaded once.  Files are decompressed as they are
+// cached, and downloads that are interrupted are resumed where they stopped.
+type BulkDownloader struct {
+	dir     string
+	options downloaderOptions
+}
+
+// Path returns the path a bulk data file is cached at, which is unique to the
+// ID of the file and the time it was last updated.
+func (d *BulkDownloader) Path(source *BulkDataSource) string {
+	return filepath.Join(d.dir, fmt.Sprintf("%s-%s.json", source.ID, cacheStamp(source.UpdatedAt)))
+}
+
+// cacheStamp removes everything but letters and digits from an updated_at
+// timestamp, so that it can be used in a file name.
+func cacheStamp(updatedAt string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, updatedAt)
+}
+
+// Open returns the contents of a bulk data file, downloading it first if it isn't cached.
+// The returned file can be read with a BulkReader.
+func (d *BulkDownloader) Open(ctx context.Context, source *BulkDataSource) (io.ReadCloser, error) {
+	path, err := d.Download(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cached %s file: %w", source.Name, err)
+	}
+
+	return fd, nil
+}
+
+// Download downloads a bulk data file into the cache if it isn't already there, and
+// returns its path.  The file is downloaded to a partial file first, which later
+// downloads of the same version resume from if this one is interrupted.  Once the
+// file has been downloaded, any older versions of it are removed from the cache.
+func (d *BulkDownloader) Download(ctx context.Context, source *BulkDataSource) (string, error) {
+	path := d.Path(source)
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create bulk data cache directory: %w", err)
+	}
+
+	partPath := path + ".part"
+
+	if err := d.fetchWithRetries(ctx, source, partPath); err != nil {
+		return "", fmt.Errorf("failed to download %s file: %w", source.Name, err)
+	}
+
+	if err := decompressPart(partPath, path, source.Size); err != nil {
+		return "", err
+	}
+
+	d.removeOldVersions(source, path)
+
+	return path, nil
+}
+
+// downloadStatusError is returned when the server responds to a download
+// with an unexpected status.
+type downloadStatusError struct {
+	statusCode int
+}
+
+func (e *downloadStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.statusCode)
+}
+
+// retryable returns true if the request might succeed if it is tried again.
+func (e *downloadStatusError) retryable() bool {
+	return e.statusCode == http.StatusTooManyRequests || e.statusCode >= http.StatusInternalServerError
+}
+
+// fetchWithRetries downloads a file to partPath, resuming it every time it fails.
+// Retries that follow a failure that made progress start straight away, while the
+// rest back off, doubling each time.
+func (d *BulkDownloader) fetchWithRetries(ctx context.Context, source *BulkDataSource, partPath string) error {
+	backoff := time.Second
+
+	var err error
+
+	for attempt := 0; attempt <= d.options.maxRetries; attempt++ {
+		before := fileSize(partPath)
+
+		err = d.fetch(ctx, source.DownloadURI, partPath)
+		if err == nil {
+			return nil
+		}
+
+		var statusErr *downloadStatusError
+		if ctx.Err() != nil || (errors.As(err, &statusErr) && !statusErr.retryable()) {
+			return err
+		}
+
+		if fileSize(partPath) > before {
+			backoff = time.Second
+			continue
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
+
+// fileSize returns the size of the file at path, or zero if it doesn't exist.
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+
+	return info.Size()
+}
+
+// fetch downloads the file at uri to partPath, continuing from the end of partPath if
+// it already exists.
+func (d *BulkDownloader) fetch(ctx context.Context, uri string, partPath string) error {
+	offset := fileSize(partPath)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", d.options.userAgent)
+
+	// asking for gzip stops net/http from decompressing it, so that ranges are
+	// offsets into the same bytes that are written to the partial file
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := d.options.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	flags := os.O_WRONLY | os.O_CREATE
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// the server doesn't support ranges, so the download starts over
+		offset = 0
+		flags |= os.O_TRUNC
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the partial file already has everything
+		return nil
+	default:
+		return &downloadStatusError{statusCode: resp.StatusCode}
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+	}
+
+	fd, err := os.OpenFile(partPath, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open partial file: %w", err)
+	}
+
+	progress := &progressWriter{received: offset, total: total, progress: d.options.progress}
+
+	_, err = io.Copy(io.MultiWriter(fd, progress), resp.Body)
+	if err != nil {
+		_ = fd.Close()
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return fd.Close()
+}
+
+// progressWriter counts the bytes written to it, calling progress after each write.
+type progressWriter struct {
+	received int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.received += int64(len(b))
+
+	if p.progress != nil {
+		p.progress(p.received, p.total)
+	}
+
+	return len(b), nil
+}
+
+// decompressPart writes the contents of a downloaded partial file to path, decompressing
+// it if it is gzipped, and removes the partial file.  If the contents aren't the expected
+// size, or can't be decompressed, nothing is written and the partial file is removed
+// so that the next download starts over.
+func decompressPart(partPath string, path string, size int64) error {
+	part, err := os.Open(partPath)
+	if err != nil {
+		return fmt.Errorf("failed to open partial file: %w", err)
+	}
+
+	defer part.Close()
+
+	tmpPath := path + ".tmp"
+
+	written, err := copyDecompressed(part, tmpPath)
+	if err == nil && size > 0 && written != size {
+		err = fmt.Errorf("%w: expected %d bytes, got %d", ErrBulkSizeMismatch, size, written)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		_ = os.Remove(partPath)
+
+		return err
+	}
+
+	if err = os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to move downloaded file into the cache: %w", err)
+	}
+
+	_ = os.Remove(partPath)
+
+	return nil
+}
+
+// copyDecompressed copies src to a new file at path, decompressing it if it is gzipped.
+func copyDecompressed(src io.Reader, path string) (int64, error) {
+	buffered := bufio.NewReader(src)
+
+	var reader io.Reader = buffered
+
+	if magic, _ := buffered.Peek(len(gzipMagic)); bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read gzipped file: %w", err)
+		}
+
+		defer gz.Close()
+
+		reader = gz
+	}
+
+	fd, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	written, err := io.Copy(fd, reader)
+	if err != nil {
+		_ = fd.Close()
+		return 0, fmt.Errorf("failed to decompress file: %w", err)
+	}
+
+	return written, fd.Close()
+}
+
+// removeOldVersions removes every other version of a bulk data file from the cache.
+func (d *BulkDownloader) removeOldVersions(source *BulkDataSource, keep string) {
+	matches, err := filepath.Glob(filepath.Join(d.dir, source.ID+"-*"))
+	if err != nil {
+		return
+	}
+
+	for _, match := range matches {
+		if match != keep {
+			_ = os.Remove(match)
+		}
+	}
+}
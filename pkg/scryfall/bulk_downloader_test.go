@@ -0,0 +1,186 @@
+package scryfall_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkFileServer serves a gzipped bulk data file with support for ranges,
+// dropping the connection halfway through the first response.
+type bulkFileServer struct {
+	encoded []byte
+
+	lock   sync.Mutex
+	ranges []string
+}
+
+func (s *bulkFileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.ranges = append(s.ranges, r.Header.Get("Range"))
+	first := len(s.ranges) == 1
+	s.lock.Unlock()
+
+	w.Header().Set("Content-Encoding", "gzip")
+
+	start := 0
+
+	if rangeHeader := r.Header.Get("Range"); rangeHeader != "" {
+		start, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rangeHeader, "bytes="), "-"))
+
+		if start >= len(s.encoded) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(s.encoded)-1, len(s.encoded)))
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.encoded)-start))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.encoded)))
+	}
+
+	body := s.encoded[start:]
+
+	if first {
+		// returning before the whole body is written closes the connection
+		body = body[:len(body)/2]
+	}
+
+	_, _ = w.Write(body)
+}
+
+// requestedRanges returns the Range header of every request, in order.
+func (s *bulkFileServer) requestedRanges() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.ranges...)
+}
+
+func newBulkFileServer(t *testing.T, contents []byte) (*bulkFileServer, *httptest.Server) {
+	t.Helper()
+
+	var encoded bytes.Buffer
+
+	gz := gzip.NewWriter(&encoded)
+	_, err := gz.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	handler := &bulkFileServer{encoded: encoded.Bytes()}
+	server := httptest.NewServer(handler)
+
+	t.Cleanup(server.Close)
+
+	return handler, server
+}
+
+func TestBulkDownloader_Download(t *testing.T) {
+	t.Parallel()
+
+	contents, err := os.ReadFile("test/cards.json")
+	require.NoError(t, err)
+
+	handler, server := newBulkFileServer(t, contents)
+
+	dir := t.TempDir()
+
+	// older versions of the file are removed once the new one is downloaded
+	oldVersion := filepath.Join(dir, "bulk-id-20240101000000.json")
+	require.NoError(t, os.WriteFile(oldVersion, []byte("[]"), 0o644))
+
+	var received, total int64
+
+	downloader := scryfall.NewBulkDownloader(dir, scryfall.WithDownloadProgress(func(r, t int64) {
+		received, total = r, t
+	}))
+
+	source := &scryfall.BulkDataSource{
+		ID:          "bulk-id",
+		Name:        "Default Cards",
+		UpdatedAt:   "2024-08-07T21:04:55.435+00:00",
+		Size:        int64(len(contents)),
+		DownloadURI: server.URL + "/default-cards.json",
+	}
+
+	fd, err := downloader.Open(context.Background(), source)
+	require.NoError(t, err)
+
+	defer fd.Close()
+
+	downloaded, err := io.ReadAll(fd)
+	require.NoError(t, err)
+	assert.Equal(t, contents, downloaded)
+
+	// the first response was cut off, so the second one resumed from where it stopped
+	ranges := handler.requestedRanges()
+	require.Len(t, ranges, 2)
+	assert.Equal(t, "", ranges[0])
+	assert.Equal(t, fmt.Sprintf("bytes=%d-", len(handler.encoded)/2), ranges[1])
+
+	assert.Equal(t, int64(len(handler.encoded)), received)
+	assert.Equal(t, int64(len(handler.encoded)), total)
+
+	assert.Equal(t, filepath.Join(dir, "bulk-id-20240807T2104554350000.json"), downloader.Path(source))
+	assert.NoFileExists(t, oldVersion)
+	assert.NoFileExists(t, downloader.Path(source)+".part")
+
+	// cached files aren't downloaded again
+	_, err = downloader.Download(context.Background(), source)
+	require.NoError(t, err)
+	assert.Len(t, handler.requestedRanges(), 2)
+}
+
+func TestBulkDownloader_SizeMismatch(t *testing.T) {
+	t.Parallel()
+
+	_, server := newBulkFileServer(t, []byte("[]"))
+
+	downloader := scryfall.NewBulkDownloader(t.TempDir())
+
+	source := &scryfall.BulkDataSource{
+		ID:          "bulk-id",
+		Name:        "Rulings",
+		UpdatedAt:   "2024-08-07T21:04:55.435+00:00",
+		Size:        1000,
+		DownloadURI: server.URL + "/rulings.json",
+	}
+
+	_, err := downloader.Download(context.Background(), source)
+	require.ErrorIs(t, err, scryfall.ErrBulkSizeMismatch)
+
+	assert.NoFileExists(t, downloader.Path(source))
+	assert.NoFileExists(t, downloader.Path(source)+".part")
+}
+
+func TestBulkDownloader_NotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	downloader := scryfall.NewBulkDownloader(t.TempDir())
+
+	_, err := downloader.Download(context.Background(), &scryfall.BulkDataSource{
+		ID:          "bulk-id",
+		Name:        "Rulings",
+		DownloadURI: server.URL + "/rulings.json",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
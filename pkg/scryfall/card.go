@@ -1,8 +1,13 @@
 package scryfall
 
+import "sort"
+
 // Card encapsulates all of the data returned by the Scryfall API for a given card.
 // This is used for both live API queries as well as for Scryfall's bulk data dumps.
 type Card struct {
+	// The type of the object, always ObjectCard.
+	Object Object `json:"object"`
+
 	// Scryfall's ID unique ID for the card.
 	ID string `json:"id"`
 
@@ -47,13 +52,53 @@ type Card struct {
 	CollectorNumber string `json:"collector_number"`
 	URI             string `json:"uri"`
 	ScryfallURI     string `json:"scryfall_uri"`
-	Layout          string `json:"layout"`
+	Layout          Layout `json:"layout"`
 	ManaCost        string `json:"mana_cost"`
 	TypeLine        string `json:"type_line"`
 	OracleText      string `json:"oracle_text"`
 	Power           string `json:"power"`
 	Toughness       string `json:"toughness"`
 	Loyalty         string `json:"loyalty"`
+	Defense         string `json:"defense"`
+
+	// The faces of a card with more than one face, such as split,
+	// flip, transform or modal double-faced cards, in the order they
+	// are printed.  It's empty for normal cards.
+	//
+	// For double-faced cards, fields like ImageURIs, ManaCost and
+	// OracleText are only set on the faces, not on the card itself.
+	CardFaces []CardFace `json:"card_faces"`
+
+	// The localized name, text and type line printed on the card,
+	// for cards that aren't printed in English.
+	PrintedName     string `json:"printed_name"`
+	PrintedText     string `json:"printed_text"`
+	PrintedTypeLine string `json:"printed_type_line"`
+
+	// The name of the card in the Universes Within version of a
+	// Universes Beyond card, such as "Tamiyo's Safekeeping".
+	FlavorName string `json:"flavor_name"`
+
+	// The watermark printed on the card, such as a guild symbol.
+	Watermark string `json:"watermark"`
+
+	// The hand and life modifiers of Vanguard cards, such as "+1" or "-3".
+	HandModifier string `json:"hand_modifier"`
+	LifeModifier string `json:"life_modifier"`
+
+	SecurityStamp SecurityStamp `json:"security_stamp,omitempty"`
+	ImageStatus   ImageStatus   `json:"image_status,omitempty"`
+	FrameEffects  []FrameEffect `json:"frame_effects"`
+
+	// The kinds of promo the card is, such as "prerelease" or "setpromo".
+	// Scryfall adds new promo types often, so these are left as strings.
+	PromoTypes []string `json:"promo_types"`
+
+	// Where the card was previewed, for cards that were previewed before release.
+	Preview *Preview `json:"preview"`
+
+	// The ID of the card this is a variation of, if Variation is true.
+	VariationOf string `json:"variation_of"`
 
 	HighResImage   bool `json:"highres_image"`
 	HighResScan    bool `json:"highres_scan"`
@@ -69,6 +114,7 @@ type Card struct {
 	Textless       bool `json:"textless"`
 	Booster        bool `json:"booster"`
 	StorySpotlight bool `json:"story_spotlight"`
+	ContentWarning bool `json:"content_warning"`
 
 	// The converted mana cost of the card.  This is only a float because of
 	// Un-set cards that have silly mana costs including half a mana.
@@ -86,22 +132,39 @@ type Card struct {
 	// The CardMarket ID for the card.
 	CardmarketID int `json:"cardmarket_id"`
 
+	// The TCGPlayer ID for the card's etched version, if it has one.
+	TCGPlayerEtchedID int `json:"tcgplayer_etched_id"`
+
+	// The MTG: Arena ID for the card.
+	ArenaID int `json:"arena_id"`
+
 	// The popularity of the card as measured by EDHREC.
-	EDHRecRank    int          `json:"edhrec_rank"`
-	PennyRank     int          `json:"penny_rank"`
-	MultiverseIDs []int        `json:"multiverse_ids"`
-	Colors        []string     `json:"colors"`
-	ColorIdentity []string     `json:"color_identity"`
-	Keywords      []string     `json:"keywords"`
-	Games         []string     `json:"games"`
-	Finishes      []string     `json:"finishes"`
-	ArtistIDs     []string     `json:"artist_ids"`
-	ReleasedAt    Date         `json:"released_at"`
-	ImageURIs     ImageURIs    `json:"image_uris"`
-	RelatedURIs   RelatedURIs  `json:"related_uris"`
-	Prices        Prices       `json:"prices"`
-	Legality      CardLegality `json:"legalities"`
-	AllParts      []Part       `json:"all_parts"`
+	EDHRecRank    int      `json:"edhrec_rank"`
+	PennyRank     int      `json:"penny_rank"`
+	MultiverseIDs []int    `json:"multiverse_ids"`
+	Colors        []string `json:"colors"`
+	ColorIdentity []string `json:"color_identity"`
+
+	// The colors of the dot on the type line of cards that have
+	// no mana cost, like the back face of a transforming card.
+	ColorIndicator []string `json:"color_indicator"`
+
+	// The colors of mana the card can produce, e.g. ["G"] for Llanowar Elves.
+	ProducedMana []string `json:"produced_mana"`
+
+	// The lights lit on an Attraction card, from 1 to 6.
+	AttractionLights []int `json:"attraction_lights"`
+
+	Keywords    []string     `json:"keywords"`
+	Games       []string     `json:"games"`
+	Finishes    []string     `json:"finishes"`
+	ArtistIDs   []string     `json:"artist_ids"`
+	ReleasedAt  Date         `json:"released_at"`
+	ImageURIs   ImageURIs    `json:"image_uris"`
+	RelatedURIs RelatedURIs  `json:"related_uris"`
+	Prices      Prices       `json:"prices"`
+	Legality    CardLegality `json:"legalities"`
+	AllParts    []Part       `json:"all_parts"`
 }
 
 type CardLegality struct {
@@ -128,6 +191,106 @@ type CardLegality struct {
 	PrEDH           Legality `json:"predh"`
 }
 
+// ByFormat returns the card's legalities keyed by the format names
+// Scryfall uses, such as "commander" or "paupercommander".
+func (c CardLegality) ByFormat() map[string]Legality {
+	return map[string]Legality{
+		"standard":        c.Standard,
+		"future":          c.Future,
+		"historic":        c.Historic,
+		"gladiator":       c.Gladiator,
+		"pioneer":         c.Pioneer,
+		"explorer":        c.Explorer,
+		"modern":          c.Modern,
+		"legacy":          c.Legacy,
+		"pauper":          c.Pauper,
+		"vintage":         c.Vintage,
+		"penny":           c.Penny,
+		"commander":       c.Commander,
+		"oathbreaker":     c.Oathbreaker,
+		"brawl":           c.Brawl,
+		"historicbrawl":   c.HistoricBrawl,
+		"alchemy":         c.Alchemy,
+		"paupercommander": c.PauperCommander,
+		"duel":            c.Duel,
+		"oldschool":       c.OldSchool,
+		"premodern":       c.PreModern,
+		"predh":           c.PrEDH,
+	}
+}
+
+// AllFormats returns the names of all of the formats in CardLegality, sorted alphabetically.
+func AllFormats() []string {
+	formats := []string{}
+
+	for format := range (CardLegality{}).ByFormat() {
+		formats = append(formats, format)
+	}
+
+	sort.Strings(formats)
+
+	return formats
+}
+
+// CardFace is a single face of a card with multiple faces, found in Card.CardFaces.
+//
+// https://scryfall.com/docs/api/cards#card-face-objects
+type CardFace struct {
+	// The type of the object, always ObjectCardFace.
+	Object Object `json:"object"`
+
+	// The name of this face, e.g. "Fire" for Fire // Ice.
+	Name string `json:"name"`
+
+	// The mana cost of this face.  It's empty for faces without one,
+	// such as the back of a transforming card.
+	ManaCost string `json:"mana_cost"`
+
+	TypeLine   string `json:"type_line"`
+	OracleText string `json:"oracle_text"`
+	FlavorText string `json:"flavor_text"`
+	FlavorName string `json:"flavor_name"`
+	Power      string `json:"power"`
+	Toughness  string `json:"toughness"`
+	Loyalty    string `json:"loyalty"`
+	Defense    string `json:"defense"`
+	Watermark  string `json:"watermark"`
+
+	// The layout of this face, only set for reversible cards.
+	Layout Layout `json:"layout,omitempty"`
+
+	// The mana value of this face, only set for reversible cards.
+	CMC *float32 `json:"cmc"`
+
+	// The Oracle ID of this face, only set for reversible cards,
+	// where each face is a different card.
+	OracleID string `json:"oracle_id"`
+
+	Colors         []string `json:"colors"`
+	ColorIndicator []string `json:"color_indicator"`
+
+	Artist         string `json:"artist"`
+	ArtistID       string `json:"artist_id"`
+	IllustrationID string `json:"illustration_id"`
+
+	// The images of this face.  They're only set for cards where each
+	// face has its own image, such as transforming cards.  Split cards
+	// use the ImageURIs of the Card instead.
+	ImageURIs *ImageURIs `json:"image_uris"`
+
+	// The localized name, text and type line printed on this face.
+	PrintedName     string `json:"printed_name"`
+	PrintedText     string `json:"printed_text"`
+	PrintedTypeLine string `json:"printed_type_line"`
+}
+
+// Preview describes where and when a card was first previewed.
+type Preview struct {
+	Source      string `json:"source"`
+	SourceURI   string `json:"source_uri"`
+	PreviewedAt Date   `json:"previewed_at"`
+}
+
 type ImageURIs struct {
 	Small      string `json:"small"`
 	Normal     string `json:"normal"`
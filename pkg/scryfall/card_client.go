@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 )
 
 type CardClient struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
 }
 
 // Named searches for a card by its name.
@@ -18,7 +20,7 @@ type CardClient struct {
 func (c *CardClient) Named(ctx context.Context, cardName string) (*Card, error) {
 	var card Card
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/named", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/cards/named", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -84,10 +86,15 @@ type CardSearchOptions struct {
 	Direction         string
 	IncludeExtras     bool
 	IncludeVariations bool
+
+	// PageSize is the number of cards on each page.  Scryfall always
+	// returns pages of 175 cards, so this is only used by a self-hosted
+	// "stax api".
+	PageSize int
 }
 
 func (c *CardClient) Search(ctx context.Context, query string, opts CardSearchOptions) (*CardSearchPager, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/search", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/cards/search", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -103,6 +110,9 @@ func (c *CardClient) Search(ctx context.Context, query string, opts CardSearchOp
 	if opts.Direction != "" {
 		q.Add("dir", opts.Direction)
 	}
+	if opts.PageSize != 0 {
+		q.Add("page_size", strconv.Itoa(opts.PageSize))
+	}
 
 	req.URL.RawQuery = q.Encode()
 
@@ -119,7 +129,7 @@ type autocompleteResponse struct {
 }
 
 func (c *CardClient) Autocomplete(ctx context.Context, query string) ([]string, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/autocomplete", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/cards/autocomplete", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -146,7 +156,7 @@ type RandomCardOptions struct {
 }
 
 func (c *CardClient) Random(ctx context.Context, opts RandomCardOptions) (*Card, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/random", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/cards/random", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -181,38 +191,83 @@ func (c *CardClient) Random(ctx context.Context, opts RandomCardOptions) (*Card,
 	return &card, nil
 }
 
+// CardIdentifier identifies a single card for CardClient.Collection.
+// Only set the fields for one of the combinations Scryfall supports,
+// such as ID, Name, or Set and CollectorNumber.
+//
+// https://scryfall.com/docs/api/cards/collection#card-identifiers
 type CardIdentifier struct {
-	ID              string `json:"id"`
-	MTGOID          int    `json:"mtgo_id"`
-	MultiverseID    int    `json:"multiverse_id"`
-	OracleID        string `json:"oracle_id"`
-	IllustrationID  string `json:"illustration_id"`
-	Name            string `json:"name"`
-	Set             string `json:"set"`
-	CollectorNumber string `json:"collector_number"`
+	ID              string `json:"id,omitempty"`
+	MTGOID          int    `json:"mtgo_id,omitempty"`
+	MultiverseID    int    `json:"multiverse_id,omitempty"`
+	OracleID        string `json:"oracle_id,omitempty"`
+	IllustrationID  string `json:"illustration_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Set             string `json:"set,omitempty"`
+	CollectorNumber string `json:"collector_number,omitempty"`
 }
 
+// MaxCollectionIdentifiers is the most identifiers Scryfall accepts in
+// a single request to /cards/collection.  Collection splits larger lists
+// into batches of this size.
+const MaxCollectionIdentifiers = 75
+
 type collectionRequest struct {
 	Identifiers []CardIdentifier `json:"identifiers"`
 }
 
-func (c *CardClient) Collection(ctx context.Context, identifiers []CardIdentifier) ([]Card, error) {
+type collectionResponse struct {
+	Data     []Card           `json:"data"`
+	NotFound []CardIdentifier `json:"not_found"`
+}
+
+// Collection fetches the cards for a list of identifiers.  Lists longer than
+// MaxCollectionIdentifiers are split into multiple requests, and the results
+// are merged in the same order as the identifiers.
+//
+// Identifiers that didn't match any card are returned separately, rather than
+// as an error.
+//
+// https://scryfall.com/docs/api/cards/collection
+func (c *CardClient) Collection(ctx context.Context, identifiers []CardIdentifier) ([]Card, []CardIdentifier, error) {
+	cards := []Card{}
+	notFound := []CardIdentifier{}
+
+	for start := 0; start < len(identifiers); start += MaxCollectionIdentifiers {
+		end := min(start+MaxCollectionIdentifiers, len(identifiers))
+
+		resp, err := c.collectionBatch(ctx, identifiers[start:end])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch identifiers %d to %d: %w", start, end, err)
+		}
+
+		cards = append(cards, resp.Data...)
+		notFound = append(notFound, resp.NotFound...)
+	}
+
+	return cards, notFound, nil
+}
+
+// collectionBatch fetches a single batch of at most MaxCollectionIdentifiers identifiers.
+func (c *CardClient) collectionBatch(ctx context.Context, identifiers []CardIdentifier) (*collectionResponse, error) {
 	marshalled, err := json.Marshal(collectionRequest{Identifiers: identifiers})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal identifiers: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/random", bytes.NewBuffer(marshalled))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/cards/collection", bytes.NewBuffer(marshalled))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
-	var list List[Card]
+	req.Header.Set("Content-Type", "application/json")
 
-	err = doRequest(c.client, req, &list)
+	var resp collectionResponse
+
+	err = doRequest(c.client, req, &resp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform HTTP request: %w", err)
 	}
 
-	return list.Data, nil
+	return &resp, nil
 }
@@ -2,6 +2,10 @@ package scryfall_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/SethCurry/stax/pkg/scryfall"
@@ -45,3 +49,70 @@ func Test_Client_Card_Autocomplete(t *testing.T) {
 
 	assert.Len(t, autocomplete, 1)
 }
+
+func Test_Client_Card_Collection(t *testing.T) {
+	t.Parallel()
+
+	requestSizes := []int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cards/collection", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body struct {
+			Identifiers []scryfall.CardIdentifier `json:"identifiers"`
+		}
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		requestSizes = append(requestSizes, len(body.Identifiers))
+
+		resp := struct {
+			Data     []map[string]string       `json:"data"`
+			NotFound []scryfall.CardIdentifier `json:"not_found"`
+		}{
+			Data:     []map[string]string{},
+			NotFound: []scryfall.CardIdentifier{},
+		}
+
+		// pretend every card with an odd number doesn't exist
+		for _, identifier := range body.Identifiers {
+			var number int
+			_, err := fmt.Sscanf(identifier.Name, "Card %d", &number)
+			require.NoError(t, err)
+
+			if number%2 == 1 {
+				resp.NotFound = append(resp.NotFound, identifier)
+			} else {
+				resp.Data = append(resp.Data, map[string]string{"object": "card", "name": identifier.Name})
+			}
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer server.Close()
+
+	identifiers := []scryfall.CardIdentifier{}
+
+	for i := 0; i < 160; i++ {
+		identifiers = append(identifiers, scryfall.CardIdentifier{Name: fmt.Sprintf("Card %d", i)})
+	}
+
+	client := scryfall.NewClient(nil, scryfall.WithBaseURL(server.URL))
+
+	cards, notFound, err := client.Card.Collection(context.Background(), identifiers)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{75, 75, 10}, requestSizes)
+	require.Len(t, cards, 80)
+	require.Len(t, notFound, 80)
+
+	for i, card := range cards {
+		assert.Equal(t, fmt.Sprintf("Card %d", i*2), card.Name)
+	}
+
+	for i, identifier := range notFound {
+		assert.Equal(t, fmt.Sprintf("Card %d", i*2+1), identifier.Name)
+	}
+}
@@ -0,0 +1,124 @@
+package scryfall_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadTestCards unmarshals a JSON array of cards from the test directory.
+func loadTestCards(t *testing.T, path string) []scryfall.Card {
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var cards []scryfall.Card
+
+	require.NoError(t, json.Unmarshal(contents, &cards))
+
+	return cards
+}
+
+func TestCard_CardFaces(t *testing.T) {
+	t.Parallel()
+
+	cards := loadTestCards(t, "test/card_faces.json")
+	require.Len(t, cards, 2)
+
+	delver := cards[0]
+
+	assert.Equal(t, scryfall.ObjectCard, delver.Object)
+	assert.Equal(t, scryfall.LayoutTransform, delver.Layout)
+	assert.Equal(t, []scryfall.FrameEffect{scryfall.FrameEffectSunMoonDFC}, delver.FrameEffects)
+	assert.Equal(t, scryfall.SecurityStampOval, delver.SecurityStamp)
+	assert.Equal(t, 68526, delver.ArenaID)
+	assert.Empty(t, delver.ManaCost)
+	require.Len(t, delver.CardFaces, 2)
+
+	front, back := delver.CardFaces[0], delver.CardFaces[1]
+
+	assert.Equal(t, scryfall.ObjectCardFace, front.Object)
+	assert.Equal(t, "Delver of Secrets", front.Name)
+	assert.Equal(t, "{U}", front.ManaCost)
+	assert.Nil(t, front.ColorIndicator)
+	require.NotNil(t, front.ImageURIs)
+	assert.Contains(t, front.ImageURIs.Normal, "/front/")
+
+	assert.Equal(t, "Insectile Aberration", back.Name)
+	assert.Equal(t, "Flying", back.OracleText)
+	assert.Equal(t, []string{"U"}, back.ColorIndicator)
+	assert.Equal(t, "3", back.Power)
+	require.NotNil(t, back.ImageURIs)
+	assert.Contains(t, back.ImageURIs.Normal, "/back/")
+
+	elves := cards[1]
+
+	assert.Equal(t, scryfall.LayoutNormal, elves.Layout)
+	assert.Empty(t, elves.CardFaces)
+	assert.Equal(t, []string{"G"}, elves.ProducedMana)
+	assert.Equal(t, []string{"prerelease"}, elves.PromoTypes)
+	assert.Equal(t, scryfall.ImageStatusLowRes, elves.ImageStatus)
+	assert.Equal(t, "set", elves.Watermark)
+	require.NotNil(t, elves.Preview)
+	assert.Equal(t, "Wizards of the Coast", elves.Preview.Source)
+	assert.Equal(t, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC), time.Time(elves.Preview.PreviewedAt))
+}
+
+func TestCard_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, path := range []string{"test/cards.json", "test/card_faces.json"} {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			cards := loadTestCards(t, path)
+
+			marshalled, err := json.Marshal(cards)
+			require.NoError(t, err)
+
+			var roundTripped []scryfall.Card
+
+			require.NoError(t, json.Unmarshal(marshalled, &roundTripped))
+
+			assert.Equal(t, cards, roundTripped)
+		})
+	}
+}
+
+func TestLayout_UnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var layout scryfall.Layout
+
+	require.NoError(t, layout.UnmarshalText([]byte("modal_dfc")))
+	assert.Equal(t, scryfall.LayoutModalDFC, layout)
+
+	require.NoError(t, layout.UnmarshalText([]byte("sideways")))
+	assert.Equal(t, scryfall.Layout("sideways"), layout)
+}
+
+func TestCard_UnknownEnumValues(t *testing.T) {
+	t.Parallel()
+
+	raw := `{
+		"name": "Future Card",
+		"layout": "sideways",
+		"frame_effects": ["legendary", "holographic"],
+		"security_stamp": "hexagon",
+		"image_status": "ultra_res"
+	}`
+
+	var card scryfall.Card
+
+	require.NoError(t, json.Unmarshal([]byte(raw), &card))
+
+	assert.Equal(t, "Future Card", card.Name)
+	assert.Equal(t, scryfall.Layout("sideways"), card.Layout)
+	assert.Equal(t, []scryfall.FrameEffect{scryfall.FrameEffectLegendary, "holographic"}, card.FrameEffects)
+	assert.Equal(t, scryfall.SecurityStamp("hexagon"), card.SecurityStamp)
+	assert.Equal(t, scryfall.ImageStatus("ultra_res"), card.ImageStatus)
+}
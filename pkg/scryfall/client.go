@@ -8,13 +8,56 @@ import (
 	"time"
 )
 
+// DefaultBaseURL is the base URL of Scryfall's API, used unless
+// WithBaseURL is passed to NewClient.
+const DefaultBaseURL = "https://api.scryfall.com"
+
+// DefaultUserAgent is the User-Agent sent with every request, unless
+// WithUserAgent is passed to NewClient.  Scryfall asks that every
+// client identify itself.
+const DefaultUserAgent = "stax"
+
+// clientOptions holds the settings that can be changed with a ClientOption.
+type clientOptions struct {
+	baseURL   string
+	userAgent string
+}
+
+// ClientOption changes a setting of a Client created by NewClient.
+type ClientOption func(*clientOptions)
+
+// WithBaseURL makes the Client send its requests to baseURL instead
+// of Scryfall, such as a self-hosted "stax api", a mirror, or an
+// httptest.Server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) {
+		o.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
 // NewClient creates a new Client.
-func NewClient(startingClient *http.Client) *Client {
+func NewClient(startingClient *http.Client, opts ...ClientOption) *Client {
 	defaultMaxRetries := 5
 	defaultMaxRequests := 10
 	defaultWindow := time.Second
 	defaultTimeoutSeconds := 30
 
+	options := clientOptions{
+		baseURL:   DefaultBaseURL,
+		userAgent: DefaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	if startingClient == nil {
 		startingClient = &http.Client{
 			Timeout:       time.Second * time.Duration(defaultTimeoutSeconds),
@@ -34,6 +77,7 @@ func NewClient(startingClient *http.Client) *Client {
 			maxRetries: defaultMaxRetries,
 			limiter:    newRateLimiter(defaultWindow, defaultMaxRequests),
 			inner:      transport,
+			userAgent:  options.userAgent,
 		},
 		CheckRedirect: startingClient.CheckRedirect,
 		Jar:           startingClient.Jar,
@@ -41,9 +85,10 @@ func NewClient(startingClient *http.Client) *Client {
 	}
 
 	return &Client{
-		Card:     &CardClient{client: httpClient},
-		BulkData: &BulkDataClient{client: httpClient},
-		Rulings:  &RulingClient{client: httpClient},
+		Card:     &CardClient{client: httpClient, baseURL: options.baseURL},
+		BulkData: &BulkDataClient{client: httpClient, baseURL: options.baseURL},
+		Rulings:  &RulingClient{client: httpClient, baseURL: options.baseURL},
+		Set:      &SetClient{client: httpClient, baseURL: options.baseURL},
 	}
 }
 
@@ -52,6 +97,7 @@ type Client struct {
 	Card     *CardClient
 	BulkData *BulkDataClient
 	Rulings  *RulingClient
+	Set      *SetClient
 }
 
 // APIError is a representation of the error format returned by the Scryfall API.
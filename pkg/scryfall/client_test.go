@@ -0,0 +1,57 @@
+package scryfall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Options(t *testing.T) {
+	t.Parallel()
+
+	var gotPath string
+	var gotUserAgent string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotUserAgent = r.UserAgent()
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object": "card", "name": "Black Lotus"}`))
+	}))
+	defer server.Close()
+
+	client := scryfall.NewClient(nil, scryfall.WithBaseURL(server.URL+"/"), scryfall.WithUserAgent("stax-test/1.0"))
+
+	card, err := client.Card.Named(context.Background(), "Black Lotus")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Black Lotus", card.Name)
+	assert.Equal(t, "/cards/named", gotPath)
+	assert.Equal(t, "stax-test/1.0", gotUserAgent)
+}
+
+func TestClient_DefaultUserAgent(t *testing.T) {
+	t.Parallel()
+
+	var gotUserAgent string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.UserAgent()
+
+		_, _ = w.Write([]byte(`{"object": "list", "data": []}`))
+	}))
+	defer server.Close()
+
+	client := scryfall.NewClient(nil, scryfall.WithBaseURL(server.URL))
+
+	_, err := client.Rulings.ByScryfallID(context.Background(), "some-id")
+	require.NoError(t, err)
+
+	assert.Equal(t, scryfall.DefaultUserAgent, gotUserAgent)
+}
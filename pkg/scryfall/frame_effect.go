@@ -0,0 +1,117 @@
+package scryfall
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FrameEffect is an enum representing a visual effect on a card's frame,
+// such as the crown on legendary cards or a showcase frame.
+//
+// https://scryfall.com/docs/api/frames#frame-effects
+// See AllFrameEffects() for all known values.
+type FrameEffect string
+
+// String returns the frame effect as a string.
+func (f FrameEffect) String() string {
+	return string(f)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Values that aren't in AllFrameEffects() are kept as-is rather than rejected,
+// so a newly added frame effect doesn't stop the rest of a card from decoding.
+func (f *FrameEffect) UnmarshalText(txt []byte) error {
+	*f = FrameEffect(string(txt))
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (f *FrameEffect) UnmarshalJSON(txt []byte) error {
+	var unmarshed string
+
+	err := json.Unmarshal(txt, &unmarshed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal frame effect: %w", err)
+	}
+
+	return f.UnmarshalText([]byte(unmarshed))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (f FrameEffect) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f FrameEffect) MarshalJSON() ([]byte, error) {
+	marshalled, err := json.Marshal(f.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal frame effect: %w", err)
+	}
+
+	return marshalled, nil
+}
+
+const (
+	FrameEffectLegendary              = FrameEffect("legendary")
+	FrameEffectMiracle                = FrameEffect("miracle")
+	FrameEffectEnchantment            = FrameEffect("enchantment")
+	FrameEffectDraft                  = FrameEffect("draft")
+	FrameEffectDevoid                 = FrameEffect("devoid")
+	FrameEffectTombstone              = FrameEffect("tombstone")
+	FrameEffectColorshifted           = FrameEffect("colorshifted")
+	FrameEffectInverted               = FrameEffect("inverted")
+	FrameEffectSunMoonDFC             = FrameEffect("sunmoondfc")
+	FrameEffectCompassLandDFC         = FrameEffect("compasslanddfc")
+	FrameEffectOriginPWDFC            = FrameEffect("originpwdfc")
+	FrameEffectMoonEldraziDFC         = FrameEffect("mooneldrazidfc")
+	FrameEffectWaxingAndWaningMoonDFC = FrameEffect("waxingandwaningmoondfc")
+	FrameEffectShowcase               = FrameEffect("showcase")
+	FrameEffectExtendedArt            = FrameEffect("extendedart")
+	FrameEffectCompanion              = FrameEffect("companion")
+	FrameEffectEtched                 = FrameEffect("etched")
+	FrameEffectSnow                   = FrameEffect("snow")
+	FrameEffectLesson                 = FrameEffect("lesson")
+	FrameEffectShatteredGlass         = FrameEffect("shatteredglass")
+	FrameEffectConvertDFC             = FrameEffect("convertdfc")
+	FrameEffectFanDFC                 = FrameEffect("fandfc")
+	FrameEffectUpsideDownDFC          = FrameEffect("upsidedowndfc")
+	FrameEffectSpree                  = FrameEffect("spree")
+	FrameEffectFullArt                = FrameEffect("fullart")
+	FrameEffectNyxtouched             = FrameEffect("nyxtouched")
+	FrameEffectStamped                = FrameEffect("stamped")
+)
+
+// AllFrameEffects returns all possible values of FrameEffect.
+func AllFrameEffects() []FrameEffect {
+	return []FrameEffect{
+		FrameEffectLegendary,
+		FrameEffectMiracle,
+		FrameEffectEnchantment,
+		FrameEffectDraft,
+		FrameEffectDevoid,
+		FrameEffectTombstone,
+		FrameEffectColorshifted,
+		FrameEffectInverted,
+		FrameEffectSunMoonDFC,
+		FrameEffectCompassLandDFC,
+		FrameEffectOriginPWDFC,
+		FrameEffectMoonEldraziDFC,
+		FrameEffectWaxingAndWaningMoonDFC,
+		FrameEffectShowcase,
+		FrameEffectExtendedArt,
+		FrameEffectCompanion,
+		FrameEffectEtched,
+		FrameEffectSnow,
+		FrameEffectLesson,
+		FrameEffectShatteredGlass,
+		FrameEffectConvertDFC,
+		FrameEffectFanDFC,
+		FrameEffectUpsideDownDFC,
+		FrameEffectSpree,
+		FrameEffectFullArt,
+		FrameEffectNyxtouched,
+		FrameEffectStamped,
+	}
+}
@@ -0,0 +1,76 @@
+package scryfall
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ImageStatus is an enum representing the quality of the images
+// Scryfall has for a card.
+// See AllImageStatuses() for all known values.
+type ImageStatus string
+
+// String returns the image status as a string.
+func (i ImageStatus) String() string {
+	return string(i)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Values that aren't in AllImageStatuses() are kept as-is rather than rejected,
+// so a newly added image status doesn't stop the rest of a card from decoding.
+func (i *ImageStatus) UnmarshalText(txt []byte) error {
+	*i = ImageStatus(string(txt))
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *ImageStatus) UnmarshalJSON(txt []byte) error {
+	var unmarshed string
+
+	err := json.Unmarshal(txt, &unmarshed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal image status: %w", err)
+	}
+
+	return i.UnmarshalText([]byte(unmarshed))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (i ImageStatus) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (i ImageStatus) MarshalJSON() ([]byte, error) {
+	marshalled, err := json.Marshal(i.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal image status: %w", err)
+	}
+
+	return marshalled, nil
+}
+
+const (
+	// ImageStatusMissing means the card has no image, or the image is being processed.
+	ImageStatusMissing = ImageStatus("missing")
+
+	// ImageStatusPlaceholder means the card has a placeholder image.
+	ImageStatusPlaceholder = ImageStatus("placeholder")
+
+	// ImageStatusLowRes means the card has a low resolution image.
+	ImageStatusLowRes = ImageStatus("lowres")
+
+	// ImageStatusHighResScan means the card has a high resolution scan.
+	ImageStatusHighResScan = ImageStatus("highres_scan")
+)
+
+// AllImageStatuses returns all possible values of ImageStatus.
+func AllImageStatuses() []ImageStatus {
+	return []ImageStatus{
+		ImageStatusMissing,
+		ImageStatusPlaceholder,
+		ImageStatusLowRes,
+		ImageStatusHighResScan,
+	}
+}
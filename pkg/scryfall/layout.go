@@ -0,0 +1,159 @@
+package scryfall
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Layout is an enum representing how a card is laid out, such as
+// split cards or double-faced cards.  Cards with more than one face
+// have them in Card.CardFaces.
+//
+// https://scryfall.com/docs/api/layouts
+// See AllLayouts() for all known values.
+type Layout string
+
+// String returns the layout as a string.
+func (l Layout) String() string {
+	return string(l)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Values that aren't in AllLayouts() are kept as-is rather than rejected,
+// so a newly added layout doesn't stop the rest of a card from decoding.
+func (l *Layout) UnmarshalText(txt []byte) error {
+	*l = Layout(string(txt))
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *Layout) UnmarshalJSON(txt []byte) error {
+	var unmarshed string
+
+	err := json.Unmarshal(txt, &unmarshed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal layout: %w", err)
+	}
+
+	return l.UnmarshalText([]byte(unmarshed))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (l Layout) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l Layout) MarshalJSON() ([]byte, error) {
+	marshalled, err := json.Marshal(l.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal layout: %w", err)
+	}
+
+	return marshalled, nil
+}
+
+const (
+	// LayoutNormal is a standard single-faced card.
+	LayoutNormal = Layout("normal")
+
+	// LayoutSplit is a split-faced card, like Fire // Ice.
+	LayoutSplit = Layout("split")
+
+	// LayoutFlip is a card that flips upside down, like the Kamigawa flip cards.
+	LayoutFlip = Layout("flip")
+
+	// LayoutTransform is a double-faced card that transforms.
+	LayoutTransform = Layout("transform")
+
+	// LayoutModalDFC is a double-faced card that can be cast from either side.
+	LayoutModalDFC = Layout("modal_dfc")
+
+	// LayoutMeld is a card with meld parts printed on the back.
+	LayoutMeld = Layout("meld")
+
+	// LayoutLeveler is a card with level up.
+	LayoutLeveler = Layout("leveler")
+
+	// LayoutClass is a class-type enchantment.
+	LayoutClass = Layout("class")
+
+	// LayoutCase is a case-type enchantment.
+	LayoutCase = Layout("case")
+
+	// LayoutSaga is a saga card.
+	LayoutSaga = Layout("saga")
+
+	// LayoutAdventure is a card with an adventure spell part.
+	LayoutAdventure = Layout("adventure")
+
+	// LayoutMutate is a card with mutate.
+	LayoutMutate = Layout("mutate")
+
+	// LayoutPrototype is a card with prototype.
+	LayoutPrototype = Layout("prototype")
+
+	// LayoutBattle is a battle card.
+	LayoutBattle = Layout("battle")
+
+	// LayoutPlanar is a plane or phenomenon card.
+	LayoutPlanar = Layout("planar")
+
+	// LayoutScheme is an Archenemy scheme card.
+	LayoutScheme = Layout("scheme")
+
+	// LayoutVanguard is a Vanguard card.
+	LayoutVanguard = Layout("vanguard")
+
+	// LayoutToken is a token card.
+	LayoutToken = Layout("token")
+
+	// LayoutDoubleFacedToken is a token with another token printed on the back.
+	LayoutDoubleFacedToken = Layout("double_faced_token")
+
+	// LayoutEmblem is an emblem.
+	LayoutEmblem = Layout("emblem")
+
+	// LayoutAugment is a card with augment.
+	LayoutAugment = Layout("augment")
+
+	// LayoutHost is a host-type card.
+	LayoutHost = Layout("host")
+
+	// LayoutArtSeries is an art series collectable double-faced card.
+	LayoutArtSeries = Layout("art_series")
+
+	// LayoutReversibleCard is a card with two sides that are unrelated.
+	LayoutReversibleCard = Layout("reversible_card")
+)
+
+// AllLayouts returns all possible values of Layout.
+func AllLayouts() []Layout {
+	return []Layout{
+		LayoutNormal,
+		LayoutSplit,
+		LayoutFlip,
+		LayoutTransform,
+		LayoutModalDFC,
+		LayoutMeld,
+		LayoutLeveler,
+		LayoutClass,
+		LayoutCase,
+		LayoutSaga,
+		LayoutAdventure,
+		LayoutMutate,
+		LayoutPrototype,
+		LayoutBattle,
+		LayoutPlanar,
+		LayoutScheme,
+		LayoutVanguard,
+		LayoutToken,
+		LayoutDoubleFacedToken,
+		LayoutEmblem,
+		LayoutAugment,
+		LayoutHost,
+		LayoutArtSeries,
+		LayoutReversibleCard,
+	}
+}
@@ -97,3 +97,29 @@ func Test_Legality_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_CardLegality_ByFormat(t *testing.T) {
+	legalities := scryfall.CardLegality{
+		Vintage:         scryfall.LegalityRestricted,
+		Commander:       scryfall.LegalityBanned,
+		PauperCommander: scryfall.LegalityLegal,
+	}
+
+	byFormat := legalities.ByFormat()
+
+	if len(byFormat) != len(scryfall.AllFormats()) {
+		t.Errorf("expected %d formats, got %d", len(scryfall.AllFormats()), len(byFormat))
+	}
+
+	if byFormat["vintage"] != scryfall.LegalityRestricted {
+		t.Errorf("unexpected vintage legality: %v", byFormat["vintage"])
+	}
+
+	if byFormat["commander"] != scryfall.LegalityBanned {
+		t.Errorf("unexpected commander legality: %v", byFormat["commander"])
+	}
+
+	if byFormat["paupercommander"] != scryfall.LegalityLegal {
+		t.Errorf("unexpected paupercommander legality: %v", byFormat["paupercommander"])
+	}
+}
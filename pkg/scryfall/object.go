@@ -51,7 +51,12 @@ func (o Object) MarshalText() ([]byte, error) {
 
 // MarshalJSON implements the json.Marshaler interface.
 func (o Object) MarshalJSON() ([]byte, error) {
-	return o.MarshalText()
+	marshalled, err := json.Marshal(string(o))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	return marshalled, nil
 }
 
 const (
@@ -61,17 +66,21 @@ const (
 	ObjectBulkData    = Object("bulk_data")
 	ObjectRuling      = Object("ruling")
 	ObjectCard        = Object("card")
+	ObjectCardFace    = Object("card_face")
 	ObjectRelatedCard = Object("related_card")
 	ObjectCatalog     = Object("catalog")
+	ObjectSet         = Object("set")
 )
 
 // ObjectList returns a list of all valid values of Object.
 func AllObjects() []Object {
 	return []Object{
 		ObjectCard,
+		ObjectCardFace,
 		ObjectRelatedCard,
 		ObjectRuling,
 		ObjectBulkData,
 		ObjectList,
+		ObjectSet,
 	}
 }
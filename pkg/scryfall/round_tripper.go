@@ -23,12 +23,22 @@ type roundTripper struct {
 	inner      http.RoundTripper
 	limiter    *rateLimiter
 	maxRetries int
+
+	// userAgent is set as the User-Agent header of every request
+	// that doesn't already have one.
+	userAgent string
 }
 
 func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	numAttempts := 0
 	lastSleep := time.Second
 
+	if r.userAgent != "" && req.Header.Get("User-Agent") == "" {
+		// RoundTrippers must not modify the request they're given
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", r.userAgent)
+	}
+
 	for numAttempts <= r.maxRetries {
 		numAttempts++
 
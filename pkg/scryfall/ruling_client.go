@@ -7,14 +7,15 @@ import (
 )
 
 type RulingClient struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
 }
 
 // ByScryfallID fetches all of the rulings for a card by its Scryfall ID.
 //
 // https://scryfall.com/docs/api/rulings/id
 func (r *RulingClient) ByScryfallID(ctx context.Context, id string) ([]Ruling, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/"+id+"/rulings", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/cards/"+id+"/rulings", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -30,7 +31,7 @@ func (r *RulingClient) ByScryfallID(ctx context.Context, id string) ([]Ruling, e
 }
 
 func (r *RulingClient) ByMultiverseID(ctx context.Context, id int) ([]Ruling, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/multiverse/"+fmt.Sprint(id)+"/rulings", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/cards/multiverse/"+fmt.Sprint(id)+"/rulings", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -46,7 +47,7 @@ func (r *RulingClient) ByMultiverseID(ctx context.Context, id int) ([]Ruling, er
 }
 
 func (r *RulingClient) ByMTGOID(ctx context.Context, id int) ([]Ruling, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/mtgo/"+fmt.Sprint(id)+"/rulings", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/cards/mtgo/"+fmt.Sprint(id)+"/rulings", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -62,7 +63,7 @@ func (r *RulingClient) ByMTGOID(ctx context.Context, id int) ([]Ruling, error) {
 }
 
 func (r *RulingClient) ByArenaID(ctx context.Context, id int) ([]Ruling, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/arena/"+fmt.Sprint(id)+"/rulings", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/cards/arena/"+fmt.Sprint(id)+"/rulings", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -78,7 +79,7 @@ func (r *RulingClient) ByArenaID(ctx context.Context, id int) ([]Ruling, error)
 }
 
 func (r *RulingClient) ByCodeAndNumber(ctx context.Context, code string, number string) ([]Ruling, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scryfall.com/cards/"+code+"/"+number+"/rulings", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/cards/"+code+"/"+number+"/rulings", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
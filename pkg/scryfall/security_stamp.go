@@ -0,0 +1,73 @@
+package scryfall
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityStamp is an enum representing the security stamp printed
+// on a card, if it has one.
+// See AllSecurityStamps() for all known values.
+type SecurityStamp string
+
+// String returns the security stamp as a string.
+func (s SecurityStamp) String() string {
+	return string(s)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Values that aren't in AllSecurityStamps() are kept as-is rather than rejected,
+// so a newly added security stamp doesn't stop the rest of a card from decoding.
+func (s *SecurityStamp) UnmarshalText(txt []byte) error {
+	*s = SecurityStamp(string(txt))
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *SecurityStamp) UnmarshalJSON(txt []byte) error {
+	var unmarshed string
+
+	err := json.Unmarshal(txt, &unmarshed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal security stamp: %w", err)
+	}
+
+	return s.UnmarshalText([]byte(unmarshed))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s SecurityStamp) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s SecurityStamp) MarshalJSON() ([]byte, error) {
+	marshalled, err := json.Marshal(s.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal security stamp: %w", err)
+	}
+
+	return marshalled, nil
+}
+
+const (
+	SecurityStampOval     = SecurityStamp("oval")
+	SecurityStampTriangle = SecurityStamp("triangle")
+	SecurityStampAcorn    = SecurityStamp("acorn")
+	SecurityStampCircle   = SecurityStamp("circle")
+	SecurityStampArena    = SecurityStamp("arena")
+	SecurityStampHeart    = SecurityStamp("heart")
+)
+
+// AllSecurityStamps returns all possible values of SecurityStamp.
+func AllSecurityStamps() []SecurityStamp {
+	return []SecurityStamp{
+		SecurityStampOval,
+		SecurityStampTriangle,
+		SecurityStampAcorn,
+		SecurityStampCircle,
+		SecurityStampArena,
+		SecurityStampHeart,
+	}
+}
@@ -0,0 +1,69 @@
+package scryfall
+
+// Set is a set of cards, such as an expansion or a promotional set.
+//
+// https://scryfall.com/docs/api/sets
+type Set struct {
+	// The type of object; should be "set" for sets.
+	Object Object `json:"object"`
+
+	// Scryfall's unique ID for the set.
+	ID string `json:"id"`
+
+	// The unique three to six-letter code for the set.
+	Code string `json:"code"`
+
+	// The code for the set on MTG: Online, if it differs from Code.
+	MTGOCode string `json:"mtgo_code,omitempty"`
+
+	// The code for the set on MTG: Arena, if it differs from Code.
+	ArenaCode string `json:"arena_code,omitempty"`
+
+	// The TCGPlayer ID for the set's group.
+	TCGPlayerID int `json:"tcgplayer_id,omitempty"`
+
+	// The English name of the set.
+	Name string `json:"name"`
+
+	// The kind of set this is.
+	SetType SetType `json:"set_type"`
+
+	// The date the set was released, if it is known.
+	ReleasedAt *Date `json:"released_at,omitempty"`
+
+	// The code for the block the set is in, if it is in one.
+	BlockCode string `json:"block_code,omitempty"`
+
+	// The name of the block the set is in, if it is in one.
+	Block string `json:"block,omitempty"`
+
+	// The code of the set this set is a part of, if it is a child set.
+	ParentSetCode string `json:"parent_set_code,omitempty"`
+
+	// The number of cards in the set.
+	CardCount int `json:"card_count"`
+
+	// The denominator for the set's printed collector numbers.
+	PrintedSize int `json:"printed_size,omitempty"`
+
+	// Whether the set was only released on video games.
+	Digital bool `json:"digital"`
+
+	// Whether the set only contains foil cards.
+	FoilOnly bool `json:"foil_only"`
+
+	// Whether the set only contains non-foil cards.
+	NonfoilOnly bool `json:"nonfoil_only"`
+
+	// A link to the set on Scryfall's website.
+	ScryfallURI string `json:"scryfall_uri"`
+
+	// A link to the set in Scryfall's API.
+	URI string `json:"uri"`
+
+	// A link to the set's icon as an SVG.
+	IconSVGURI string `json:"icon_svg_uri"`
+
+	// A link to search for the set's cards in Scryfall's API.
+	SearchURI string `json:"search_uri"`
+}
@@ -0,0 +1,69 @@
+package scryfall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type SetClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+// List fetches every set.
+//
+// https://scryfall.com/docs/api/sets/all
+func (s *SetClient) List(ctx context.Context) ([]Set, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/sets", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	var list List[Set]
+
+	err = doRequest(s.client, req, &list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform HTTP request: %w", err)
+	}
+
+	return list.Data, nil
+}
+
+// ByCode fetches a single set by its code, such as "mh2".
+//
+// https://scryfall.com/docs/api/sets/code
+func (s *SetClient) ByCode(ctx context.Context, code string) (*Set, error) {
+	return s.get(ctx, "/sets/"+url.PathEscape(code))
+}
+
+// ByTCGPlayerID fetches a single set by the ID of its group on TCGPlayer.
+//
+// https://scryfall.com/docs/api/sets/tcgplayer
+func (s *SetClient) ByTCGPlayerID(ctx context.Context, id int) (*Set, error) {
+	return s.get(ctx, "/sets/tcgplayer/"+fmt.Sprint(id))
+}
+
+// ByScryfallID fetches a single set by its Scryfall ID.
+//
+// https://scryfall.com/docs/api/sets/id
+func (s *SetClient) ByScryfallID(ctx context.Context, id string) (*Set, error) {
+	return s.get(ctx, "/sets/"+url.PathEscape(id))
+}
+
+func (s *SetClient) get(ctx context.Context, path string) (*Set, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	var set Set
+
+	err = doRequest(s.client, req, &set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform HTTP request: %w", err)
+	}
+
+	return &set, nil
+}
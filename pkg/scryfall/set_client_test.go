@@ -0,0 +1,93 @@
+package scryfall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SethCurry/stax/pkg/scryfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSetJSON = `{
+	"object": "set",
+	"id": "c1c7eb8c-f205-40ab-a609-767cb296544e",
+	"code": "mh2",
+	"tcgplayer_id": 2864,
+	"name": "Modern Horizons 2",
+	"set_type": "draft_innovation",
+	"released_at": "2021-06-18",
+	"card_count": 491,
+	"printed_size": 303,
+	"digital": false,
+	"foil_only": false,
+	"nonfoil_only": false,
+	"scryfall_uri": "https://scryfall.com/sets/mh2",
+	"uri": "https://api.scryfall.com/sets/c1c7eb8c-f205-40ab-a609-767cb296544e",
+	"icon_svg_uri": "https://svgs.scryfall.io/sets/mh2.svg",
+	"search_uri": "https://api.scryfall.com/cards/search?order=set&q=e%3Amh2&unique=prints"
+}`
+
+const testChildSetJSON = `{
+	"object": "set",
+	"id": "1f9ab2f6-ba9f-4b4a-9d7c-53b2d1d4bbed",
+	"code": "tmh2",
+	"name": "Modern Horizons 2 Tokens",
+	"set_type": "token",
+	"parent_set_code": "mh2",
+	"card_count": 27,
+	"digital": false,
+	"foil_only": false,
+	"nonfoil_only": false,
+	"icon_svg_uri": "https://svgs.scryfall.io/sets/mh2.svg"
+}`
+
+func Test_Client_Set(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/sets":
+			_, _ = w.Write([]byte(`{"object": "list", "has_more": false, "data": [` + testSetJSON + `, ` + testChildSetJSON + `]}`))
+		case "/sets/mh2", "/sets/tcgplayer/2864":
+			_, _ = w.Write([]byte(testSetJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"object": "error", "code": "not_found", "status": 404}`))
+		}
+	}))
+	defer server.Close()
+
+	client := scryfall.NewClient(nil, scryfall.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	sets, err := client.Set.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, sets, 2)
+
+	assert.Equal(t, scryfall.SetTypeDraftInnovation, sets[0].SetType)
+	assert.True(t, sets[0].SetType.IsDraftable())
+	require.NotNil(t, sets[0].ReleasedAt)
+	assert.Equal(t, time.Date(2021, 6, 18, 0, 0, 0, 0, time.UTC), time.Time(*sets[0].ReleasedAt))
+
+	assert.Equal(t, "mh2", sets[1].ParentSetCode)
+	assert.Nil(t, sets[1].ReleasedAt)
+	assert.False(t, sets[1].SetType.IsDraftable())
+
+	byCode, err := client.Set.ByCode(ctx, "mh2")
+	require.NoError(t, err)
+	assert.Equal(t, "Modern Horizons 2", byCode.Name)
+	assert.Equal(t, 491, byCode.CardCount)
+
+	byTCGPlayer, err := client.Set.ByTCGPlayerID(ctx, 2864)
+	require.NoError(t, err)
+	assert.Equal(t, "mh2", byTCGPlayer.Code)
+
+	_, err = client.Set.ByCode(ctx, "nope")
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+package scryfall
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownSetType is returned when unmarshaling a SetType from a string
+// that is not one of the pre-defined set types.
+var ErrUnknownSetType = errors.New("unknown set type")
+
+// SetType is an enum representing the kind of product a set is, such
+// as an expansion or a commander deck.
+//
+// https://scryfall.com/docs/api/sets#set-types
+// See AllSetTypes() for all possible values.
+type SetType string
+
+// String returns the set type as a string.
+func (s SetType) String() string {
+	return string(s)
+}
+
+// IsDraftable returns whether sets of this type are normally sold in
+// booster packs meant for drafting.
+func (s SetType) IsDraftable() bool {
+	switch s {
+	case SetTypeCore, SetTypeExpansion, SetTypeMasters, SetTypeDraftInnovation:
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *SetType) UnmarshalText(txt []byte) error {
+	for _, v := range AllSetTypes() {
+		if v == SetType(string(txt)) {
+			*s = v
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownSetType, string(txt))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *SetType) UnmarshalJSON(txt []byte) error {
+	var unmarshed string
+
+	err := json.Unmarshal(txt, &unmarshed)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal set type: %w", err)
+	}
+
+	return s.UnmarshalText([]byte(unmarshed))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s SetType) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s SetType) MarshalJSON() ([]byte, error) {
+	marshalled, err := json.Marshal(s.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal set type: %w", err)
+	}
+
+	return marshalled, nil
+}
+
+const (
+	// SetTypeCore is a yearly core set, like Magic 2010.
+	SetTypeCore = SetType("core")
+
+	// SetTypeExpansion is a rotational expansion set in a block.
+	SetTypeExpansion = SetType("expansion")
+
+	// SetTypeMasters is a reprint set that contains no new cards.
+	SetTypeMasters = SetType("masters")
+
+	// SetTypeAlchemy is an Arena set designed for Alchemy.
+	SetTypeAlchemy = SetType("alchemy")
+
+	// SetTypeMasterpiece is a set of masterpiece series premium foil cards.
+	SetTypeMasterpiece = SetType("masterpiece")
+
+	// SetTypeArsenal is a commander-oriented gift set.
+	SetTypeArsenal = SetType("arsenal")
+
+	// SetTypeFromTheVault is a From the Vault gift set.
+	SetTypeFromTheVault = SetType("from_the_vault")
+
+	// SetTypeSpellbook is a Spellbook series gift set.
+	SetTypeSpellbook = SetType("spellbook")
+
+	// SetTypePremiumDeck is a Premium Deck Series decklist.
+	SetTypePremiumDeck = SetType("premium_deck")
+
+	// SetTypeDuelDeck is a Duel Decks decklist.
+	SetTypeDuelDeck = SetType("duel_deck")
+
+	// SetTypeDraftInnovation is a special draft set, like Conspiracy.
+	SetTypeDraftInnovation = SetType("draft_innovation")
+
+	// SetTypeTreasureChest is a Magic Online treasure chest prize set.
+	SetTypeTreasureChest = SetType("treasure_chest")
+
+	// SetTypeCommander is a commander preconstructed decklist.
+	SetTypeCommander = SetType("commander")
+
+	// SetTypePlanechase is a Planechase set.
+	SetTypePlanechase = SetType("planechase")
+
+	// SetTypeArchenemy is an Archenemy set.
+	SetTypeArchenemy = SetType("archenemy")
+
+	// SetTypeVanguard is a Vanguard card set.
+	SetTypeVanguard = SetType("vanguard")
+
+	// SetTypeFunny is a funny un-set or playtest set.
+	SetTypeFunny = SetType("funny")
+
+	// SetTypeStarter is a starter or introductory set.
+	SetTypeStarter = SetType("starter")
+
+	// SetTypeBox is a gift box set.
+	SetTypeBox = SetType("box")
+
+	// SetTypePromo is a set that contains purely promotional cards.
+	SetTypePromo = SetType("promo")
+
+	// SetTypeToken is a set made up of tokens and emblems.
+	SetTypeToken = SetType("token")
+
+	// SetTypeMemorabilia is a set made up of gold-bordered, oversize, or
+	// trophy cards that are not legal.
+	SetTypeMemorabilia = SetType("memorabilia")
+
+	// SetTypeMinigame is a set that contains minigame card inserts from booster packs.
+	SetTypeMinigame = SetType("minigame")
+)
+
+// AllSetTypes returns all possible values of SetType.
+func AllSetTypes() []SetType {
+	return []SetType{
+		SetTypeCore,
+		SetTypeExpansion,
+		SetTypeMasters,
+		SetTypeAlchemy,
+		SetTypeMasterpiece,
+		SetTypeArsenal,
+		SetTypeFromTheVault,
+		SetTypeSpellbook,
+		SetTypePremiumDeck,
+		SetTypeDuelDeck,
+		SetTypeDraftInnovation,
+		SetTypeTreasureChest,
+		SetTypeCommander,
+		SetTypePlanechase,
+		SetTypeArchenemy,
+		SetTypeVanguard,
+		SetTypeFunny,
+		SetTypeStarter,
+		SetTypeBox,
+		SetTypePromo,
+		SetTypeToken,
+		SetTypeMemorabilia,
+		SetTypeMinigame,
+	}
+}
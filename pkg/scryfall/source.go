@@ -47,7 +47,12 @@ func (s Source) MarshalText() ([]byte, error) {
 
 // MarshalJSON implements the json.Marshaler interface.
 func (s Source) MarshalJSON() ([]byte, error) {
-	return s.MarshalText()
+	marshalled, err := json.Marshal(string(s))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal source: %w", err)
+	}
+
+	return marshalled, nil
 }
 
 const (
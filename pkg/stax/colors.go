@@ -1,5 +1,15 @@
 package stax
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownColor is returned when parsing a color that isn't one of
+// the five colors, colorless, or a known color combination name.
+var ErrUnknownColor = errors.New("unknown color")
+
 // ColorField stores a set of colors for a card, like its colors
 // or its color identity.
 //
@@ -64,6 +74,166 @@ func (c ColorField) HasGreen() bool {
 	return c.HasColor(ColorGreen)
 }
 
+// Count returns the number of colors set in the field.
+func (c ColorField) Count() int {
+	count := 0
+
+	for _, color := range AllColors {
+		if c.HasColor(color) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// String returns the colors in the field as mana symbol characters
+// in WUBRG order, such as "UR".  Colorless fields return "C".
+func (c ColorField) String() string {
+	if c == 0 {
+		return "C"
+	}
+
+	ret := ""
+
+	for _, color := range wubrg {
+		if c.HasColor(color) {
+			ret += color.Char()
+		}
+	}
+
+	return ret
+}
+
+// Chars returns the colors in the field as a slice of mana symbol
+// characters in WUBRG order, like the "colors" field on Scryfall cards.
+// Colorless fields return an empty slice.
+func (c ColorField) Chars() []string {
+	ret := []string{}
+
+	for _, color := range wubrg {
+		if c.HasColor(color) {
+			ret = append(ret, color.Char())
+		}
+	}
+
+	return ret
+}
+
+// NewColorField creates a ColorField from a slice of mana symbol characters,
+// like the "colors" and "color_identity" fields on Scryfall cards.
+func NewColorField(chars []string) (ColorField, error) {
+	var field ColorField
+
+	for _, char := range chars {
+		color, err := ColorByChar(char)
+		if err != nil {
+			return 0, err
+		}
+
+		field.SetColor(color, true)
+	}
+
+	return field, nil
+}
+
+// ParseColorField parses a set of colors written the way they are in
+// Scryfall queries.  It accepts mana symbol characters like "WUB", "c" or
+// "colorless", color names like "red", and the names of two and three
+// color combinations like "azorius", "esper" or "temur".
+func ParseColorField(colors string) (ColorField, error) {
+	colors = strings.ToLower(strings.TrimSpace(colors))
+
+	if field, ok := namedColorFields[colors]; ok {
+		return field, nil
+	}
+
+	return colorFieldFromChars(colors)
+}
+
+// colorFieldFromChars creates a ColorField from a string of mana symbol
+// characters like "wub".  A "c" for colorless is allowed and ignored.
+func colorFieldFromChars(colors string) (ColorField, error) {
+	var field ColorField
+
+	for _, char := range colors {
+		if char == 'c' {
+			continue
+		}
+
+		color, err := ColorByChar(string(char))
+		if err != nil {
+			return 0, err
+		}
+
+		field.SetColor(color, true)
+	}
+
+	return field, nil
+}
+
+// ColorByChar returns the color for a mana symbol character, case-insensitively.
+// For example, "u" and "U" both return ColorBlue.
+func ColorByChar(char string) (*Color, error) {
+	for _, color := range AllColors {
+		if strings.EqualFold(color.Char(), char) {
+			return color, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownColor, char)
+}
+
+// mustColorFieldFromChars is colorFieldFromChars for hard-coded values.
+// It panics if the colors are invalid.
+func mustColorFieldFromChars(colors string) ColorField {
+	field, err := colorFieldFromChars(colors)
+	if err != nil {
+		panic(err)
+	}
+
+	return field
+}
+
+// namedColorFields maps the names of colors and color combinations,
+// as used by Scryfall, to their ColorField.
+var namedColorFields = map[string]ColorField{
+	"colorless": 0,
+	"white":     mustColorFieldFromChars("w"),
+	"blue":      mustColorFieldFromChars("u"),
+	"black":     mustColorFieldFromChars("b"),
+	"red":       mustColorFieldFromChars("r"),
+	"green":     mustColorFieldFromChars("g"),
+
+	// guilds
+	"azorius":  mustColorFieldFromChars("wu"),
+	"dimir":    mustColorFieldFromChars("ub"),
+	"rakdos":   mustColorFieldFromChars("br"),
+	"gruul":    mustColorFieldFromChars("rg"),
+	"selesnya": mustColorFieldFromChars("gw"),
+	"orzhov":   mustColorFieldFromChars("wb"),
+	"izzet":    mustColorFieldFromChars("ur"),
+	"golgari":  mustColorFieldFromChars("bg"),
+	"boros":    mustColorFieldFromChars("rw"),
+	"simic":    mustColorFieldFromChars("gu"),
+
+	// shards
+	"bant":   mustColorFieldFromChars("gwu"),
+	"esper":  mustColorFieldFromChars("wub"),
+	"grixis": mustColorFieldFromChars("ubr"),
+	"jund":   mustColorFieldFromChars("brg"),
+	"naya":   mustColorFieldFromChars("rgw"),
+
+	// wedges
+	"abzan":  mustColorFieldFromChars("wbg"),
+	"jeskai": mustColorFieldFromChars("urw"),
+	"sultai": mustColorFieldFromChars("bgu"),
+	"mardu":  mustColorFieldFromChars("rwb"),
+	"temur":  mustColorFieldFromChars("gur"),
+
+	"wubrg": mustColorFieldFromChars("wubrg"),
+}
+
 // Color represents a single color in Magic.  There are constants declared
 // for all of the colors.  See ColorRed, ColorBlue, ColorBlack, ColorGreen,
 // and ColorWhite.
@@ -134,4 +304,13 @@ var (
 		ColorWhite,
 		ColorGreen,
 	}
+
+	// wubrg is all of the colors in the conventional WUBRG order.
+	wubrg = []*Color{
+		ColorWhite,
+		ColorBlue,
+		ColorBlack,
+		ColorRed,
+		ColorGreen,
+	}
 )
@@ -107,3 +107,47 @@ func TestColorFieldSetGreen(t *testing.T) {
 
 	assert.Equal(t, false, fieldRef.HasGreen())
 }
+
+func TestParseColorField(t *testing.T) {
+	testCases := []struct {
+		name     string
+		colors   string
+		expected string
+		wantErr  bool
+	}{
+		{name: "single color", colors: "r", expected: "R"},
+		{name: "upper case", colors: "WUB", expected: "WUB"},
+		{name: "out of order", colors: "gw", expected: "WG"},
+		{name: "colorless char", colors: "c", expected: "C"},
+		{name: "colorless name", colors: "Colorless", expected: "C"},
+		{name: "color name", colors: "green", expected: "G"},
+		{name: "guild", colors: "izzet", expected: "UR"},
+		{name: "shard", colors: "esper", expected: "WUB"},
+		{name: "wedge", colors: "Temur", expected: "URG"},
+		{name: "unknown", colors: "xyz", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			field, err := ParseColorField(tc.colors)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownColor)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, field.String())
+		})
+	}
+}
+
+func TestNewColorField(t *testing.T) {
+	field, err := NewColorField([]string{"U", "R"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, field.Count())
+	assert.True(t, field.HasBlue())
+	assert.True(t, field.HasRed())
+
+	_, err = NewColorField([]string{"P"})
+	assert.ErrorIs(t, err, ErrUnknownColor)
+}
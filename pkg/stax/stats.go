@@ -0,0 +1,69 @@
+package stax
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseStat converts a power, toughness or loyalty value as printed on a
+// card into a number that can be compared against other stats.
+//
+// Variable values like "*", "X" and "?" count as 0, so "1+*" is 1 and
+// "7-*" is 7.  Half values from Un-sets like "2½" or "3.5" are supported,
+// and "∞" is positive infinity.
+//
+// The second return value is false if the card doesn't have the stat at all
+// (an empty string) or if it couldn't be parsed.
+func ParseStat(stat string) (float32, bool) {
+	stat = strings.TrimSpace(strings.ReplaceAll(stat, "½", ".5"))
+
+	if stat == "" {
+		return 0, false
+	}
+
+	if stat == "∞" {
+		return float32(math.Inf(1)), true
+	}
+
+	var total float64
+
+	term := ""
+
+	for i, char := range stat {
+		// a leading sign belongs to the first term, anything
+		// after that separates two terms like in 1+*
+		if i > 0 && (char == '+' || char == '-') {
+			value, ok := parseStatTerm(term)
+			if !ok {
+				return 0, false
+			}
+
+			total += value
+			term = ""
+		}
+
+		term += string(char)
+	}
+
+	value, ok := parseStatTerm(term)
+	if !ok {
+		return 0, false
+	}
+
+	return float32(total + value), true
+}
+
+// parseStatTerm parses a single signed term of a stat, such as "+*" or "-1".
+func parseStatTerm(term string) (float64, bool) {
+	if strings.ContainsAny(term, "*?Xx") {
+		return 0, true
+	}
+
+	value, err := strconv.ParseFloat(term, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,40 @@
+package stax
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stat     string
+		expected float32
+		ok       bool
+	}{
+		{name: "number", stat: "3", expected: 3, ok: true},
+		{name: "negative", stat: "-1", expected: -1, ok: true},
+		{name: "star", stat: "*", expected: 0, ok: true},
+		{name: "one plus star", stat: "1+*", expected: 1, ok: true},
+		{name: "seven minus star", stat: "7-*", expected: 7, ok: true},
+		{name: "x", stat: "X", expected: 0, ok: true},
+		{name: "question mark", stat: "?", expected: 0, ok: true},
+		{name: "half symbol", stat: "2½", expected: 2.5, ok: true},
+		{name: "lone half symbol", stat: "½", expected: 0.5, ok: true},
+		{name: "decimal", stat: "3.5", expected: 3.5, ok: true},
+		{name: "infinity", stat: "∞", expected: float32(math.Inf(1)), ok: true},
+		{name: "empty", stat: "", expected: 0, ok: false},
+		{name: "garbage", stat: "1d4+1", expected: 0, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := ParseStat(tc.stat)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}